@@ -0,0 +1,157 @@
+// Package services provides business logic services for the OCR checker application.
+// It contains analyzers and other services that operate on domain entities.
+package services
+
+import (
+	"fmt"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AlertMessageOptions controls how alert messages are built.
+type AlertMessageOptions struct {
+	// IncludeHealthy lists jobs in the Found state alongside unhealthy ones.
+	IncludeHealthy bool
+
+	// HealthScorePrecision is the number of decimals shown for the health score.
+	HealthScorePrecision int
+
+	// ChainID is the chain the transmitter is watched on, used in the dedup key.
+	ChainID int64
+
+	// NoActiveStatus is the health status a result with jobs in the No Active
+	// state is raised to. The zero value, OK, leaves such jobs out of it.
+	NoActiveStatus entities.HealthStatus
+}
+
+// BuildAlertMessage builds a notification describing a watch result.
+// Jobs in the Found state are listed only when IncludeHealthy is set.
+func BuildAlertMessage(
+	transmitter common.Address,
+	result *interfaces.WatchTransmittersResult,
+	opts AlertMessageOptions,
+) interfaces.Notification {
+	summary := result.Summary
+	severity := alertSeverity(AlertHealthStatus(summary, opts))
+
+	notification := interfaces.Notification{
+		Title:    fmt.Sprintf("OCR Checker: %s for %s", severityLabel(severity), transmitter.Hex()),
+		Severity: severity,
+		Summary: fmt.Sprintf("Total: %d, Found: %d, Stale: %d, Missing: %d, No Active: %d, Error: %d, Health: %s",
+			summary.TotalJobs,
+			summary.FoundJobs,
+			summary.StaleJobs,
+			summary.MissingJobs,
+			summary.NoActiveJobs,
+			summary.ErrorJobs,
+			FormatHealthScore(summary.HealthScore, opts.HealthScorePrecision)),
+		DedupKey: AlertDedupKey(opts.ChainID, transmitter),
+	}
+
+	// An escalated No Active job is explained first: the transmitter is not
+	// sending for the feed at all, which staleness alone does not convey.
+	if noActiveEscalated(summary, opts) {
+		notification.Details = append(notification.Details, fmt.Sprintf(
+			"[%s] %d of %d jobs are not active on the node, so the transmitter is absent from those feeds",
+			entities.JobStatusNoActive, summary.NoActiveJobs, summary.TotalJobs))
+	}
+
+	for _, status := range result.Statuses {
+		if status.Status == entities.JobStatusFound && !opts.IncludeHealthy {
+			continue
+		}
+		notification.Details = append(notification.Details, formatJobStatus(status))
+	}
+	notification.Fields = alertFields(transmitter, result, opts.ChainID)
+
+	return notification
+}
+
+// AlertDedupKey returns the key that groups the alerts of a transmitter on a
+// chain, whatever their severity, into one incident.
+func AlertDedupKey(chainID int64, transmitter common.Address) string {
+	return fmt.Sprintf("ocr-checker/%d/%s", chainID, transmitter.Hex())
+}
+
+// alertFields returns the structured context of an alert: the transmitter,
+// the chain, and the IDs of the jobs not in the Found state.
+func alertFields(transmitter common.Address, result *interfaces.WatchTransmittersResult, chainID int64) map[string]any {
+	failingJobs := []string{}
+	for _, status := range result.Statuses {
+		if status.Status != entities.JobStatusFound {
+			failingJobs = append(failingJobs, status.JobID)
+		}
+	}
+
+	return map[string]any{
+		"transmitter":  transmitter.Hex(),
+		"chain_id":     chainID,
+		"failing_jobs": failingJobs,
+	}
+}
+
+// AlertHealthStatus returns the health status alerts are raised for: the
+// summary's status, escalated to opts.NoActiveStatus when jobs are No Active.
+func AlertHealthStatus(summary interfaces.TransmitterSummary, opts AlertMessageOptions) entities.HealthStatus {
+	status := summary.HealthStatus()
+	if summary.NoActiveJobs > 0 {
+		status = entities.WorstHealthStatus(status, opts.NoActiveStatus)
+	}
+	return status
+}
+
+// noActiveEscalated reports whether No Active jobs set the alert's status.
+func noActiveEscalated(summary interfaces.TransmitterSummary, opts AlertMessageOptions) bool {
+	return summary.NoActiveJobs > 0 &&
+		opts.NoActiveStatus != entities.HealthStatusOK &&
+		opts.NoActiveStatus >= summary.HealthStatus()
+}
+
+// alertSeverity derives the notification severity from an alert health status.
+func alertSeverity(status entities.HealthStatus) interfaces.NotificationSeverity {
+	switch status {
+	case entities.HealthStatusCritical:
+		return interfaces.NotificationSeverityCritical
+	case entities.HealthStatusWarning:
+		return interfaces.NotificationSeverityWarning
+	default:
+		return interfaces.NotificationSeverityInfo
+	}
+}
+
+// severityLabel returns the human-readable status for a severity.
+func severityLabel(severity interfaces.NotificationSeverity) string {
+	switch severity {
+	case interfaces.NotificationSeverityCritical:
+		return "CRITICAL"
+	case interfaces.NotificationSeverityWarning:
+		return "WARNING"
+	default:
+		return "OK"
+	}
+}
+
+// formatJobStatus formats a single job status as a detail line.
+func formatJobStatus(status entities.TransmitterStatus) string {
+	lastSeen := "never"
+	if !status.LastTimestamp.IsZero() {
+		lastSeen = status.LastTimestamp.UTC().Format("2006-01-02 15:04:05")
+	}
+
+	line := fmt.Sprintf("[%s] job %s contract %s last round %d last seen %s",
+		status.Status,
+		status.JobID,
+		status.ContractAddress.Hex(),
+		status.LastRound,
+		lastSeen)
+	if status.Error != nil {
+		line += fmt.Sprintf(" (%v)", status.Error)
+	}
+	if status.Reason != "" {
+		line += ": " + status.Reason
+	}
+
+	return line
+}
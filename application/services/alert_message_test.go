@@ -0,0 +1,153 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testWatchResult() *interfaces.WatchTransmittersResult {
+	return &interfaces.WatchTransmittersResult{
+		Statuses: []entities.TransmitterStatus{
+			{
+				JobID:           "healthy-job",
+				ContractAddress: common.HexToAddress("0x1000000000000000000000000000000000000001"),
+				LastRound:       42,
+				LastTimestamp:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				Status:          entities.JobStatusFound,
+			},
+			{
+				JobID:           "missing-job",
+				ContractAddress: common.HexToAddress("0x2000000000000000000000000000000000000002"),
+				Status:          entities.JobStatusMissing,
+			},
+		},
+		Summary: interfaces.TransmitterSummary{
+			TotalJobs:   2,
+			FoundJobs:   1,
+			MissingJobs: 1,
+		},
+	}
+}
+
+func TestBuildAlertMessage(t *testing.T) {
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000000")
+
+	t.Run("omits healthy jobs by default", func(t *testing.T) {
+		notification := BuildAlertMessage(transmitter, testWatchResult(), AlertMessageOptions{})
+
+		assert.Equal(t, interfaces.NotificationSeverityCritical, notification.Severity)
+		assert.Contains(t, notification.Title, "CRITICAL")
+		require.Len(t, notification.Details, 1)
+		assert.Contains(t, notification.Details[0], "missing-job")
+		assert.Contains(t, notification.Details[0], "last seen never")
+	})
+
+	t.Run("includes healthy jobs when requested", func(t *testing.T) {
+		notification := BuildAlertMessage(transmitter, testWatchResult(), AlertMessageOptions{
+			IncludeHealthy: true,
+		})
+
+		require.Len(t, notification.Details, 2)
+		assert.Contains(t, notification.Details[0], "[Found] job healthy-job")
+		assert.Contains(t, notification.Details[0], "last round 42")
+		assert.Contains(t, notification.Details[0], "last seen 2024-01-02 03:04:05")
+		assert.Contains(t, notification.Details[1], "missing-job")
+	})
+
+	t.Run("all healthy", func(t *testing.T) {
+		result := testWatchResult()
+		result.Statuses = result.Statuses[:1]
+		result.Summary = interfaces.TransmitterSummary{TotalJobs: 1, FoundJobs: 1}
+
+		notification := BuildAlertMessage(transmitter, result, AlertMessageOptions{})
+		assert.Equal(t, interfaces.NotificationSeverityInfo, notification.Severity)
+		assert.Contains(t, notification.Title, "OK")
+		assert.Empty(t, notification.Details)
+	})
+}
+
+func TestBuildAlertMessage_DedupKeyAndFields(t *testing.T) {
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000000")
+	opts := AlertMessageOptions{ChainID: 137}
+
+	critical := BuildAlertMessage(transmitter, testWatchResult(), opts)
+	assert.Equal(t, "ocr-checker/137/"+transmitter.Hex(), critical.DedupKey)
+	assert.Equal(t, transmitter.Hex(), critical.Fields["transmitter"])
+	assert.Equal(t, int64(137), critical.Fields["chain_id"])
+	assert.Equal(t, []string{"missing-job"}, critical.Fields["failing_jobs"])
+
+	// A recovery shares the key so it lands on the same incident.
+	result := testWatchResult()
+	result.Statuses = result.Statuses[:1]
+	result.Summary = interfaces.TransmitterSummary{TotalJobs: 1, FoundJobs: 1}
+	recovered := BuildAlertMessage(transmitter, result, opts)
+	assert.Equal(t, critical.DedupKey, recovered.DedupKey)
+	assert.Equal(t, []string{}, recovered.Fields["failing_jobs"])
+}
+
+func TestBuildAlertMessage_HealthScorePrecision(t *testing.T) {
+	result := testWatchResult()
+	result.Summary.HealthScore = 50
+
+	notification := BuildAlertMessage(common.Address{}, result, AlertMessageOptions{HealthScorePrecision: 2})
+	assert.Contains(t, notification.Summary, "Health: 50.00%")
+}
+
+func TestFormatHealthScore(t *testing.T) {
+	assert.Equal(t, "66.7%", FormatHealthScore(200.0/3, 1))
+	assert.Equal(t, "66.667%", FormatHealthScore(200.0/3, 3))
+	assert.Equal(t, "66.7%", FormatHealthScore(200.0/3, -1))
+}
+
+func TestBuildAlertMessage_NoActiveStatus(t *testing.T) {
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000000")
+	result := &interfaces.WatchTransmittersResult{
+		Statuses: []entities.TransmitterStatus{
+			{
+				JobID:           "inactive-job",
+				ContractAddress: common.HexToAddress("0x3000000000000000000000000000000000000003"),
+				Status:          entities.JobStatusNoActive,
+			},
+		},
+		Summary: interfaces.TransmitterSummary{TotalJobs: 2, FoundJobs: 1, NoActiveJobs: 1},
+	}
+
+	t.Run("not escalated by default", func(t *testing.T) {
+		notification := BuildAlertMessage(transmitter, result, AlertMessageOptions{})
+
+		assert.Equal(t, interfaces.NotificationSeverityInfo, notification.Severity)
+		assert.Equal(t, entities.HealthStatusOK, AlertHealthStatus(result.Summary, AlertMessageOptions{}))
+		require.Len(t, notification.Details, 1)
+		assert.Contains(t, notification.Details[0], "inactive-job")
+	})
+
+	t.Run("escalates to critical under the policy", func(t *testing.T) {
+		opts := AlertMessageOptions{NoActiveStatus: entities.HealthStatusCritical}
+		notification := BuildAlertMessage(transmitter, result, opts)
+
+		assert.Equal(t, interfaces.NotificationSeverityCritical, notification.Severity)
+		assert.Equal(t, entities.HealthStatusCritical, AlertHealthStatus(result.Summary, opts))
+		assert.Contains(t, notification.Title, "CRITICAL")
+		require.Len(t, notification.Details, 2)
+		assert.Equal(t,
+			"[No Active] 1 of 2 jobs are not active on the node, so the transmitter is absent from those feeds",
+			notification.Details[0])
+		assert.Contains(t, notification.Details[1], "inactive-job")
+	})
+
+	t.Run("does not lower a worse status", func(t *testing.T) {
+		worse := *result
+		worse.Summary.MissingJobs = 1
+		opts := AlertMessageOptions{NoActiveStatus: entities.HealthStatusWarning}
+		notification := BuildAlertMessage(transmitter, &worse, opts)
+
+		assert.Equal(t, interfaces.NotificationSeverityCritical, notification.Severity)
+		assert.NotContains(t, notification.Details[0], "absent from those feeds")
+	})
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"math/big"
+	"strings"
+)
+
+// AnswerDisplay configures how a feed's answers are written in reports, for
+// example "$1,234.56" for a USD feed. The zero value writes the answer scaled
+// by the feed decimals with every decimal kept.
+type AnswerDisplay struct {
+	// Decimals is the number of decimal places shown, rounding the answer.
+	// Nil keeps all of the feed's decimals.
+	Decimals *int
+
+	// Prefix and Suffix surround the number, after any minus sign.
+	Prefix string
+	Suffix string
+
+	// ThousandsSeparator groups the integer digits in threes when set.
+	ThousandsSeparator string
+}
+
+// Format renders a raw answer scaled by feedDecimals.
+func (d AnswerDisplay) Format(answer *big.Int, feedDecimals uint8) string {
+	places := int(feedDecimals)
+	if d.Decimals != nil {
+		places = *d.Decimals
+	}
+
+	text := scaleAnswer(answer, feedDecimals).Text('f', places)
+	sign := ""
+	if strings.HasPrefix(text, "-") {
+		sign, text = "-", text[1:]
+	}
+
+	integer, fraction, hasFraction := strings.Cut(text, ".")
+	if d.ThousandsSeparator != "" {
+		integer = groupThousands(integer, d.ThousandsSeparator)
+	}
+	if hasFraction {
+		integer += "." + fraction
+	}
+
+	return sign + d.Prefix + integer + d.Suffix
+}
+
+// groupThousands inserts separator between each group of three digits.
+func groupThousands(digits, separator string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+
+	var b strings.Builder
+	head := len(digits) % 3
+	if head > 0 {
+		b.WriteString(digits[:head])
+	}
+	for i := head; i < len(digits); i += 3 {
+		if b.Len() > 0 {
+			b.WriteString(separator)
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
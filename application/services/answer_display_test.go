@@ -0,0 +1,31 @@
+package services
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnswerDisplay_Format(t *testing.T) {
+	two := 2
+	usd := AnswerDisplay{Decimals: &two, Prefix: "$", ThousandsSeparator: ","}
+
+	for _, tt := range []struct {
+		name    string
+		display AnswerDisplay
+		answer  int64
+		want    string
+	}{
+		{name: "raw", answer: 123456000000, want: "1234.56000000"},
+		{name: "usd", display: usd, answer: 123456000000, want: "$1,234.56"},
+		{name: "usd rounds", display: usd, answer: 123456789012345, want: "$1,234,567.89"},
+		{name: "usd negative", display: usd, answer: -123456000000, want: "-$1,234.56"},
+		{name: "usd small", display: usd, answer: 99000000, want: "$0.99"},
+		{name: "suffix without decimals", display: AnswerDisplay{Decimals: new(int), Suffix: " ETH"}, answer: 260000000, want: "3 ETH"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.display.Format(big.NewInt(tt.answer), 8))
+		})
+	}
+}
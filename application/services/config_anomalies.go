@@ -0,0 +1,29 @@
+package services
+
+import (
+	"fmt"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+)
+
+// DetectConfigAnomalies reports problems with a config itself rather than with
+// the transmissions made under it: currently, transmitters listed more than
+// once. Each is reported as a high-severity AnomalyTypeDuplicateTransmitter.
+func DetectConfigAnomalies(config *entities.OCR2Config) []interfaces.TransmissionAnomaly {
+	var anomalies []interfaces.TransmissionAnomaly
+	for _, duplicate := range config.DuplicateTransmitters() {
+		anomalies = append(anomalies, interfaces.TransmissionAnomaly{
+			Type: interfaces.AnomalyTypeDuplicateTransmitter,
+			Description: fmt.Sprintf("Transmitter %s is listed at indices %v of config %x; only index %d is used",
+				duplicate.Address.Hex(), duplicate.Indices, config.ConfigDigest, duplicate.Indices[0]),
+			Severity: interfaces.AnomalySeverityHigh,
+			Details: map[string]interface{}{
+				"transmitter":  duplicate.Address.Hex(),
+				"indices":      duplicate.Indices,
+				"configDigest": fmt.Sprintf("%x", config.ConfigDigest),
+			},
+		})
+	}
+	return anomalies
+}
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"testing"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectConfigAnomalies(t *testing.T) {
+	a := common.HexToAddress("0xa000000000000000000000000000000000000001")
+	b := common.HexToAddress("0xa000000000000000000000000000000000000002")
+	c := common.HexToAddress("0xa000000000000000000000000000000000000003")
+
+	assert.Empty(t, DetectConfigAnomalies(&entities.OCR2Config{Transmitters: []common.Address{a, b, c}}))
+
+	config := &entities.OCR2Config{
+		ConfigDigest: [32]byte{0xab},
+		Transmitters: []common.Address{b, a, c, a, b, a},
+	}
+	anomalies := DetectConfigAnomalies(config)
+	require.Len(t, anomalies, 2)
+
+	// Reported in order of first position, each with every index it holds.
+	assert.Equal(t, interfaces.AnomalyTypeDuplicateTransmitter, anomalies[0].Type)
+	assert.Equal(t, interfaces.AnomalySeverityHigh, anomalies[0].Severity)
+	assert.Equal(t, b.Hex(), anomalies[0].Details["transmitter"])
+	assert.Equal(t, []int{0, 4}, anomalies[0].Details["indices"])
+	assert.Equal(t, a.Hex(), anomalies[1].Details["transmitter"])
+	assert.Equal(t, []int{1, 3, 5}, anomalies[1].Details["indices"])
+	assert.Contains(t, anomalies[1].Description, "only index 1 is used")
+
+	index, ok := config.TransmitterIndex(a)
+	require.True(t, ok)
+	assert.Equal(t, uint8(1), index)
+}
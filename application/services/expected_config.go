@@ -0,0 +1,64 @@
+package services
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"chainlink-ocr-checker/domain/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v2"
+)
+
+// expectedConfigFile is the YAML shape of an expected config file.
+type expectedConfigFile struct {
+	Transmitters []string `yaml:"transmitters"`
+	Signers      []string `yaml:"signers"`
+	F            *uint8   `yaml:"f"`
+}
+
+// ReadExpectedConfig reads an expected OCR2 configuration from a YAML file
+// listing transmitters, signers, and optionally f.
+func ReadExpectedConfig(path string) (*entities.ExpectedOCR2Config, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read expected config: %w", err)
+	}
+
+	var file expectedConfigFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse expected config: %w", err)
+	}
+
+	transmitters, err := parseAddressList("transmitters", file.Transmitters)
+	if err != nil {
+		return nil, err
+	}
+	signers, err := parseAddressList("signers", file.Signers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.ExpectedOCR2Config{
+		Transmitters: transmitters,
+		Signers:      signers,
+		F:            file.F,
+	}, nil
+}
+
+// parseAddressList converts the hex addresses of an expected config field.
+// A missing field stays nil so it is not compared.
+func parseAddressList(field string, values []string) ([]common.Address, error) {
+	if values == nil {
+		return nil, nil
+	}
+
+	addresses := make([]common.Address, 0, len(values))
+	for _, value := range values {
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("invalid address in %s: %s", field, value)
+		}
+		addresses = append(addresses, common.HexToAddress(value))
+	}
+	return addresses, nil
+}
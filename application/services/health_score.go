@@ -0,0 +1,17 @@
+// Package services provides business logic services for the OCR checker application.
+// It contains analyzers and other services that operate on domain entities.
+package services
+
+import "strconv"
+
+// DefaultHealthScorePrecision is the default number of decimals shown for health scores.
+const DefaultHealthScorePrecision = 1
+
+// FormatHealthScore formats a health score percentage for human-readable output.
+// Machine-readable outputs should carry the raw float instead.
+func FormatHealthScore(score float64, precision int) string {
+	if precision < 0 {
+		precision = DefaultHealthScorePrecision
+	}
+	return strconv.FormatFloat(score, 'f', precision, 64) + "%"
+}
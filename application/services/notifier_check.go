@@ -0,0 +1,88 @@
+// Package services provides business logic services for the OCR checker application.
+// It contains analyzers and other services that operate on domain entities.
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"chainlink-ocr-checker/domain/interfaces"
+)
+
+// NotifierCheckResult represents the outcome of a test notification for one backend.
+// Skipped is set when the backend was not tried.
+type NotifierCheckResult struct {
+	Name    string
+	Err     error
+	Skipped bool
+}
+
+// NotifierCheckOptions controls how notifiers are checked.
+type NotifierCheckOptions struct {
+	// Incidents also checks the notifiers that open incidents, by opening a
+	// test incident and resolving it right away. Without it they are skipped.
+	Incidents bool
+}
+
+// incidentNotifiers are the backends whose alerts open an incident that stays
+// open until it is resolved.
+var incidentNotifiers = map[string]bool{"pagerduty": true}
+
+// TestNotification returns the benign message used to verify notifier delivery.
+func TestNotification() interfaces.Notification {
+	return interfaces.Notification{
+		Title:    "OCR Checker notification test",
+		Severity: interfaces.NotificationSeverityInfo,
+		Summary:  "This is a test message from ocr-checker. No action is required.",
+	}
+}
+
+// CheckNotifiers sends a test notification through every notifier.
+// A failing backend does not prevent the remaining ones from being tried.
+func CheckNotifiers(
+	ctx context.Context,
+	notifiers []interfaces.Notifier,
+	opts NotifierCheckOptions,
+) []NotifierCheckResult {
+	notification := TestNotification()
+	results := make([]NotifierCheckResult, 0, len(notifiers))
+
+	// Each run gets its own incident, so an earlier run's dedup state cannot
+	// swallow it.
+	dedupKey := fmt.Sprintf("ocr-checker/notify-test/%d", time.Now().UnixNano())
+
+	for _, notifier := range notifiers {
+		result := NotifierCheckResult{Name: notifier.Name()}
+		switch {
+		case !incidentNotifiers[result.Name]:
+			result.Err = notifier.Notify(ctx, notification)
+		case opts.Incidents:
+			result.Err = checkIncident(ctx, notifier, dedupKey)
+		default:
+			result.Skipped = true
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// checkIncident opens a test incident under dedupKey and resolves it again.
+func checkIncident(ctx context.Context, notifier interfaces.Notifier, dedupKey string) error {
+	trigger := TestNotification()
+	trigger.Severity = interfaces.NotificationSeverityWarning
+	trigger.DedupKey = dedupKey
+	if err := notifier.Notify(ctx, trigger); err != nil {
+		return err
+	}
+
+	// An info notification with the same dedup key resolves the incident.
+	resolve := TestNotification()
+	resolve.DedupKey = dedupKey
+	if err := notifier.Notify(ctx, resolve); err != nil {
+		return fmt.Errorf("test incident %s was opened but not resolved: %w", dedupKey, err)
+	}
+
+	return nil
+}
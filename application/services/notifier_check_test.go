@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckNotifiers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	sendErr := errors.New("smtp unavailable")
+
+	email := mocks.NewMockNotifier(ctrl)
+	email.EXPECT().Name().Return("email")
+	email.EXPECT().Notify(ctx, TestNotification()).Return(sendErr)
+
+	other := mocks.NewMockNotifier(ctrl)
+	other.EXPECT().Name().Return("other")
+	other.EXPECT().Notify(ctx, TestNotification()).Return(nil)
+
+	results := CheckNotifiers(ctx, []interfaces.Notifier{email, other}, NotifierCheckOptions{})
+
+	require.Len(t, results, 2)
+	assert.Equal(t, "email", results[0].Name)
+	assert.ErrorIs(t, results[0].Err, sendErr)
+	assert.Equal(t, "other", results[1].Name)
+	assert.NoError(t, results[1].Err)
+}
+
+func TestCheckNotifiers_None(t *testing.T) {
+	results := CheckNotifiers(context.Background(), nil, NotifierCheckOptions{})
+	assert.Empty(t, results)
+}
+
+func TestCheckNotifiers_Incidents(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("skipped by default", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		pagerDuty := mocks.NewMockNotifier(ctrl)
+		pagerDuty.EXPECT().Name().Return("pagerduty")
+
+		results := CheckNotifiers(ctx, []interfaces.Notifier{pagerDuty}, NotifierCheckOptions{})
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Skipped)
+		assert.NoError(t, results[0].Err)
+	})
+
+	t.Run("opens and resolves a test incident", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		pagerDuty := mocks.NewMockNotifier(ctrl)
+		pagerDuty.EXPECT().Name().Return("pagerduty")
+
+		var sent []interfaces.Notification
+		pagerDuty.EXPECT().Notify(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, notification interfaces.Notification) error {
+				sent = append(sent, notification)
+				return nil
+			}).Times(2)
+
+		results := CheckNotifiers(ctx, []interfaces.Notifier{pagerDuty}, NotifierCheckOptions{Incidents: true})
+		require.Len(t, results, 1)
+		assert.False(t, results[0].Skipped)
+		assert.NoError(t, results[0].Err)
+
+		require.Len(t, sent, 2)
+		assert.NotEmpty(t, sent[0].DedupKey)
+		assert.Equal(t, interfaces.NotificationSeverityWarning, sent[0].Severity)
+		assert.Equal(t, sent[0].DedupKey, sent[1].DedupKey)
+		assert.Equal(t, interfaces.NotificationSeverityInfo, sent[1].Severity)
+	})
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"math"
+
+	"chainlink-ocr-checker/domain/entities"
+)
+
+// UnevenParticipationCV is the participation coefficient of variation above
+// which a feed is flagged as uneven: the per-observer totals then typically
+// stray from their mean by more than half of it.
+const UnevenParticipationCV = 0.5
+
+// ParticipationCV returns the coefficient of variation of the observers'
+// transmission totals, their standard deviation over their mean. It is 0 when
+// every observer sent the same number, and 0 without any activity.
+func ParticipationCV(activities []entities.ObserverActivity) float64 {
+	if len(activities) == 0 {
+		return 0
+	}
+
+	var total float64
+	for _, activity := range activities {
+		total += float64(activity.TotalCount)
+	}
+	mean := total / float64(len(activities))
+	if mean == 0 {
+		return 0
+	}
+
+	var squares float64
+	for _, activity := range activities {
+		diff := float64(activity.TotalCount) - mean
+		squares += diff * diff
+	}
+
+	return math.Sqrt(squares/float64(len(activities))) / mean
+}
+
+// IsParticipationUneven reports whether a participation coefficient of
+// variation exceeds UnevenParticipationCV.
+func IsParticipationUneven(cv float64) bool {
+	return cv > UnevenParticipationCV
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"testing"
+
+	"chainlink-ocr-checker/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParticipationCV(t *testing.T) {
+	activities := func(totals ...int) []entities.ObserverActivity {
+		result := make([]entities.ObserverActivity, 0, len(totals))
+		for i, total := range totals {
+			result = append(result, entities.ObserverActivity{ObserverIndex: uint8(i), TotalCount: total})
+		}
+		return result
+	}
+
+	t.Run("even", func(t *testing.T) {
+		cv := ParticipationCV(activities(10, 10, 10, 10))
+		assert.InDelta(t, 0, cv, 1e-9)
+		assert.False(t, IsParticipationUneven(cv))
+	})
+
+	t.Run("skewed", func(t *testing.T) {
+		// Mean 17.5, standard deviation sqrt(168.75).
+		cv := ParticipationCV(activities(40, 10, 10, 10))
+		assert.InDelta(t, 0.742, cv, 0.001)
+		assert.True(t, IsParticipationUneven(cv))
+	})
+
+	t.Run("no activity", func(t *testing.T) {
+		assert.Zero(t, ParticipationCV(nil))
+		assert.Zero(t, ParticipationCV(activities(0, 0)))
+	})
+}
@@ -0,0 +1,170 @@
+package services
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"chainlink-ocr-checker/domain/entities"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ArchiveState is the checkpoint of an archive in progress. Size is the length
+// of the archive file once the chunk before NextBlock was written; anything
+// past it is a partial chunk and is cut off on resume.
+type ArchiveState struct {
+	ContractAddress common.Address `json:"contract_address"`
+	OutputPath      string         `json:"output_path"`
+	FromBlock       uint64         `json:"from_block"`
+	NextBlock       uint64         `json:"next_block"`
+	Size            int64          `json:"size"`
+	Transmissions   int            `json:"transmissions"`
+}
+
+// ReadArchiveState reads an archive checkpoint. A missing file yields a nil
+// state and no error, so a first run and a resume share one code path.
+func ReadArchiveState(path string) (*ArchiveState, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive state: %w", err)
+	}
+
+	var state ArchiveState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to decode archive state %s: %w", path, err)
+	}
+	return &state, nil
+}
+
+// WriteArchiveState replaces an archive checkpoint atomically.
+func WriteArchiveState(path string, state *ArchiveState) error {
+	return writeFileAtomic(filepath.Clean(path), func(w io.Writer) error {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(state)
+	})
+}
+
+// ArchiveWriter appends transmissions to a JSON-lines archive, one chunk at a
+// time. In gzipped archives every chunk is its own gzip member, so the file is
+// a valid gzip stream after each chunk and can be cut back to any chunk end.
+type ArchiveWriter struct {
+	file     *os.File
+	compress bool
+	size     int64
+}
+
+// OpenArchive opens the archive at path for appending after size bytes. A
+// zero size starts a new archive with the header line; otherwise the file is
+// truncated to size, dropping a chunk left partly written by an interruption.
+func OpenArchive(path string, contractAddress common.Address, size int64) (*ArchiveWriter, error) {
+	cleanPath := filepath.Clean(path)
+	if err := os.MkdirAll(filepath.Dir(cleanPath), 0750); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	flags := os.O_RDWR | os.O_CREATE
+	if size == 0 {
+		flags |= os.O_TRUNC
+	}
+	file, err := os.OpenFile(cleanPath, flags, 0600) // #nosec G304 -- path is cleaned
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive: %w", err)
+	}
+
+	w := &ArchiveWriter{file: file, compress: isGzipPath(cleanPath)}
+	if size > 0 {
+		info, err := file.Stat()
+		if err != nil {
+			_ = file.Close()
+			return nil, fmt.Errorf("failed to stat archive: %w", err)
+		}
+		if info.Size() < size {
+			_ = file.Close()
+			return nil, fmt.Errorf("archive %s has %d bytes, fewer than the %d checkpointed",
+				cleanPath, info.Size(), size)
+		}
+		if err := file.Truncate(size); err != nil {
+			_ = file.Close()
+			return nil, fmt.Errorf("failed to truncate archive: %w", err)
+		}
+		if _, err := file.Seek(size, io.SeekStart); err != nil {
+			_ = file.Close()
+			return nil, fmt.Errorf("failed to seek archive: %w", err)
+		}
+		w.size = size
+		return w, nil
+	}
+
+	if err := w.appendLines([]interface{}{jsonLinesHeader{ContractAddress: contractAddress}}); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// Append writes a chunk of transmissions and syncs it to disk.
+func (w *ArchiveWriter) Append(transmissions []entities.Transmission) error {
+	lines := make([]interface{}, len(transmissions))
+	for i := range transmissions {
+		lines[i] = transmissions[i]
+	}
+	return w.appendLines(lines)
+}
+
+// Size returns the length of the archive after the last complete chunk.
+func (w *ArchiveWriter) Size() int64 {
+	return w.size
+}
+
+// Close closes the archive file.
+func (w *ArchiveWriter) Close() error {
+	return w.file.Close()
+}
+
+// appendLines writes values as JSON lines, gzipped as one member when the
+// archive is compressed, and syncs them before advancing the size.
+func (w *ArchiveWriter) appendLines(lines []interface{}) error {
+	counter := &countingWriter{w: w.file}
+	buffered := bufio.NewWriter(counter)
+	out := io.Writer(buffered)
+
+	var gz *gzip.Writer
+	if w.compress {
+		gz = gzip.NewWriter(buffered)
+		out = gz
+	}
+
+	for _, v := range lines {
+		line, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("failed to encode results: %w", err)
+		}
+		if _, err := out.Write(append(line, '\n')); err != nil {
+			return fmt.Errorf("failed to write results: %w", err)
+		}
+	}
+
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return fmt.Errorf("failed to finish gzip stream: %w", err)
+		}
+	}
+	if err := buffered.Flush(); err != nil {
+		return fmt.Errorf("failed to write results: %w", err)
+	}
+	if err := w.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync archive: %w", err)
+	}
+
+	w.size += counter.n
+	return nil
+}
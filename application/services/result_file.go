@@ -0,0 +1,284 @@
+// Package services provides business logic services for the OCR checker application.
+// It contains analyzers and other services that operate on domain entities.
+package services
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"gopkg.in/yaml.v2"
+)
+
+// gzipExt is the extension that selects gzip compression for result files.
+const gzipExt = ".gz"
+
+// ResultFileFormat returns the encoding used for a result file based on its extension.
+// A trailing .gz extension is ignored.
+func ResultFileFormat(path string) interfaces.OutputFormat {
+	if isGzipPath(path) {
+		path = path[:len(path)-len(gzipExt)]
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return interfaces.OutputFormatJSON
+	case ".jsonl":
+		return interfaces.OutputFormatJSONL
+	case ".protobuf", ".pb":
+		return interfaces.OutputFormatProtobuf
+	default:
+		return interfaces.OutputFormatYAML
+	}
+}
+
+// ReadTransmissionResult reads a transmission result saved by the fetch command.
+// Gzipped files are decompressed transparently. The encoding is detected from
+// the file content and returned alongside the result; JSON-lines and protobuf
+// files are recognized by their .jsonl and .protobuf or .pb extensions.
+func ReadTransmissionResult(path string) (*entities.TransmissionResult, interfaces.OutputFormat, error) {
+	cleanPath := filepath.Clean(path)
+	file, err := os.Open(cleanPath) // #nosec G304 -- path is cleaned
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	reader := bufio.NewReader(file)
+	if isGzipContent(reader) {
+		gz, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to open gzip stream: %w", err)
+		}
+		defer func() { _ = gz.Close() }()
+		reader = bufio.NewReader(gz)
+	}
+
+	if ResultFileFormat(cleanPath) == interfaces.OutputFormatJSONL {
+		result, err := decodeJSONLines(reader)
+		if err != nil {
+			return nil, "", err
+		}
+		return result, interfaces.OutputFormatJSONL, nil
+	}
+
+	if ResultFileFormat(cleanPath) == interfaces.OutputFormatProtobuf {
+		result, err := decodeProtobuf(reader)
+		if err != nil {
+			return nil, "", err
+		}
+		return result, interfaces.OutputFormatProtobuf, nil
+	}
+
+	format := interfaces.OutputFormatYAML
+	if isJSONContent(reader) {
+		format = interfaces.OutputFormatJSON
+	}
+
+	var result entities.TransmissionResult
+	if format == interfaces.OutputFormatJSON {
+		if err := json.NewDecoder(reader).Decode(&result); err != nil {
+			return nil, "", fmt.Errorf("failed to decode JSON: %w", err)
+		}
+		return &result, format, nil
+	}
+
+	if err := yaml.NewDecoder(reader).Decode(&result); err != nil {
+		return nil, "", fmt.Errorf("failed to decode YAML: %w", err)
+	}
+
+	return &result, format, nil
+}
+
+// isGzipContent reports whether the buffered content starts with the gzip magic bytes.
+func isGzipContent(reader *bufio.Reader) bool {
+	magic, err := reader.Peek(2)
+	return err == nil && magic[0] == 0x1f && magic[1] == 0x8b
+}
+
+// isGzipPath reports whether the path selects gzip compression.
+func isGzipPath(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), gzipExt)
+}
+
+// isJSONContent reports whether the buffered content starts with a JSON object.
+func isJSONContent(reader *bufio.Reader) bool {
+	for n := 1; ; n++ {
+		peek, err := reader.Peek(n)
+		if err != nil {
+			return false
+		}
+		switch c := peek[n-1]; c {
+		case ' ', '\t', '\r', '\n':
+			continue
+		default:
+			return c == '{'
+		}
+	}
+}
+
+// WriteTransmissionResult saves a transmission result in the given format,
+// gzip-compressed when the path ends in .gz. The result is written to a temporary file first so an existing file is
+// only replaced once encoding has succeeded. A stale round index next to the file is removed.
+func WriteTransmissionResult(
+	path string,
+	result *entities.TransmissionResult,
+	format interfaces.OutputFormat,
+) error {
+	return WriteProjectedTransmissionResult(path, result, format, ProjectionFull)
+}
+
+// WriteProjectedTransmissionResult saves a transmission result like
+// WriteTransmissionResult, keeping only the transmission fields of projection.
+func WriteProjectedTransmissionResult(
+	path string,
+	result *entities.TransmissionResult,
+	format interfaces.OutputFormat,
+	projection TransmissionProjection,
+) error {
+	if err := checkProjection(format, projection); err != nil {
+		return err
+	}
+
+	switch format {
+	case interfaces.OutputFormatJSON, interfaces.OutputFormatYAML, interfaces.OutputFormatProtobuf:
+	case interfaces.OutputFormatJSONL:
+		cleanPath := filepath.Clean(path)
+		err := writeFileAtomic(cleanPath, func(w io.Writer) error {
+			_, err := encodeJSONLines(w, result, projection, isGzipPath(cleanPath))
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return removeTransmissionIndex(cleanPath)
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+
+	cleanPath := filepath.Clean(path)
+	return writeFileAtomic(cleanPath, func(w io.Writer) error {
+		return encodeTransmissionResult(w, result, format, projection, isGzipPath(cleanPath))
+	})
+}
+
+// EncodeTransmissionResult writes a transmission result to w in the given format, uncompressed.
+func EncodeTransmissionResult(
+	w io.Writer,
+	result *entities.TransmissionResult,
+	format interfaces.OutputFormat,
+) error {
+	return EncodeProjectedTransmissionResult(w, result, format, ProjectionFull)
+}
+
+// EncodeProjectedTransmissionResult writes a transmission result like
+// EncodeTransmissionResult, keeping only the transmission fields of projection.
+func EncodeProjectedTransmissionResult(
+	w io.Writer,
+	result *entities.TransmissionResult,
+	format interfaces.OutputFormat,
+	projection TransmissionProjection,
+) error {
+	if err := checkProjection(format, projection); err != nil {
+		return err
+	}
+
+	switch format {
+	case interfaces.OutputFormatJSON, interfaces.OutputFormatYAML, interfaces.OutputFormatProtobuf:
+		return encodeTransmissionResult(w, result, format, projection, false)
+	case interfaces.OutputFormatJSONL:
+		_, err := encodeJSONLines(w, result, projection, false)
+		return err
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// checkProjection rejects unknown projections and projections of protobuf
+// output, whose schema has no optional fields.
+func checkProjection(format interfaces.OutputFormat, projection TransmissionProjection) error {
+	if err := ValidateProjection(projection); err != nil {
+		return err
+	}
+	if projection != ProjectionFull && format == interfaces.OutputFormatProtobuf {
+		return fmt.Errorf("the %s projection is not supported for protobuf output", projection)
+	}
+	return nil
+}
+
+// writeFileAtomic writes a file through a temporary file in the same directory
+// and renames it into place once write has succeeded.
+func writeFileAtomic(path string, write func(w io.Writer) error) error {
+	// Create directory if needed.
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer func() { _ = os.Remove(tmpPath) }()
+
+	if err := write(tmp); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close file: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to replace file: %w", err)
+	}
+
+	return nil
+}
+
+// encodeTransmissionResult encodes a transmission result, optionally gzip-compressed.
+func encodeTransmissionResult(
+	w io.Writer,
+	result *entities.TransmissionResult,
+	format interfaces.OutputFormat,
+	projection TransmissionProjection,
+	compress bool,
+) error {
+	var gz *gzip.Writer
+	if compress {
+		gz = gzip.NewWriter(w)
+		w = gz
+	}
+
+	// Encode based on format.
+	var err error
+	switch format {
+	case interfaces.OutputFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(projectResult(result, projection))
+	case interfaces.OutputFormatProtobuf:
+		err = encodeProtobuf(w, result)
+	default:
+		err = yaml.NewEncoder(w).Encode(projectResult(result, projection))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to encode results: %w", err)
+	}
+
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return fmt.Errorf("failed to finish gzip stream: %w", err)
+		}
+	}
+
+	return nil
+}
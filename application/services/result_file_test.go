@@ -0,0 +1,266 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func testTransmissionResult() *entities.TransmissionResult {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	return &entities.TransmissionResult{
+		ContractAddress: contract,
+		StartRound:      1,
+		EndRound:        2,
+		Transmissions: []entities.Transmission{
+			{
+				ContractAddress:    contract,
+				ConfigDigest:       [32]byte{1},
+				Epoch:              1,
+				Round:              1,
+				TransmitterAddress: common.HexToAddress("0xa000000000000000000000000000000000000000"),
+				ObserverIndex:      3,
+				BlockNumber:        100,
+				BlockTimestamp:     time.Unix(1700000000, 0).UTC(),
+			},
+			{
+				ContractAddress:    contract,
+				ConfigDigest:       [32]byte{1},
+				Epoch:              1,
+				Round:              2,
+				TransmitterAddress: common.HexToAddress("0xb000000000000000000000000000000000000000"),
+				ObserverIndex:      4,
+				BlockNumber:        101,
+				BlockTimestamp:     time.Unix(1700000012, 0).UTC(),
+			},
+		},
+	}
+}
+
+func TestTransmissionResultFile_Gzip(t *testing.T) {
+	tests := []struct {
+		name   string
+		file   string
+		format interfaces.OutputFormat
+	}{
+		{name: "yaml", file: "results.yaml.gz", format: interfaces.OutputFormatYAML},
+		{name: "json", file: "results.json.gz", format: interfaces.OutputFormatJSON},
+		{name: "protobuf", file: "results.pb.gz", format: interfaces.OutputFormatProtobuf},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			expected := testTransmissionResult()
+
+			require.Equal(t, tt.format, ResultFileFormat(path))
+			require.NoError(t, WriteTransmissionResult(path, expected, tt.format))
+
+			// The file on disk must be gzip-compressed.
+			raw, err := os.ReadFile(path) // #nosec G304 -- test path
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, len(raw), 2)
+			assert.Equal(t, []byte{0x1f, 0x8b}, raw[:2])
+
+			actual, format, err := ReadTransmissionResult(path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.format, format)
+			assert.Equal(t, expected.ContractAddress, actual.ContractAddress)
+			require.Len(t, actual.Transmissions, len(expected.Transmissions))
+			for i := range expected.Transmissions {
+				assert.Equal(t, expected.Transmissions[i].TransmitterAddress, actual.Transmissions[i].TransmitterAddress)
+				assert.Equal(t, expected.Transmissions[i].ObserverIndex, actual.Transmissions[i].ObserverIndex)
+				assert.True(t, expected.Transmissions[i].BlockTimestamp.Equal(actual.Transmissions[i].BlockTimestamp))
+			}
+		})
+	}
+}
+
+func TestReadTransmissionResult_GzipWithoutExtension(t *testing.T) {
+	dir := t.TempDir()
+	compressed := filepath.Join(dir, "results.yaml.gz")
+	require.NoError(t, WriteTransmissionResult(compressed, testTransmissionResult(), interfaces.OutputFormatYAML))
+
+	// Gzip input is detected from its magic bytes, not only the extension.
+	renamed := filepath.Join(dir, "results.dat")
+	require.NoError(t, os.Rename(compressed, renamed))
+
+	actual, format, err := ReadTransmissionResult(renamed)
+	require.NoError(t, err)
+	assert.Equal(t, interfaces.OutputFormatYAML, format)
+	assert.Len(t, actual.Transmissions, 2)
+}
+
+func TestEncodeTransmissionResult_RoundRepresentations(t *testing.T) {
+	result := &entities.TransmissionResult{
+		Transmissions: []entities.Transmission{
+			{Epoch: 4660, Round: 7, AggregatorRoundID: 981},
+		},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, EncodeTransmissionResult(&buf, result, interfaces.OutputFormatJSON))
+
+		var decoded struct {
+			Transmissions []struct {
+				Epoch             uint32
+				Round             uint32
+				PackedRound       uint32
+				AggregatorRoundID uint32
+			}
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		require.Len(t, decoded.Transmissions, 1)
+
+		tx := decoded.Transmissions[0]
+		assert.Equal(t, uint32(4660), tx.Epoch)
+		assert.Equal(t, uint32(7), tx.Round)
+		assert.Equal(t, uint32(981), tx.AggregatorRoundID)
+		assert.Equal(t, tx.Epoch<<8|tx.Round, tx.PackedRound)
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, EncodeTransmissionResult(&buf, result, interfaces.OutputFormatYAML))
+
+		var decoded struct {
+			Transmissions []map[string]interface{}
+		}
+		require.NoError(t, yaml.Unmarshal(buf.Bytes(), &decoded))
+		require.Len(t, decoded.Transmissions, 1)
+
+		tx := decoded.Transmissions[0]
+		assert.Equal(t, 4660, tx["epoch"])
+		assert.Equal(t, 7, tx["round"])
+		assert.Equal(t, 981, tx["aggregatorroundid"])
+		assert.Equal(t, 4660<<8|7, tx["packedround"])
+	})
+
+	t.Run("round trip ignores packed round", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "result.json")
+		require.NoError(t, WriteTransmissionResult(path, result, interfaces.OutputFormatJSON))
+
+		decoded, _, err := ReadTransmissionResult(path)
+		require.NoError(t, err)
+		assert.Equal(t, result.Transmissions[0].PackedRound(), decoded.Transmissions[0].PackedRound())
+		assert.Equal(t, uint32(981), decoded.Transmissions[0].AggregatorRoundID)
+	})
+}
+
+func TestWriteProjectedTransmissionResult(t *testing.T) {
+	result := testTransmissionResult()
+	result.Transmissions[0].Observers = []uint8{0, 3}
+	result.Transmissions[0].ObserverCount = 2
+
+	tests := []struct {
+		projection TransmissionProjection
+		kept       []string
+		omitted    []string
+	}{
+		{
+			projection: ProjectionObservers,
+			kept:       []string{"ContractAddress", "Epoch", "Round", "Observers", "ObserverCount", "BlockTimestamp"},
+			omitted:    []string{"TransmitterAddress", "TransmitterIndex", "ObserverIndex"},
+		},
+		{
+			projection: ProjectionTransmitters,
+			kept:       []string{"ContractAddress", "Epoch", "Round", "TransmitterAddress", "ObserverIndex"},
+			omitted:    []string{"Observers", "ObserverCount", "MetQuorum"},
+		},
+		{
+			projection: ProjectionMinimal,
+			kept:       []string{"Epoch", "Round", "PackedRound", "TransmitterAddress", "BlockNumber"},
+			omitted:    []string{"ContractAddress", "ConfigDigest", "Observers", "ObserverIndex", "BlockTimestamp"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.projection), func(t *testing.T) {
+			for _, format := range []interfaces.OutputFormat{interfaces.OutputFormatJSON, interfaces.OutputFormatJSONL} {
+				var buf bytes.Buffer
+				require.NoError(t, EncodeProjectedTransmissionResult(&buf, result, format, tt.projection))
+
+				// JSON lines output starts with the header line.
+				var decoded map[string]interface{}
+				if format == interfaces.OutputFormatJSONL {
+					lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+					require.Len(t, lines, 3)
+					require.NoError(t, json.Unmarshal(lines[1], &decoded))
+				} else {
+					var file struct{ Transmissions []map[string]interface{} }
+					require.NoError(t, json.Unmarshal(buf.Bytes(), &file))
+					require.Len(t, file.Transmissions, 2)
+					decoded = file.Transmissions[0]
+				}
+
+				for _, field := range tt.kept {
+					assert.Contains(t, decoded, field, format)
+				}
+				for _, field := range tt.omitted {
+					assert.NotContains(t, decoded, field, format)
+				}
+			}
+
+			// Projected files read back with the omitted fields zeroed.
+			path := filepath.Join(t.TempDir(), "result.yaml")
+			require.NoError(t, WriteProjectedTransmissionResult(path, result, interfaces.OutputFormatYAML, tt.projection))
+			actual, _, err := ReadTransmissionResult(path)
+			require.NoError(t, err)
+			require.Len(t, actual.Transmissions, 2)
+			assert.Equal(t, result.Transmissions[0].Epoch, actual.Transmissions[0].Epoch)
+			assert.Equal(t, result.Transmissions[0].Round, actual.Transmissions[0].Round)
+			assert.Equal(t, result.Transmissions[0].BlockNumber, actual.Transmissions[0].BlockNumber)
+		})
+	}
+
+	t.Run("protobuf", func(t *testing.T) {
+		err := EncodeProjectedTransmissionResult(&bytes.Buffer{}, result, interfaces.OutputFormatProtobuf, ProjectionMinimal)
+		assert.ErrorContains(t, err, "not supported for protobuf")
+	})
+}
+
+func TestEncodeTransmissionResult_JuelsPerFeeCoin(t *testing.T) {
+	result := &entities.TransmissionResult{
+		Transmissions: []entities.Transmission{
+			{Epoch: 1, Round: 1, LatestAnswer: big.NewInt(100), JuelsPerFeeCoin: big.NewInt(4200000000)},
+			{Epoch: 1, Round: 2, LatestAnswer: big.NewInt(101)},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, EncodeTransmissionResult(&buf, result, interfaces.OutputFormatJSON))
+	var decoded struct{ Transmissions []map[string]interface{} }
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Len(t, decoded.Transmissions, 2)
+	assert.Equal(t, 4200000000.0, decoded.Transmissions[0]["JuelsPerFeeCoin"])
+	assert.NotContains(t, decoded.Transmissions[1], "JuelsPerFeeCoin")
+
+	// Every format reads the value back, and leaves it nil when it was not captured.
+	for _, format := range []interfaces.OutputFormat{
+		interfaces.OutputFormatJSON,
+		interfaces.OutputFormatJSONL,
+		interfaces.OutputFormatYAML,
+		interfaces.OutputFormatProtobuf,
+	} {
+		path := filepath.Join(t.TempDir(), "result."+string(format))
+		require.NoError(t, WriteTransmissionResult(path, result, format))
+
+		actual, _, err := ReadTransmissionResult(path)
+		require.NoError(t, err)
+		require.Len(t, actual.Transmissions, 2)
+		assert.Equal(t, big.NewInt(4200000000), actual.Transmissions[0].JuelsPerFeeCoin, format)
+		assert.Nil(t, actual.Transmissions[1].JuelsPerFeeCoin, format)
+	}
+}
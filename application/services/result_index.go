@@ -0,0 +1,407 @@
+package services
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"chainlink-ocr-checker/domain/entities"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// TransmissionIndexExt is appended to a JSON-lines result path to name its round index.
+	TransmissionIndexExt = ".idx"
+
+	// transmissionIndexBlockSize is the number of transmissions covered by one index entry.
+	transmissionIndexBlockSize = 256
+
+	// transmissionIndexVersion is the first line of every index file.
+	transmissionIndexVersion = "ocr-checker round index v1"
+)
+
+// jsonLinesHeader is the first line of a JSON-lines result file.
+type jsonLinesHeader struct {
+	ContractAddress      common.Address
+	StartRound           uint32
+	EndRound             uint32
+	DistinctTransmitters []entities.TransmitterCount
+}
+
+// transmissionIndexEntry maps a block of consecutive transmissions to its byte offset.
+// In gzipped files each block is its own gzip member, so the offset is a valid
+// starting point for decompression.
+type transmissionIndexEntry struct {
+	MinRound uint32
+	MaxRound uint32
+	Offset   int64
+	Count    int
+}
+
+// countingWriter tracks the number of bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// TransmissionIndexPath returns the path of the round index for a result file.
+func TransmissionIndexPath(path string) string {
+	return filepath.Clean(path) + TransmissionIndexExt
+}
+
+// HasTransmissionIndex reports whether a round index exists next to the result file.
+func HasTransmissionIndex(path string) bool {
+	_, err := os.Stat(TransmissionIndexPath(path))
+	return err == nil
+}
+
+// WriteIndexedTransmissionResult saves a transmission result as JSON lines,
+// gzip-compressed when the path ends in .gz, and writes a round index next to it.
+// The output is deterministic for a given result.
+func WriteIndexedTransmissionResult(path string, result *entities.TransmissionResult) error {
+	return WriteIndexedProjectedTransmissionResult(path, result, ProjectionFull)
+}
+
+// WriteIndexedProjectedTransmissionResult saves a transmission result like
+// WriteIndexedTransmissionResult, keeping only the transmission fields of projection.
+func WriteIndexedProjectedTransmissionResult(
+	path string,
+	result *entities.TransmissionResult,
+	projection TransmissionProjection,
+) error {
+	if err := ValidateProjection(projection); err != nil {
+		return err
+	}
+	cleanPath := filepath.Clean(path)
+
+	var entries []transmissionIndexEntry
+	var size int64
+	err := writeFileAtomic(cleanPath, func(w io.Writer) error {
+		counter := &countingWriter{w: w}
+		var err error
+		entries, err = encodeJSONLines(counter, result, projection, isGzipPath(cleanPath))
+		size = counter.n
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(TransmissionIndexPath(cleanPath), func(w io.Writer) error {
+		return writeTransmissionIndex(w, size, entries)
+	})
+}
+
+// ReadTransmissionRange reads the transmissions whose round falls within
+// [startRound, endRound]. When the file has a round index only the blocks that
+// overlap the range are read; otherwise the whole file is read and filtered.
+func ReadTransmissionRange(path string, startRound, endRound uint32) (*entities.TransmissionResult, error) {
+	cleanPath := filepath.Clean(path)
+
+	size, entries, err := readTransmissionIndexFile(TransmissionIndexPath(cleanPath))
+	if errors.Is(err, os.ErrNotExist) {
+		result, _, err := ReadTransmissionResult(cleanPath)
+		if err != nil {
+			return nil, err
+		}
+		return filterRoundRange(result, result.Transmissions, startRound, endRound), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(cleanPath) // #nosec G304 -- path is cleaned
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+	if info.Size() != size {
+		return nil, fmt.Errorf("round index %s is stale (indexed %d bytes, file has %d)",
+			TransmissionIndexPath(cleanPath), size, info.Size())
+	}
+
+	return readIndexedRange(file, entries, startRound, endRound)
+}
+
+// encodeJSONLines writes a header line followed by one transmission per line
+// and returns the index entries for the written blocks.
+func encodeJSONLines(
+	w io.Writer,
+	result *entities.TransmissionResult,
+	projection TransmissionProjection,
+	compress bool,
+) ([]transmissionIndexEntry, error) {
+	counter := &countingWriter{w: w}
+	out := io.Writer(counter)
+
+	// startSegment begins a new gzip member so it can be decompressed from its own offset.
+	var gz *gzip.Writer
+	startSegment := func() error {
+		if !compress {
+			return nil
+		}
+		if gz != nil {
+			if err := gz.Close(); err != nil {
+				return fmt.Errorf("failed to finish gzip stream: %w", err)
+			}
+		}
+		gz = gzip.NewWriter(counter)
+		out = gz
+		return nil
+	}
+
+	writeLine := func(v interface{}) error {
+		line, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("failed to encode results: %w", err)
+		}
+		if _, err := out.Write(append(line, '\n')); err != nil {
+			return fmt.Errorf("failed to write results: %w", err)
+		}
+		return nil
+	}
+
+	if err := startSegment(); err != nil {
+		return nil, err
+	}
+	err := writeLine(jsonLinesHeader{
+		ContractAddress:      result.ContractAddress,
+		StartRound:           result.StartRound,
+		EndRound:             result.EndRound,
+		DistinctTransmitters: result.DistinctTransmitters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []transmissionIndexEntry
+	for i, tx := range result.Transmissions {
+		round := tx.Epoch<<8 | uint32(tx.Round)
+
+		if i%transmissionIndexBlockSize == 0 {
+			if err := startSegment(); err != nil {
+				return nil, err
+			}
+			entries = append(entries, transmissionIndexEntry{
+				MinRound: round,
+				MaxRound: round,
+				Offset:   counter.n,
+			})
+		}
+
+		entry := &entries[len(entries)-1]
+		entry.MinRound = min(entry.MinRound, round)
+		entry.MaxRound = max(entry.MaxRound, round)
+		entry.Count++
+
+		if err := writeLine(projectTransmission(&result.Transmissions[i], projection)); err != nil {
+			return nil, err
+		}
+	}
+
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return nil, fmt.Errorf("failed to finish gzip stream: %w", err)
+		}
+	}
+
+	return entries, nil
+}
+
+// decodeJSONLines reads a complete JSON-lines result.
+func decodeJSONLines(r io.Reader) (*entities.TransmissionResult, error) {
+	decoder := json.NewDecoder(r)
+
+	var header jsonLinesHeader
+	if err := decoder.Decode(&header); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON lines header: %w", err)
+	}
+
+	result := &entities.TransmissionResult{
+		ContractAddress:      header.ContractAddress,
+		StartRound:           header.StartRound,
+		EndRound:             header.EndRound,
+		DistinctTransmitters: header.DistinctTransmitters,
+	}
+
+	for {
+		var tx entities.Transmission
+		err := decoder.Decode(&tx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode transmission %d: %w", len(result.Transmissions)+1, err)
+		}
+		result.Transmissions = append(result.Transmissions, tx)
+	}
+
+	return result, nil
+}
+
+// readIndexedRange reads the header and the index blocks that overlap the round range.
+func readIndexedRange(
+	file io.ReadSeeker,
+	entries []transmissionIndexEntry,
+	startRound, endRound uint32,
+) (*entities.TransmissionResult, error) {
+	magic := make([]byte, 2)
+	if _, err := io.ReadFull(file, magic); err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	compressed := magic[0] == 0x1f && magic[1] == 0x8b
+
+	var header jsonLinesHeader
+	segment, err := openSegment(file, 0, compressed)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.NewDecoder(segment).Decode(&header); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON lines header: %w", err)
+	}
+
+	var transmissions []entities.Transmission
+	for _, entry := range entries {
+		if entry.MaxRound < startRound || entry.MinRound > endRound {
+			continue
+		}
+
+		segment, err := openSegment(file, entry.Offset, compressed)
+		if err != nil {
+			return nil, err
+		}
+
+		decoder := json.NewDecoder(segment)
+		for i := 0; i < entry.Count; i++ {
+			var tx entities.Transmission
+			if err := decoder.Decode(&tx); err != nil {
+				return nil, fmt.Errorf("failed to decode transmission at offset %d: %w", entry.Offset, err)
+			}
+			transmissions = append(transmissions, tx)
+		}
+	}
+
+	result := &entities.TransmissionResult{ContractAddress: header.ContractAddress}
+	return filterRoundRange(result, transmissions, startRound, endRound), nil
+}
+
+// openSegment positions the file at offset and returns a reader for the data there.
+func openSegment(file io.ReadSeeker, offset int64, compressed bool) (io.Reader, error) {
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to seek to offset %d: %w", offset, err)
+	}
+
+	if !compressed {
+		return file, nil
+	}
+
+	gz, err := gzip.NewReader(bufio.NewReader(file))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open gzip stream at offset %d: %w", offset, err)
+	}
+	gz.Multistream(false)
+	return gz, nil
+}
+
+// filterRoundRange returns a copy of result holding only the transmissions in the round range.
+func filterRoundRange(
+	result *entities.TransmissionResult,
+	transmissions []entities.Transmission,
+	startRound, endRound uint32,
+) *entities.TransmissionResult {
+	filtered := &entities.TransmissionResult{
+		ContractAddress: result.ContractAddress,
+		StartRound:      startRound,
+		EndRound:        endRound,
+	}
+
+	for _, tx := range transmissions {
+		round := tx.Epoch<<8 | uint32(tx.Round)
+		if round >= startRound && round <= endRound {
+			filtered.Transmissions = append(filtered.Transmissions, tx)
+		}
+	}
+	filtered.DistinctTransmitters = filtered.CountTransmitters()
+
+	return filtered
+}
+
+// writeTransmissionIndex writes the index in its plain-text form:
+// a version line, the indexed file size, then one "min max offset count" line per block.
+func writeTransmissionIndex(w io.Writer, size int64, entries []transmissionIndexEntry) error {
+	var b strings.Builder
+	b.WriteString(transmissionIndexVersion + "\n")
+	_, _ = fmt.Fprintf(&b, "size %d\n", size)
+	for _, entry := range entries {
+		_, _ = fmt.Fprintf(&b, "%d %d %d %d\n", entry.MinRound, entry.MaxRound, entry.Offset, entry.Count)
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write round index: %w", err)
+	}
+	return nil
+}
+
+// readTransmissionIndexFile reads a round index and the file size it was built for.
+func readTransmissionIndexFile(path string) (int64, []transmissionIndexEntry, error) {
+	file, err := os.Open(filepath.Clean(path)) // #nosec G304 -- path is cleaned
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	if !scanner.Scan() || scanner.Text() != transmissionIndexVersion {
+		return 0, nil, fmt.Errorf("unsupported round index: %s", path)
+	}
+
+	var size int64
+	if !scanner.Scan() {
+		return 0, nil, fmt.Errorf("round index %s is missing the file size", path)
+	}
+	if _, err := fmt.Sscanf(scanner.Text(), "size %d", &size); err != nil {
+		return 0, nil, fmt.Errorf("invalid round index size in %s: %w", path, err)
+	}
+
+	var entries []transmissionIndexEntry
+	for scanner.Scan() {
+		var entry transmissionIndexEntry
+		_, err := fmt.Sscanf(scanner.Text(), "%d %d %d %d",
+			&entry.MinRound, &entry.MaxRound, &entry.Offset, &entry.Count)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid round index entry %q: %w", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, nil, fmt.Errorf("failed to read round index: %w", err)
+	}
+
+	return size, entries, nil
+}
+
+// removeTransmissionIndex deletes the round index next to a result file, if any.
+func removeTransmissionIndex(path string) error {
+	err := os.Remove(TransmissionIndexPath(path))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove stale round index: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingReadSeeker records how many bytes are read from the underlying file.
+type countingReadSeeker struct {
+	io.ReadSeeker
+	read int64
+}
+
+func (c *countingReadSeeker) Read(p []byte) (int, error) {
+	n, err := c.ReadSeeker.Read(p)
+	c.read += int64(n)
+	return n, err
+}
+
+// largeTransmissionResult builds a result with one transmission per round
+// for rounds 1 through count.
+func largeTransmissionResult(count int) *entities.TransmissionResult {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	result := &entities.TransmissionResult{ContractAddress: contract, StartRound: 1, EndRound: uint32(count)} // #nosec G115 -- test size
+	for i := 1; i <= count; i++ {
+		round := uint32(i) // #nosec G115 -- test size
+		result.Transmissions = append(result.Transmissions, entities.Transmission{
+			ContractAddress:    contract,
+			Epoch:              round >> 8,
+			Round:              uint8(round & 0xff),
+			TransmitterAddress: common.BigToAddress(common.Big1),
+			ObserverIndex:      uint8(i % 4), // #nosec G115 -- bounded
+			BlockNumber:        uint64(1000 + i),
+		})
+	}
+	return result
+}
+
+func TestIndexedTransmissionResult_ReadRange(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+	}{
+		{name: "plain", file: "results.jsonl"},
+		{name: "gzip", file: "results.jsonl.gz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			expected := largeTransmissionResult(10000)
+
+			require.Equal(t, interfaces.OutputFormatJSONL, ResultFileFormat(path))
+			require.NoError(t, WriteIndexedTransmissionResult(path, expected))
+			require.True(t, HasTransmissionIndex(path))
+
+			// The index is deterministic.
+			index, err := os.ReadFile(TransmissionIndexPath(path))
+			require.NoError(t, err)
+			require.NoError(t, WriteIndexedTransmissionResult(path, expected))
+			again, err := os.ReadFile(TransmissionIndexPath(path))
+			require.NoError(t, err)
+			assert.Equal(t, index, again)
+
+			// A range read only touches the blocks covering the range.
+			size, entries, err := readTransmissionIndexFile(TransmissionIndexPath(path))
+			require.NoError(t, err)
+			require.Len(t, entries, 40)
+
+			file, err := os.Open(path) // #nosec G304 -- test path
+			require.NoError(t, err)
+			defer func() { _ = file.Close() }()
+			counter := &countingReadSeeker{ReadSeeker: file}
+
+			result, err := readIndexedRange(counter, entries, 5000, 5020)
+			require.NoError(t, err)
+			require.Len(t, result.Transmissions, 21)
+			for i, tx := range result.Transmissions {
+				assert.Equal(t, uint32(5000+i), tx.Epoch<<8|uint32(tx.Round)) // #nosec G115 -- bounded
+			}
+			assert.Equal(t, expected.ContractAddress, result.ContractAddress)
+			assert.Less(t, counter.read, size/2, "range read should not scan the whole file")
+
+			// The public reader returns the same rounds.
+			result, err = ReadTransmissionRange(path, 5000, 5020)
+			require.NoError(t, err)
+			assert.Len(t, result.Transmissions, 21)
+			assert.Equal(t, uint32(5000), result.StartRound)
+			assert.Equal(t, uint32(5020), result.EndRound)
+
+			// The whole file still reads as a normal result.
+			full, format, err := ReadTransmissionResult(path)
+			require.NoError(t, err)
+			assert.Equal(t, interfaces.OutputFormatJSONL, format)
+			assert.Len(t, full.Transmissions, 10000)
+		})
+	}
+}
+
+func TestReadTransmissionRange_WithoutIndex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.yaml")
+	require.NoError(t, WriteTransmissionResult(path, largeTransmissionResult(50), interfaces.OutputFormatYAML))
+
+	result, err := ReadTransmissionRange(path, 10, 19)
+	require.NoError(t, err)
+	require.Len(t, result.Transmissions, 10)
+	assert.Equal(t, uint8(10), result.Transmissions[0].Round)
+}
+
+func TestWriteTransmissionResult_RemovesStaleIndex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.jsonl")
+	require.NoError(t, WriteIndexedTransmissionResult(path, largeTransmissionResult(10)))
+	require.True(t, HasTransmissionIndex(path))
+
+	require.NoError(t, WriteTransmissionResult(path, largeTransmissionResult(20), interfaces.OutputFormatJSONL))
+	assert.False(t, HasTransmissionIndex(path))
+
+	result, err := ReadTransmissionRange(path, 15, 20)
+	require.NoError(t, err)
+	assert.Len(t, result.Transmissions, 6)
+}
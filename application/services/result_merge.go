@@ -0,0 +1,40 @@
+package services
+
+import (
+	"sort"
+
+	"chainlink-ocr-checker/domain/entities"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// transmissionKey identifies a transmission across result files.
+type transmissionKey struct {
+	contract          common.Address
+	aggregatorRoundID uint32
+}
+
+// MergeTransmissions combines the transmissions of several results in block
+// order. Where the results overlap, only the first transmission of each
+// contract and aggregator round ID is kept.
+func MergeTransmissions(results []*entities.TransmissionResult) []entities.Transmission {
+	var merged []entities.Transmission
+	for _, result := range results {
+		merged = append(merged, result.Transmissions...)
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].BlockNumber < merged[j].BlockNumber
+	})
+
+	seen := make(map[transmissionKey]bool, len(merged))
+	deduped := merged[:0]
+	for _, tx := range merged {
+		key := transmissionKey{contract: tx.ContractAddress, aggregatorRoundID: tx.AggregatorRoundID}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		deduped = append(deduped, tx)
+	}
+
+	return deduped
+}
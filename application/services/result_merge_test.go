@@ -0,0 +1,27 @@
+package services
+
+import (
+	"testing"
+
+	"chainlink-ocr-checker/domain/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeTransmissions(t *testing.T) {
+	first := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	second := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	tx := func(contract common.Address, aggregatorRoundID uint32, block uint64) entities.Transmission {
+		return entities.Transmission{ContractAddress: contract, AggregatorRoundID: aggregatorRoundID, BlockNumber: block}
+	}
+
+	merged := MergeTransmissions([]*entities.TransmissionResult{
+		{Transmissions: []entities.Transmission{tx(first, 3, 30), tx(first, 4, 40)}},
+		{Transmissions: []entities.Transmission{tx(first, 2, 20), tx(first, 3, 30), tx(second, 3, 35)}},
+	})
+
+	// Round 3 of the first contract is kept once; the second contract's round 3 is distinct.
+	assert.Equal(t, []entities.Transmission{
+		tx(first, 2, 20), tx(first, 3, 30), tx(second, 3, 35), tx(first, 4, 40),
+	}, merged)
+}
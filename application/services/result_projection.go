@@ -0,0 +1,131 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TransmissionProjection selects the transmission fields written to a result file.
+type TransmissionProjection string
+
+// TransmissionProjection constants.
+const (
+	// ProjectionFull writes every field.
+	ProjectionFull TransmissionProjection = ""
+	// ProjectionObservers drops the transmitter's address and indices.
+	ProjectionObservers TransmissionProjection = "observers"
+	// ProjectionTransmitters drops the observer list, count, and quorum flag.
+	ProjectionTransmitters TransmissionProjection = "transmitters"
+	// ProjectionMinimal keeps only the round, the transmitter, and the block.
+	ProjectionMinimal TransmissionProjection = "minimal"
+)
+
+// projectedResult mirrors entities.TransmissionResult with projected
+// transmissions, so projected files read back as ordinary results.
+type projectedResult struct {
+	ContractAddress      common.Address
+	StartRound           uint32
+	EndRound             uint32
+	Transmissions        []projectedTransmission
+	DistinctTransmitters []entities.TransmitterCount
+}
+
+// projectedTransmission mirrors entities.Transmission. Fields left out by the
+// projection are nil and omitted; the field names match so they decode as the
+// zero value.
+type projectedTransmission struct {
+	ContractAddress    *common.Address `json:",omitempty" yaml:",omitempty"`
+	ConfigDigest       *[32]byte       `json:",omitempty" yaml:",omitempty"`
+	Epoch              *uint32         `json:",omitempty" yaml:",omitempty"`
+	Round              *uint8          `json:",omitempty" yaml:",omitempty"`
+	AggregatorRoundID  *uint32         `json:",omitempty" yaml:",omitempty"`
+	LatestAnswer       *big.Int        `json:",omitempty" yaml:",omitempty"`
+	JuelsPerFeeCoin    *big.Int        `json:",omitempty" yaml:",omitempty"`
+	LatestTimestamp    *uint32         `json:",omitempty" yaml:",omitempty"`
+	TransmitterIndex   *uint8          `json:",omitempty" yaml:",omitempty"`
+	TransmitterAddress *common.Address `json:",omitempty" yaml:",omitempty"`
+	ObserverIndex      *uint8          `json:",omitempty" yaml:",omitempty"`
+	ObserverCount      *uint8          `json:",omitempty" yaml:",omitempty"`
+	Observers          *[]uint8        `json:",omitempty" yaml:",omitempty"`
+	MetQuorum          *bool           `json:",omitempty" yaml:",omitempty"`
+	BlockNumber        *uint64         `json:",omitempty" yaml:",omitempty"`
+	BlockTimestamp     *time.Time      `json:",omitempty" yaml:",omitempty"`
+	PackedRound        uint32
+}
+
+// ValidateProjection checks that a projection is known.
+func ValidateProjection(projection TransmissionProjection) error {
+	switch projection {
+	case ProjectionFull, ProjectionObservers, ProjectionTransmitters, ProjectionMinimal:
+		return nil
+	default:
+		return fmt.Errorf("unsupported projection: %s", projection)
+	}
+}
+
+// projectResult returns the value to encode for a result: the result itself
+// for the full projection, or a projected copy.
+func projectResult(result *entities.TransmissionResult, projection TransmissionProjection) interface{} {
+	if projection == ProjectionFull {
+		return result
+	}
+
+	projected := &projectedResult{
+		ContractAddress:      result.ContractAddress,
+		StartRound:           result.StartRound,
+		EndRound:             result.EndRound,
+		Transmissions:        make([]projectedTransmission, len(result.Transmissions)),
+		DistinctTransmitters: result.DistinctTransmitters,
+	}
+	for i := range result.Transmissions {
+		projected.Transmissions[i] = projectTransmissionFields(&result.Transmissions[i], projection)
+	}
+	return projected
+}
+
+// projectTransmission returns the value to encode for one transmission.
+func projectTransmission(tx *entities.Transmission, projection TransmissionProjection) interface{} {
+	if projection == ProjectionFull {
+		return tx
+	}
+	return projectTransmissionFields(tx, projection)
+}
+
+// projectTransmissionFields copies the fields a projection keeps.
+func projectTransmissionFields(tx *entities.Transmission, projection TransmissionProjection) projectedTransmission {
+	p := projectedTransmission{
+		Epoch:             &tx.Epoch,
+		Round:             &tx.Round,
+		AggregatorRoundID: &tx.AggregatorRoundID,
+		BlockNumber:       &tx.BlockNumber,
+		PackedRound:       tx.PackedRound(),
+	}
+
+	if projection == ProjectionMinimal {
+		p.TransmitterAddress = &tx.TransmitterAddress
+		return p
+	}
+
+	p.ContractAddress = &tx.ContractAddress
+	p.ConfigDigest = &tx.ConfigDigest
+	p.LatestAnswer = tx.LatestAnswer
+	p.JuelsPerFeeCoin = tx.JuelsPerFeeCoin
+	p.LatestTimestamp = &tx.LatestTimestamp
+	p.BlockTimestamp = &tx.BlockTimestamp
+
+	switch projection {
+	case ProjectionObservers:
+		p.ObserverCount = &tx.ObserverCount
+		p.Observers = &tx.Observers
+		p.MetQuorum = &tx.MetQuorum
+	case ProjectionTransmitters:
+		p.TransmitterIndex = &tx.TransmitterIndex
+		p.TransmitterAddress = &tx.TransmitterAddress
+		p.ObserverIndex = &tx.ObserverIndex
+	}
+	return p
+}
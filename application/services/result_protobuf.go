@@ -0,0 +1,292 @@
+package services
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers of the messages in proto/ocrchecker/v1/transmission.proto.
+const (
+	pbResultContractAddress      protowire.Number = 1
+	pbResultStartRound           protowire.Number = 2
+	pbResultEndRound             protowire.Number = 3
+	pbResultTransmissions        protowire.Number = 4
+	pbResultDistinctTransmitters protowire.Number = 5
+
+	pbTxContractAddress    protowire.Number = 1
+	pbTxConfigDigest       protowire.Number = 2
+	pbTxEpoch              protowire.Number = 3
+	pbTxRound              protowire.Number = 4
+	pbTxAggregatorRoundID  protowire.Number = 5
+	pbTxLatestAnswer       protowire.Number = 6
+	pbTxLatestTimestamp    protowire.Number = 7
+	pbTxTransmitterIndex   protowire.Number = 8
+	pbTxTransmitterAddress protowire.Number = 9
+	pbTxObserverIndex      protowire.Number = 10
+	pbTxObserverCount      protowire.Number = 11
+	pbTxObservers          protowire.Number = 12
+	pbTxMetQuorum          protowire.Number = 13
+	pbTxBlockNumber        protowire.Number = 14
+	pbTxBlockTimestamp     protowire.Number = 15
+	pbTxPackedRound        protowire.Number = 16
+	pbTxJuelsPerFeeCoin    protowire.Number = 17
+
+	pbCountAddress protowire.Number = 1
+	pbCountCount   protowire.Number = 2
+
+	pbTimestampSeconds protowire.Number = 1
+	pbTimestampNanos   protowire.Number = 2
+)
+
+// protoField is a decoded field of a protobuf message. Varint fields carry
+// their value in varint, length-delimited fields in bytes.
+type protoField struct {
+	num    protowire.Number
+	varint uint64
+	bytes  []byte
+}
+
+// encodeProtobuf writes a transmission result as a TransmissionResult message.
+func encodeProtobuf(w io.Writer, result *entities.TransmissionResult) error {
+	_, err := w.Write(marshalTransmissionResult(result))
+	return err
+}
+
+// decodeProtobuf reads a transmission result encoded as a TransmissionResult message.
+func decodeProtobuf(r io.Reader) (*entities.TransmissionResult, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read protobuf: %w", err)
+	}
+
+	result, err := unmarshalTransmissionResult(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode protobuf: %w", err)
+	}
+	return result, nil
+}
+
+// marshalTransmissionResult encodes a TransmissionResult message.
+func marshalTransmissionResult(result *entities.TransmissionResult) []byte {
+	var b []byte
+	b = appendProtoBytes(b, pbResultContractAddress, result.ContractAddress.Bytes())
+	b = appendProtoVarint(b, pbResultStartRound, uint64(result.StartRound))
+	b = appendProtoVarint(b, pbResultEndRound, uint64(result.EndRound))
+	for i := range result.Transmissions {
+		b = appendProtoMessage(b, pbResultTransmissions, marshalTransmission(&result.Transmissions[i]))
+	}
+	for _, count := range result.DistinctTransmitters {
+		var m []byte
+		m = appendProtoBytes(m, pbCountAddress, count.Address.Bytes())
+		m = appendProtoVarint(m, pbCountCount, uint64(count.Count))
+		b = appendProtoMessage(b, pbResultDistinctTransmitters, m)
+	}
+	return b
+}
+
+// marshalTransmission encodes a Transmission message.
+func marshalTransmission(tx *entities.Transmission) []byte {
+	var b []byte
+	b = appendProtoBytes(b, pbTxContractAddress, tx.ContractAddress.Bytes())
+	b = appendProtoBytes(b, pbTxConfigDigest, tx.ConfigDigest[:])
+	b = appendProtoVarint(b, pbTxEpoch, uint64(tx.Epoch))
+	b = appendProtoVarint(b, pbTxRound, uint64(tx.Round))
+	b = appendProtoVarint(b, pbTxAggregatorRoundID, uint64(tx.AggregatorRoundID))
+	if tx.LatestAnswer != nil {
+		b = appendProtoBytes(b, pbTxLatestAnswer, []byte(tx.LatestAnswer.String()))
+	}
+	b = appendProtoVarint(b, pbTxLatestTimestamp, uint64(tx.LatestTimestamp))
+	b = appendProtoVarint(b, pbTxTransmitterIndex, uint64(tx.TransmitterIndex))
+	b = appendProtoBytes(b, pbTxTransmitterAddress, tx.TransmitterAddress.Bytes())
+	b = appendProtoVarint(b, pbTxObserverIndex, uint64(tx.ObserverIndex))
+	b = appendProtoVarint(b, pbTxObserverCount, uint64(tx.ObserverCount))
+	b = appendProtoBytes(b, pbTxObservers, tx.Observers)
+	b = appendProtoVarint(b, pbTxMetQuorum, protowire.EncodeBool(tx.MetQuorum))
+	b = appendProtoVarint(b, pbTxBlockNumber, tx.BlockNumber)
+	if !tx.BlockTimestamp.IsZero() {
+		var m []byte
+		m = appendProtoVarint(m, pbTimestampSeconds, uint64(tx.BlockTimestamp.Unix()))
+		m = appendProtoVarint(m, pbTimestampNanos, uint64(tx.BlockTimestamp.Nanosecond()))
+		b = appendProtoMessage(b, pbTxBlockTimestamp, m)
+	}
+	b = appendProtoVarint(b, pbTxPackedRound, uint64(tx.PackedRound()))
+	if tx.JuelsPerFeeCoin != nil {
+		b = appendProtoBytes(b, pbTxJuelsPerFeeCoin, []byte(tx.JuelsPerFeeCoin.String()))
+	}
+	return b
+}
+
+// unmarshalTransmissionResult decodes a TransmissionResult message.
+func unmarshalTransmissionResult(data []byte) (*entities.TransmissionResult, error) {
+	var result entities.TransmissionResult
+	err := consumeProtoFields(data, func(f protoField) error {
+		var err error
+		switch f.num {
+		case pbResultContractAddress:
+			result.ContractAddress, err = protoAddress(f.bytes)
+		case pbResultStartRound:
+			result.StartRound = uint32(f.varint)
+		case pbResultEndRound:
+			result.EndRound = uint32(f.varint)
+		case pbResultTransmissions:
+			var tx entities.Transmission
+			if tx, err = unmarshalTransmission(f.bytes); err == nil {
+				result.Transmissions = append(result.Transmissions, tx)
+			}
+		case pbResultDistinctTransmitters:
+			var count entities.TransmitterCount
+			err = consumeProtoFields(f.bytes, func(f protoField) error {
+				var err error
+				switch f.num {
+				case pbCountAddress:
+					count.Address, err = protoAddress(f.bytes)
+				case pbCountCount:
+					count.Count = int(f.varint)
+				}
+				return err
+			})
+			result.DistinctTransmitters = append(result.DistinctTransmitters, count)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// unmarshalTransmission decodes a Transmission message.
+func unmarshalTransmission(data []byte) (entities.Transmission, error) {
+	var tx entities.Transmission
+	err := consumeProtoFields(data, func(f protoField) error {
+		var err error
+		switch f.num {
+		case pbTxContractAddress:
+			tx.ContractAddress, err = protoAddress(f.bytes)
+		case pbTxConfigDigest:
+			if len(f.bytes) != len(tx.ConfigDigest) {
+				return fmt.Errorf("invalid config digest length %d", len(f.bytes))
+			}
+			copy(tx.ConfigDigest[:], f.bytes)
+		case pbTxEpoch:
+			tx.Epoch = uint32(f.varint)
+		case pbTxRound:
+			tx.Round = uint8(f.varint)
+		case pbTxAggregatorRoundID:
+			tx.AggregatorRoundID = uint32(f.varint)
+		case pbTxLatestAnswer:
+			answer, ok := new(big.Int).SetString(string(f.bytes), 10)
+			if !ok {
+				return fmt.Errorf("invalid latest answer %q", f.bytes)
+			}
+			tx.LatestAnswer = answer
+		case pbTxJuelsPerFeeCoin:
+			juels, ok := new(big.Int).SetString(string(f.bytes), 10)
+			if !ok {
+				return fmt.Errorf("invalid juels per fee coin %q", f.bytes)
+			}
+			tx.JuelsPerFeeCoin = juels
+		case pbTxLatestTimestamp:
+			tx.LatestTimestamp = uint32(f.varint)
+		case pbTxTransmitterIndex:
+			tx.TransmitterIndex = uint8(f.varint)
+		case pbTxTransmitterAddress:
+			tx.TransmitterAddress, err = protoAddress(f.bytes)
+		case pbTxObserverIndex:
+			tx.ObserverIndex = uint8(f.varint)
+		case pbTxObserverCount:
+			tx.ObserverCount = uint8(f.varint)
+		case pbTxObservers:
+			tx.Observers = append([]uint8(nil), f.bytes...)
+		case pbTxMetQuorum:
+			tx.MetQuorum = protowire.DecodeBool(f.varint)
+		case pbTxBlockNumber:
+			tx.BlockNumber = f.varint
+		case pbTxBlockTimestamp:
+			var seconds, nanos int64
+			err = consumeProtoFields(f.bytes, func(f protoField) error {
+				switch f.num {
+				case pbTimestampSeconds:
+					seconds = int64(f.varint)
+				case pbTimestampNanos:
+					nanos = int64(int32(f.varint))
+				}
+				return nil
+			})
+			tx.BlockTimestamp = time.Unix(seconds, nanos).UTC()
+		}
+		return err
+	})
+	return tx, err
+}
+
+// consumeProtoFields calls field for every varint and length-delimited field
+// of a message. Fields of other wire types are skipped.
+func consumeProtoFields(data []byte, field func(protoField) error) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		f := protoField{num: num}
+		switch typ {
+		case protowire.VarintType:
+			f.varint, n = protowire.ConsumeVarint(data)
+		case protowire.BytesType:
+			f.bytes, n = protowire.ConsumeBytes(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		if typ != protowire.VarintType && typ != protowire.BytesType {
+			continue
+		}
+		if err := field(f); err != nil {
+			return fmt.Errorf("field %d: %w", num, err)
+		}
+	}
+	return nil
+}
+
+// protoAddress converts a 20-byte address field.
+func protoAddress(b []byte) (common.Address, error) {
+	if len(b) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("invalid address length %d", len(b))
+	}
+	return common.BytesToAddress(b), nil
+}
+
+// appendProtoVarint appends a varint field, omitting the proto3 default of zero.
+func appendProtoVarint(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+// appendProtoBytes appends a length-delimited field, omitting empty values.
+func appendProtoBytes(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	return appendProtoMessage(b, num, v)
+}
+
+// appendProtoMessage appends an embedded message, which is written even when empty.
+func appendProtoMessage(b []byte, num protowire.Number, m []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, m)
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"bytes"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestTransmissionResultProtobuf_RoundTrip(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	expected := &entities.TransmissionResult{
+		ContractAddress: contract,
+		StartRound:      10,
+		EndRound:        20,
+		Transmissions: []entities.Transmission{
+			{
+				ContractAddress:    contract,
+				ConfigDigest:       [32]byte{0: 1, 31: 0xff},
+				Epoch:              70000,
+				Round:              3,
+				AggregatorRoundID:  12,
+				LatestAnswer:       big.NewInt(-123456789),
+				LatestTimestamp:    1700000000,
+				TransmitterIndex:   2,
+				TransmitterAddress: common.HexToAddress("0xa000000000000000000000000000000000000000"),
+				ObserverIndex:      4,
+				ObserverCount:      3,
+				Observers:          []uint8{0, 4, 7},
+				MetQuorum:          true,
+				BlockNumber:        1 << 40,
+				BlockTimestamp:     time.Unix(1700000001, 500).UTC(),
+			},
+			{
+				// Zero values, a missing answer and an unfetched timestamp must survive too.
+				ContractAddress:    contract,
+				TransmitterAddress: common.HexToAddress("0xb000000000000000000000000000000000000000"),
+			},
+		},
+		DistinctTransmitters: []entities.TransmitterCount{
+			{Address: common.HexToAddress("0xa000000000000000000000000000000000000000"), Count: 1},
+			{Address: common.HexToAddress("0xb000000000000000000000000000000000000000"), Count: 1},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, EncodeTransmissionResult(&buf, expected, interfaces.OutputFormatProtobuf))
+
+	actual, err := decodeProtobuf(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	// Files are read back by extension.
+	path := filepath.Join(t.TempDir(), "results.protobuf")
+	require.NoError(t, WriteTransmissionResult(path, expected, interfaces.OutputFormatProtobuf))
+	actual, format, err := ReadTransmissionResult(path)
+	require.NoError(t, err)
+	assert.Equal(t, interfaces.OutputFormatProtobuf, format)
+	assert.Equal(t, expected, actual)
+}
+
+func TestDecodeProtobuf_SkipsUnknownFields(t *testing.T) {
+	data := marshalTransmissionResult(testTransmissionResult())
+	data = protowire.AppendTag(data, 99, protowire.Fixed64Type)
+	data = protowire.AppendFixed64(data, 42)
+	data = protowire.AppendTag(data, 100, protowire.BytesType)
+	data = protowire.AppendBytes(data, []byte("ignored"))
+
+	actual, err := decodeProtobuf(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, testTransmissionResult(), actual)
+}
+
+func TestDecodeProtobuf_Invalid(t *testing.T) {
+	t.Run("truncated", func(t *testing.T) {
+		data := marshalTransmissionResult(testTransmissionResult())
+		_, err := decodeProtobuf(bytes.NewReader(data[:len(data)-1]))
+		assert.Error(t, err)
+	})
+
+	t.Run("bad address", func(t *testing.T) {
+		data := protowire.AppendTag(nil, pbResultContractAddress, protowire.BytesType)
+		data = protowire.AppendBytes(data, []byte{1, 2, 3})
+		_, err := decodeProtobuf(bytes.NewReader(data))
+		assert.ErrorContains(t, err, "invalid address length")
+	})
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+// resultFileNamePattern matches the default names of fetch result files and
+// their round indexes: <contract>-<start>_<end>.<format>[.gz][.idx].
+var resultFileNamePattern = regexp.MustCompile(
+	`^0x[0-9a-fA-F]{40}-\d+_\d+\.(yaml|json|jsonl|protobuf|pb)(\.gz)?(\.idx)?$`)
+
+// PrunedFile describes a result file removed, or due for removal, by PruneResultFiles.
+type PrunedFile struct {
+	Path    string
+	Size    int64
+	ModTime time.Time
+}
+
+// IsResultFileName reports whether name follows the naming of files written by fetch.
+func IsResultFileName(name string) bool {
+	return resultFileNamePattern.MatchString(name)
+}
+
+// PruneResultFiles removes result files under dir last modified before cutoff.
+// Only files named like fetch output are considered; everything else is left
+// alone. With dryRun set nothing is removed and the files that would be are
+// returned.
+func PruneResultFiles(dir string, cutoff time.Time, dryRun bool) ([]PrunedFile, error) {
+	var pruned []PrunedFile
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !IsResultFileName(entry.Name()) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if !info.ModTime().Before(cutoff) {
+			return nil
+		}
+
+		if !dryRun {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+
+		pruned = append(pruned, PrunedFile{Path: path, Size: info.Size(), ModTime: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return pruned, fmt.Errorf("failed to prune result files: %w", err)
+	}
+
+	return pruned, nil
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneResultFiles(t *testing.T) {
+	now := time.Now()
+	stale := now.Add(-45 * 24 * time.Hour)
+	fresh := now.Add(-24 * time.Hour)
+	contract := "0x1000000000000000000000000000000000000001"
+
+	files := map[string]time.Time{
+		contract + "-1_100.yaml":                      stale,
+		contract + "-1_100.jsonl.gz":                  stale,
+		contract + "-1_100.jsonl.gz.idx":              stale,
+		filepath.Join("nested", contract+"-5_9.json"): stale,
+		contract + "-101_200.yaml":                    fresh,
+		"notes.yaml":                                  stale,
+		"report.csv":                                  stale,
+		contract + "-1_100.yaml.bak":                  stale,
+	}
+
+	setup := func(t *testing.T) string {
+		dir := t.TempDir()
+		for name, modTime := range files {
+			path := filepath.Join(dir, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+			require.NoError(t, os.WriteFile(path, []byte("data"), 0o600))
+			require.NoError(t, os.Chtimes(path, modTime, modTime))
+		}
+		return dir
+	}
+
+	wantPruned := []string{
+		contract + "-1_100.jsonl.gz",
+		contract + "-1_100.jsonl.gz.idx",
+		contract + "-1_100.yaml",
+		filepath.Join("nested", contract+"-5_9.json"),
+	}
+
+	relPaths := func(t *testing.T, dir string, pruned []PrunedFile) []string {
+		paths := make([]string, 0, len(pruned))
+		for _, file := range pruned {
+			rel, err := filepath.Rel(dir, file.Path)
+			require.NoError(t, err)
+			paths = append(paths, rel)
+		}
+		sort.Strings(paths)
+		return paths
+	}
+
+	t.Run("removes only stale result files", func(t *testing.T) {
+		dir := setup(t)
+
+		pruned, err := PruneResultFiles(dir, now.Add(-30*24*time.Hour), false)
+		require.NoError(t, err)
+		assert.Equal(t, wantPruned, relPaths(t, dir, pruned))
+
+		for name := range files {
+			_, err := os.Stat(filepath.Join(dir, name))
+			if slices.Contains(wantPruned, name) {
+				assert.True(t, os.IsNotExist(err), "%s should be removed", name)
+			} else {
+				assert.NoError(t, err, "%s should be kept", name)
+			}
+		}
+	})
+
+	t.Run("dry run removes nothing", func(t *testing.T) {
+		dir := setup(t)
+
+		pruned, err := PruneResultFiles(dir, now.Add(-30*24*time.Hour), true)
+		require.NoError(t, err)
+		assert.Equal(t, wantPruned, relPaths(t, dir, pruned))
+
+		for name := range files {
+			_, err := os.Stat(filepath.Join(dir, name))
+			assert.NoError(t, err, "%s should be kept", name)
+		}
+	})
+}
@@ -3,49 +3,143 @@
 package services
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"slices"
 	"sort"
 	"time"
 
 	"chainlink-ocr-checker/domain/entities"
 	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
 	"gopkg.in/yaml.v2"
 )
 
+// AnomalyConfig configures anomaly detection.
+type AnomalyConfig struct {
+	// DeviationThresholdPercent is the answer change, in percent, that should
+	// trigger a prompt update. Zero disables deviation checks.
+	DeviationThresholdPercent float64
+
+	// DeviationMaxDelay is how long a deviating answer may take to be transmitted.
+	DeviationMaxDelay time.Duration
+
+	// AnswerDecimals is the number of decimals of the feed's answers.
+	AnswerDecimals uint8
+
+	// AnswerBounds holds the sane range of scaled answers per contract.
+	// Contracts without bounds are not checked.
+	AnswerBounds map[common.Address]AnswerBound
+
+	// AnswerDisplays holds how each contract's answers are written in anomaly
+	// descriptions. Contracts without one use the zero AnswerDisplay; details
+	// always carry the plain scaled answer.
+	AnswerDisplays map[common.Address]AnswerDisplay
+}
+
+// AnswerBound is an inclusive range of scaled answers. A nil Min or Max leaves
+// that side unbounded.
+type AnswerBound struct {
+	Min *float64
+	Max *float64
+}
+
+// AggregatorRoundGaps lists the aggregator round IDs missing from a set of transmissions.
+type AggregatorRoundGaps struct {
+	Missing []uint32
+	Count   int
+}
+
+// FindAggregatorRoundGaps reports the aggregator round IDs missing between the
+// lowest and highest ID seen. The contract increments the ID on every
+// transmission, so unlike epoch/round gaps, which also appear when rounds are
+// abandoned, each missing ID is a transmission absent from the data.
+// Transmissions without an aggregator round ID are ignored.
+func FindAggregatorRoundGaps(transmissions []entities.Transmission) AggregatorRoundGaps {
+	seen := make(map[uint32]bool, len(transmissions))
+	ids := make([]uint32, 0, len(transmissions))
+	for _, tx := range transmissions {
+		if tx.AggregatorRoundID == 0 || seen[tx.AggregatorRoundID] {
+			continue
+		}
+		seen[tx.AggregatorRoundID] = true
+		ids = append(ids, tx.AggregatorRoundID)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	gaps := AggregatorRoundGaps{Missing: []uint32{}}
+	for i := 1; i < len(ids); i++ {
+		for id := ids[i-1] + 1; id < ids[i]; id++ {
+			gaps.Missing = append(gaps.Missing, id)
+		}
+	}
+	gaps.Count = len(gaps.Missing)
+
+	return gaps
+}
+
+// cancelCheckInterval is how many transmissions the analyzer processes between
+// checks for cancellation.
+const cancelCheckInterval = 4096
+
+// checkCancel returns the context's error on every cancelCheckInterval-th
+// iteration, so long loops stop promptly without checking on every element.
+func checkCancel(ctx context.Context, i int) error {
+	if i%cancelCheckInterval != 0 {
+		return nil
+	}
+	return ctx.Err()
+}
+
 // transmissionAnalyzer implements the TransmissionAnalyzer interface.
 type transmissionAnalyzer struct {
 	logger interfaces.Logger
+	config AnomalyConfig
 }
 
 // NewTransmissionAnalyzer creates a new transmission analyzer.
-func NewTransmissionAnalyzer(logger interfaces.Logger) interfaces.TransmissionAnalyzer {
+func NewTransmissionAnalyzer(logger interfaces.Logger, config AnomalyConfig) interfaces.TransmissionAnalyzer {
 	return &transmissionAnalyzer{
 		logger: logger,
+		config: config,
 	}
 }
 
 // AnalyzeObserverActivity analyzes observer participation.
 func (a *transmissionAnalyzer) AnalyzeObserverActivity(
+	ctx context.Context,
 	transmissions []entities.Transmission,
 ) ([]entities.ObserverActivity, error) {
 	// Create a map to track observer activities.
 	observerMap := make(map[uint8]*entities.ObserverActivity)
 	
-	for _, tx := range transmissions {
+	for i, tx := range transmissions {
+		if err := checkCancel(ctx, i); err != nil {
+			return nil, err
+		}
+		
 		// Get or create observer activity.
 		activity, exists := observerMap[tx.ObserverIndex]
 		if !exists {
 			activity = &entities.ObserverActivity{
 				ObserverIndex: tx.ObserverIndex,
 				Address:       tx.TransmitterAddress,
+				Addresses:     []common.Address{tx.TransmitterAddress},
 				TotalCount:    0,
 				DailyCount:    make(map[string]int),
 				MonthlyCount:  make(map[string]int),
+				EpochCount:    make(map[uint32]int),
 			}
 			observerMap[tx.ObserverIndex] = activity
 		}
 		
+		// Track addresses the index moved to after a config rotation.
+		if !slices.Contains(activity.Addresses, tx.TransmitterAddress) {
+			activity.Addresses = append(activity.Addresses, tx.TransmitterAddress)
+		}
+		
 		// Update counts
 		activity.TotalCount++
 		
@@ -56,6 +150,9 @@ func (a *transmissionAnalyzer) AnalyzeObserverActivity(
 		// Update monthly count.
 		monthKey := tx.BlockTimestamp.Format("2006-01")
 		activity.MonthlyCount[monthKey]++
+		
+		// Update epoch count.
+		activity.EpochCount[tx.Epoch]++
 	}
 	
 	// Convert map to slice
@@ -74,6 +171,7 @@ func (a *transmissionAnalyzer) AnalyzeObserverActivity(
 
 // DetectAnomalies detects anomalies in transmission patterns.
 func (a *transmissionAnalyzer) DetectAnomalies(
+	ctx context.Context,
 	transmissions []entities.Transmission,
 ) ([]interfaces.TransmissionAnomaly, error) {
 	anomalies := []interfaces.TransmissionAnomaly{}
@@ -81,70 +179,83 @@ func (a *transmissionAnalyzer) DetectAnomalies(
 	if len(transmissions) == 0 {
 		return anomalies, nil
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	
-	// Sort transmissions by round.
-	sort.Slice(transmissions, func(i, j int) bool {
-		roundI := transmissions[i].Epoch<<8 | uint32(transmissions[i].Round)
-		roundJ := transmissions[j].Epoch<<8 | uint32(transmissions[j].Round)
-		return roundI < roundJ
-	})
+	// Split the transmissions into epoch eras and sort each era by round.
+	// Round numbers restart with the epoch, so rounds are only compared
+	// within an era.
+	eras := splitEpochEras(transmissions)
+	anomalies = append(anomalies, epochResets(eras)...)
 	
-	// Check for missing rounds.
-	prevRound := transmissions[0].Epoch<<8 | uint32(transmissions[0].Round)
-	for i := 1; i < len(transmissions); i++ {
-		currRound := transmissions[i].Epoch<<8 | uint32(transmissions[i].Round)
-		
-		if currRound > prevRound+1 {
-			anomaly := interfaces.TransmissionAnomaly{
-				Type:        interfaces.AnomalyTypeMissingRound,
-				Description: fmt.Sprintf("Missing rounds between %d and %d", prevRound, currRound),
-				Severity:    interfaces.AnomalySeverityMedium,
-				Timestamp:   transmissions[i].BlockTimestamp.Unix(),
-				Details: map[string]interface{}{
-					"start_round": prevRound,
-					"end_round":   currRound,
-					"gap":         currRound - prevRound - 1,
-				},
+	for _, era := range eras {
+		// Check for missing rounds.
+		prevRound := era[0].PackedRound()
+		for i := 1; i < len(era); i++ {
+			if err := checkCancel(ctx, i); err != nil {
+				return nil, err
 			}
-			anomalies = append(anomalies, anomaly)
+			currRound := era[i].PackedRound()
+			
+			if currRound > prevRound+1 {
+				anomaly := interfaces.TransmissionAnomaly{
+					Type:        interfaces.AnomalyTypeMissingRound,
+					Description: fmt.Sprintf("Missing rounds between %d and %d", prevRound, currRound),
+					Severity:    interfaces.AnomalySeverityMedium,
+					Timestamp:   era[i].BlockTimestamp.Unix(),
+					Details: map[string]interface{}{
+						"start_round": prevRound,
+						"end_round":   currRound,
+						"gap":         currRound - prevRound - 1,
+					},
+				}
+				anomalies = append(anomalies, anomaly)
+			}
+			
+			prevRound = currRound
 		}
 		
-		prevRound = currRound
-	}
-	
-	// Check for duplicate rounds.
-	roundMap := make(map[uint32][]entities.Transmission)
-	for _, tx := range transmissions {
-		round := tx.Epoch<<8 | uint32(tx.Round)
-		roundMap[round] = append(roundMap[round], tx)
-	}
-	
-	for round, txs := range roundMap {
-		if len(txs) > 1 {
-			anomaly := interfaces.TransmissionAnomaly{
-				Type:        interfaces.AnomalyTypeDuplicateRound,
-				Description: fmt.Sprintf("Duplicate transmissions for round %d", round),
-				Severity:    interfaces.AnomalySeverityHigh,
-				Timestamp:   txs[0].BlockTimestamp.Unix(),
-				Details: map[string]interface{}{
-					"round":       round,
-					"count":       len(txs),
-					"transmitters": func() []string {
-						addrs := make([]string, len(txs))
-						for i, tx := range txs {
-							addrs[i] = tx.TransmitterAddress.Hex()
-						}
-						return addrs
-					}(),
-				},
+		// Check for duplicate rounds. The era is sorted by round, so the
+		// duplicates of a round are adjacent and one pass finds them.
+		for run, start := 0, 0; start < len(era); run++ {
+			if err := checkCancel(ctx, run); err != nil {
+				return nil, err
 			}
-			anomalies = append(anomalies, anomaly)
+			round := era[start].PackedRound()
+			end := start + 1
+			for end < len(era) && era[end].PackedRound() == round {
+				end++
+			}
+			
+			if txs := era[start:end]; len(txs) > 1 {
+				addrs := make([]string, len(txs))
+				for i, tx := range txs {
+					addrs[i] = tx.TransmitterAddress.Hex()
+				}
+				anomaly := interfaces.TransmissionAnomaly{
+					Type:        interfaces.AnomalyTypeDuplicateRound,
+					Description: fmt.Sprintf("Duplicate transmissions for round %d", round),
+					Severity:    interfaces.AnomalySeverityHigh,
+					Timestamp:   txs[0].BlockTimestamp.Unix(),
+					Details: map[string]interface{}{
+						"round":        round,
+						"count":        len(txs),
+						"transmitters": addrs,
+					},
+				}
+				anomalies = append(anomalies, anomaly)
+			}
+			start = end
 		}
 	}
 	
 	// Check for inactive observers.
 	observerActivity := make(map[uint8]int)
-	for _, tx := range transmissions {
+	for i, tx := range transmissions {
+		if err := checkCancel(ctx, i); err != nil {
+			return nil, err
+		}
 		observerActivity[tx.ObserverIndex]++
 	}
 	
@@ -165,8 +276,25 @@ func (a *transmissionAnalyzer) DetectAnomalies(
 		}
 	}
 	
+	// Check for deviations that were transmitted late.
+	deviations, err := a.detectDeviationWithoutUpdate(ctx, transmissions)
+	if err != nil {
+		return nil, err
+	}
+	anomalies = append(anomalies, deviations...)
+	
+	// Check for answers outside the configured bounds.
+	outOfBounds, err := a.detectAnswerOutOfBounds(ctx, transmissions)
+	if err != nil {
+		return nil, err
+	}
+	anomalies = append(anomalies, outOfBounds...)
+	
 	// Check for high latency.
 	for i := 1; i < len(transmissions); i++ {
+		if err := checkCancel(ctx, i); err != nil {
+			return nil, err
+		}
 		timeDiff := transmissions[i].BlockTimestamp.Sub(transmissions[i-1].BlockTimestamp)
 		if timeDiff > 5*time.Minute { // Assuming 5 minutes is too long between rounds.
 			anomaly := interfaces.TransmissionAnomaly{
@@ -187,29 +315,229 @@ func (a *transmissionAnalyzer) DetectAnomalies(
 	return anomalies, nil
 }
 
+// splitEpochEras orders transmissions by block and splits them where the epoch
+// goes backwards, as it does when a new config restarts it. Each era is then
+// sorted by round in place, leaving transmissions in era order. Transmissions
+// without block numbers keep their order, so they should be passed in chain order.
+func splitEpochEras(transmissions []entities.Transmission) [][]entities.Transmission {
+	sort.SliceStable(transmissions, func(i, j int) bool {
+		return transmissions[i].BlockNumber < transmissions[j].BlockNumber
+	})
+	
+	var eras [][]entities.Transmission
+	start := 0
+	for i := 1; i <= len(transmissions); i++ {
+		if i < len(transmissions) && transmissions[i].Epoch >= transmissions[i-1].Epoch {
+			continue
+		}
+		
+		era := transmissions[start:i]
+		sort.Slice(era, func(a, b int) bool {
+			return era[a].PackedRound() < era[b].PackedRound()
+		})
+		eras = append(eras, era)
+		start = i
+	}
+	
+	return eras
+}
+
+// epochResets reports the start of each era after the first.
+func epochResets(eras [][]entities.Transmission) []interfaces.TransmissionAnomaly {
+	var anomalies []interfaces.TransmissionAnomaly
+	for i := 1; i < len(eras); i++ {
+		prev, curr := eras[i-1][len(eras[i-1])-1], eras[i][0]
+		anomalies = append(anomalies, interfaces.TransmissionAnomaly{
+			Type: interfaces.AnomalyTypeEpochReset,
+			Description: fmt.Sprintf("Epoch reset from round %d to round %d at block %d",
+				prev.PackedRound(), curr.PackedRound(), curr.BlockNumber),
+			Severity:  interfaces.AnomalySeverityLow,
+			Timestamp: curr.BlockTimestamp.Unix(),
+			Details: map[string]interface{}{
+				"from_round":             prev.PackedRound(),
+				"to_round":               curr.PackedRound(),
+				"block_number":           curr.BlockNumber,
+				"previous_config_digest": fmt.Sprintf("%x", prev.ConfigDigest),
+				"config_digest":          fmt.Sprintf("%x", curr.ConfigDigest),
+			},
+		})
+	}
+	return anomalies
+}
+
+// detectDeviationWithoutUpdate flags consecutive transmissions whose answer moved by
+// more than the deviation threshold but arrived later than the allowed delay.
+// Transmissions must be in era order, as splitEpochEras leaves them.
+func (a *transmissionAnalyzer) detectDeviationWithoutUpdate(
+	ctx context.Context,
+	transmissions []entities.Transmission,
+) ([]interfaces.TransmissionAnomaly, error) {
+	var anomalies []interfaces.TransmissionAnomaly
+	if a.config.DeviationThresholdPercent <= 0 {
+		return anomalies, nil
+	}
+	
+	for i := 1; i < len(transmissions); i++ {
+		if err := checkCancel(ctx, i); err != nil {
+			return nil, err
+		}
+		prev, curr := transmissions[i-1], transmissions[i]
+		
+		// Answers and timestamps are both needed to judge the update.
+		if prev.LatestAnswer == nil || curr.LatestAnswer == nil ||
+			prev.BlockTimestamp.IsZero() || curr.BlockTimestamp.IsZero() {
+			continue
+		}
+		
+		deviation, ok := percentChange(prev.LatestAnswer, curr.LatestAnswer)
+		if !ok || deviation <= a.config.DeviationThresholdPercent {
+			continue
+		}
+		
+		delay := curr.BlockTimestamp.Sub(prev.BlockTimestamp)
+		if delay <= a.config.DeviationMaxDelay {
+			continue
+		}
+		
+		anomaly := interfaces.TransmissionAnomaly{
+			Type: interfaces.AnomalyTypeDeviationWithoutUpdate,
+			Description: fmt.Sprintf("Answer deviated %.4f%% (threshold %.4f%%) from %s to %s but was transmitted after %s",
+				deviation, a.config.DeviationThresholdPercent,
+				a.displayAnswer(prev.ContractAddress, prev.LatestAnswer),
+				a.displayAnswer(curr.ContractAddress, curr.LatestAnswer), delay),
+			Severity:  interfaces.AnomalySeverityHigh,
+			Timestamp: curr.BlockTimestamp.Unix(),
+			Details: map[string]interface{}{
+				"from_round":        prev.Epoch<<8 | uint32(prev.Round),
+				"to_round":          curr.Epoch<<8 | uint32(curr.Round),
+				"previous_answer":   formatAnswer(prev.LatestAnswer, a.config.AnswerDecimals),
+				"answer":            formatAnswer(curr.LatestAnswer, a.config.AnswerDecimals),
+				"deviation_percent": deviation,
+				"threshold_percent": a.config.DeviationThresholdPercent,
+				"delay_seconds":     delay.Seconds(),
+				"max_delay_seconds": a.config.DeviationMaxDelay.Seconds(),
+			},
+		}
+		anomalies = append(anomalies, anomaly)
+	}
+	
+	return anomalies, nil
+}
+
+// detectAnswerOutOfBounds flags transmissions whose answer, scaled by the feed
+// decimals, falls outside the bounds configured for their contract.
+func (a *transmissionAnalyzer) detectAnswerOutOfBounds(
+	ctx context.Context,
+	transmissions []entities.Transmission,
+) ([]interfaces.TransmissionAnomaly, error) {
+	var anomalies []interfaces.TransmissionAnomaly
+	if len(a.config.AnswerBounds) == 0 {
+		return anomalies, nil
+	}
+	
+	for i, tx := range transmissions {
+		if err := checkCancel(ctx, i); err != nil {
+			return nil, err
+		}
+		bound, ok := a.config.AnswerBounds[tx.ContractAddress]
+		if !ok || tx.LatestAnswer == nil {
+			continue
+		}
+		
+		answer := scaleAnswer(tx.LatestAnswer, a.config.AnswerDecimals)
+		below := bound.Min != nil && answer.Cmp(big.NewFloat(*bound.Min)) < 0
+		above := bound.Max != nil && answer.Cmp(big.NewFloat(*bound.Max)) > 0
+		if !below && !above {
+			continue
+		}
+		
+		details := map[string]interface{}{
+			"round":    tx.Epoch<<8 | uint32(tx.Round),
+			"contract": tx.ContractAddress.Hex(),
+			"answer":   formatAnswer(tx.LatestAnswer, a.config.AnswerDecimals),
+		}
+		if bound.Min != nil {
+			details["min"] = *bound.Min
+		}
+		if bound.Max != nil {
+			details["max"] = *bound.Max
+		}
+		
+		anomaly := interfaces.TransmissionAnomaly{
+			Type: interfaces.AnomalyTypeAnswerOutOfBounds,
+			Description: fmt.Sprintf("Answer %s in round %d is outside the configured bounds",
+				a.displayAnswer(tx.ContractAddress, tx.LatestAnswer), details["round"]),
+			Severity:  interfaces.AnomalySeverityHigh,
+			Timestamp: tx.BlockTimestamp.Unix(),
+			Details:   details,
+		}
+		anomalies = append(anomalies, anomaly)
+	}
+	
+	return anomalies, nil
+}
+
+// percentChange returns the absolute change from prev to curr in percent of prev.
+// It reports false when prev is zero.
+func percentChange(prev, curr *big.Int) (float64, bool) {
+	if prev.Sign() == 0 {
+		return 0, false
+	}
+	
+	diff := new(big.Float).SetInt(new(big.Int).Sub(curr, prev))
+	ratio := new(big.Float).Quo(diff, new(big.Float).SetInt(prev))
+	percent, _ := ratio.Mul(ratio, big.NewFloat(100)).Float64()
+	if percent < 0 {
+		percent = -percent
+	}
+	
+	return percent, true
+}
+
+// formatAnswer renders a raw answer scaled by the feed decimals.
+func formatAnswer(answer *big.Int, decimals uint8) string {
+	return AnswerDisplay{}.Format(answer, decimals)
+}
+
+// displayAnswer renders a raw answer for a description, with the contract's
+// display settings.
+func (a *transmissionAnalyzer) displayAnswer(contract common.Address, answer *big.Int) string {
+	return a.config.AnswerDisplays[contract].Format(answer, a.config.AnswerDecimals)
+}
+
+// scaleAnswer divides a raw answer by 10^decimals.
+func scaleAnswer(answer *big.Int, decimals uint8) *big.Float {
+	scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	return new(big.Float).Quo(new(big.Float).SetInt(answer), scale)
+}
+
 // GenerateReport generates a comprehensive report.
 func (a *transmissionAnalyzer) GenerateReport(
+	ctx context.Context,
 	transmissions []entities.Transmission,
 	format interfaces.OutputFormat,
 ) ([]byte, error) {
 	// Analyze observer activity.
-	activities, err := a.AnalyzeObserverActivity(transmissions)
+	activities, err := a.AnalyzeObserverActivity(ctx, transmissions)
 	if err != nil {
 		return nil, err
 	}
 	
 	// Detect anomalies.
-	anomalies, err := a.DetectAnomalies(transmissions)
+	anomalies, err := a.DetectAnomalies(ctx, transmissions)
 	if err != nil {
 		return nil, err
 	}
 	
 	// Create report structure.
+	participationCV := ParticipationCV(activities)
 	report := map[string]interface{}{
 		"summary": map[string]interface{}{
-			"total_transmissions": len(transmissions),
-			"total_observers":     len(activities),
-			"total_anomalies":     len(anomalies),
+			"total_transmissions":  len(transmissions),
+			"total_observers":      len(activities),
+			"total_anomalies":      len(anomalies),
+			"participation_cv":     participationCV,
+			"uneven_participation": IsParticipationUneven(participationCV),
 			"date_range": map[string]interface{}{
 				"start": func() string {
 					if len(transmissions) > 0 {
@@ -0,0 +1,350 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransmissionAnalyzer_DeviationWithoutUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	analyzer := NewTransmissionAnalyzer(mocks.NewMockLogger(ctrl), AnomalyConfig{
+		DeviationThresholdPercent: 0.5,
+		DeviationMaxDelay:         2 * time.Minute,
+		AnswerDecimals:            8,
+	})
+
+	start := time.Unix(1700000000, 0).UTC()
+	transmission := func(round uint8, answer int64, at time.Duration) entities.Transmission {
+		return entities.Transmission{
+			Epoch:          1,
+			Round:          round,
+			LatestAnswer:   big.NewInt(answer),
+			BlockTimestamp: start.Add(at),
+		}
+	}
+
+	deviationAnomalies := func(t *testing.T, transmissions []entities.Transmission) []interfaces.TransmissionAnomaly {
+		anomalies, err := analyzer.DetectAnomalies(context.Background(), transmissions)
+		require.NoError(t, err)
+
+		var found []interfaces.TransmissionAnomaly
+		for _, anomaly := range anomalies {
+			if anomaly.Type == interfaces.AnomalyTypeDeviationWithoutUpdate {
+				found = append(found, anomaly)
+			}
+		}
+		return found
+	}
+
+	t.Run("large deviation followed by slow update", func(t *testing.T) {
+		anomalies := deviationAnomalies(t, []entities.Transmission{
+			transmission(1, 200000000000, 0),              // 2000.00000000
+			transmission(2, 200050000000, time.Minute),    // +0.025%, prompt
+			transmission(3, 210052500000, 11*time.Minute), // +5%, 10 minutes later
+			transmission(4, 210052500000, 11*time.Minute+30*time.Second),
+		})
+
+		require.Len(t, anomalies, 1)
+		anomaly := anomalies[0]
+		assert.Equal(t, interfaces.AnomalySeverityHigh, anomaly.Severity)
+		assert.Equal(t, start.Add(11*time.Minute).Unix(), anomaly.Timestamp)
+		assert.Equal(t, uint32(1<<8|2), anomaly.Details["from_round"])
+		assert.Equal(t, uint32(1<<8|3), anomaly.Details["to_round"])
+		assert.InDelta(t, 5.0, anomaly.Details["deviation_percent"], 1e-9)
+		assert.Equal(t, 0.5, anomaly.Details["threshold_percent"])
+		assert.Equal(t, 600.0, anomaly.Details["delay_seconds"])
+		assert.Equal(t, 120.0, anomaly.Details["max_delay_seconds"])
+		assert.Equal(t, "2000.50000000", anomaly.Details["previous_answer"])
+		assert.Equal(t, "2100.52500000", anomaly.Details["answer"])
+	})
+
+	t.Run("large deviation updated promptly", func(t *testing.T) {
+		anomalies := deviationAnomalies(t, []entities.Transmission{
+			transmission(1, 200000000000, 0),
+			transmission(2, 180000000000, time.Minute), // -10%
+		})
+		assert.Empty(t, anomalies)
+	})
+
+	t.Run("slow update within threshold", func(t *testing.T) {
+		anomalies := deviationAnomalies(t, []entities.Transmission{
+			transmission(1, 200000000000, 0),
+			transmission(2, 200100000000, time.Hour), // heartbeat, +0.05%
+		})
+		assert.Empty(t, anomalies)
+	})
+
+	t.Run("disabled without threshold", func(t *testing.T) {
+		disabled := NewTransmissionAnalyzer(mocks.NewMockLogger(ctrl), AnomalyConfig{})
+		anomalies, err := disabled.DetectAnomalies(context.Background(), []entities.Transmission{
+			transmission(1, 100, 0),
+			transmission(2, 200, time.Hour),
+		})
+		require.NoError(t, err)
+		for _, anomaly := range anomalies {
+			assert.NotEqual(t, interfaces.AnomalyTypeDeviationWithoutUpdate, anomaly.Type)
+		}
+	})
+}
+
+func TestTransmissionAnalyzer_AnswerDisplay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usdFeed := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	rawFeed := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	maxAnswer, two := 1000.0, 2
+	analyzer := NewTransmissionAnalyzer(mocks.NewMockLogger(ctrl), AnomalyConfig{
+		AnswerDecimals: 8,
+		AnswerBounds: map[common.Address]AnswerBound{
+			usdFeed: {Max: &maxAnswer},
+			rawFeed: {Max: &maxAnswer},
+		},
+		AnswerDisplays: map[common.Address]AnswerDisplay{
+			usdFeed: {Decimals: &two, Prefix: "$", ThousandsSeparator: ","},
+		},
+	})
+
+	anomalies, err := analyzer.DetectAnomalies(context.Background(), []entities.Transmission{
+		{ContractAddress: usdFeed, Epoch: 1, Round: 1, LatestAnswer: big.NewInt(123456000000)},
+		{ContractAddress: rawFeed, Epoch: 1, Round: 2, LatestAnswer: big.NewInt(123456000000)},
+	})
+	require.NoError(t, err)
+
+	var outOfBounds []interfaces.TransmissionAnomaly
+	for _, anomaly := range anomalies {
+		if anomaly.Type == interfaces.AnomalyTypeAnswerOutOfBounds {
+			outOfBounds = append(outOfBounds, anomaly)
+		}
+	}
+	require.Len(t, outOfBounds, 2)
+
+	// Descriptions use the feed's display; details keep the plain scaled answer.
+	assert.Equal(t, "Answer $1,234.56 in round 257 is outside the configured bounds", outOfBounds[0].Description)
+	assert.Equal(t, "1234.56000000", outOfBounds[0].Details["answer"])
+	assert.Equal(t, "Answer 1234.56000000 in round 258 is outside the configured bounds", outOfBounds[1].Description)
+}
+
+func TestTransmissionAnalyzer_AnswerOutOfBounds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	unbounded := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	minAnswer, maxAnswer := 100.0, 10000.0
+	analyzer := NewTransmissionAnalyzer(mocks.NewMockLogger(ctrl), AnomalyConfig{
+		AnswerDecimals: 8,
+		AnswerBounds: map[common.Address]AnswerBound{
+			contract: {Min: &minAnswer, Max: &maxAnswer},
+		},
+	})
+
+	outOfBounds := func(t *testing.T, tx entities.Transmission) []interfaces.TransmissionAnomaly {
+		anomalies, err := analyzer.DetectAnomalies(context.Background(), []entities.Transmission{tx})
+		require.NoError(t, err)
+
+		var found []interfaces.TransmissionAnomaly
+		for _, anomaly := range anomalies {
+			if anomaly.Type == interfaces.AnomalyTypeAnswerOutOfBounds {
+				found = append(found, anomaly)
+			}
+		}
+		return found
+	}
+
+	t.Run("zero answer", func(t *testing.T) {
+		anomalies := outOfBounds(t, entities.Transmission{
+			ContractAddress: contract, Epoch: 1, Round: 2, LatestAnswer: big.NewInt(0),
+		})
+		require.Len(t, anomalies, 1)
+		assert.Equal(t, interfaces.AnomalySeverityHigh, anomalies[0].Severity)
+		assert.Equal(t, uint32(1<<8|2), anomalies[0].Details["round"])
+		assert.Equal(t, "0.00000000", anomalies[0].Details["answer"])
+		assert.Equal(t, 100.0, anomalies[0].Details["min"])
+		assert.Equal(t, 10000.0, anomalies[0].Details["max"])
+	})
+
+	t.Run("astronomically large answer", func(t *testing.T) {
+		huge, ok := new(big.Int).SetString("1000000000000000000000000000", 10)
+		require.True(t, ok)
+		anomalies := outOfBounds(t, entities.Transmission{ContractAddress: contract, LatestAnswer: huge})
+		assert.Len(t, anomalies, 1)
+	})
+
+	t.Run("in bounds", func(t *testing.T) {
+		anomalies := outOfBounds(t, entities.Transmission{
+			ContractAddress: contract, LatestAnswer: big.NewInt(200000000000), // 2000.00000000
+		})
+		assert.Empty(t, anomalies)
+	})
+
+	t.Run("contract without bounds", func(t *testing.T) {
+		anomalies := outOfBounds(t, entities.Transmission{ContractAddress: unbounded, LatestAnswer: big.NewInt(0)})
+		assert.Empty(t, anomalies)
+	})
+}
+
+func TestFindAggregatorRoundGaps(t *testing.T) {
+	transmissions := func(ids ...uint32) []entities.Transmission {
+		result := make([]entities.Transmission, 0, len(ids))
+		for _, id := range ids {
+			result = append(result, entities.Transmission{AggregatorRoundID: id})
+		}
+		return result
+	}
+
+	t.Run("missing rounds 3 and 4", func(t *testing.T) {
+		gaps := FindAggregatorRoundGaps(transmissions(1, 2, 5, 6))
+		assert.Equal(t, []uint32{3, 4}, gaps.Missing)
+		assert.Equal(t, 2, gaps.Count)
+	})
+
+	t.Run("unordered with duplicates", func(t *testing.T) {
+		gaps := FindAggregatorRoundGaps(transmissions(9, 7, 7, 10, 12))
+		assert.Equal(t, []uint32{8, 11}, gaps.Missing)
+		assert.Equal(t, 2, gaps.Count)
+	})
+
+	t.Run("contiguous", func(t *testing.T) {
+		gaps := FindAggregatorRoundGaps(transmissions(1, 2, 3))
+		assert.Empty(t, gaps.Missing)
+		assert.Zero(t, gaps.Count)
+	})
+
+	t.Run("ids not captured", func(t *testing.T) {
+		gaps := FindAggregatorRoundGaps(transmissions(0, 0, 0))
+		assert.Empty(t, gaps.Missing)
+	})
+}
+
+func TestTransmissionAnalyzer_Cancellation(t *testing.T) {
+	mockLogger := mocks.NewMockLogger(gomock.NewController(t))
+	analyzer := NewTransmissionAnalyzer(mockLogger, AnomalyConfig{})
+
+	// A million transmissions, every round transmitted twice.
+	transmissions := make([]entities.Transmission, 1_000_000)
+	start := time.Unix(1700000000, 0).UTC()
+	for i := range transmissions {
+		transmissions[i] = entities.Transmission{
+			Epoch:          uint32(i / 2 >> 8),
+			Round:          uint8(i / 2),
+			ObserverIndex:  uint8(i % 31),
+			BlockTimestamp: start.Add(time.Duration(i) * time.Second),
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	begin := time.Now()
+	_, err := analyzer.AnalyzeObserverActivity(ctx, transmissions)
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = analyzer.DetectAnomalies(ctx, transmissions)
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = analyzer.GenerateReport(ctx, transmissions, interfaces.OutputFormatJSON)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(begin), time.Second)
+
+	t.Run("cancelled mid-analysis", func(t *testing.T) {
+		// The context reports cancellation only after a few checks, so
+		// the loops must notice it part-way through.
+		_, err := analyzer.AnalyzeObserverActivity(&cancelAfterContext{Context: context.Background(), checks: 3}, transmissions)
+		require.ErrorIs(t, err, context.Canceled)
+
+		_, err = analyzer.DetectAnomalies(&cancelAfterContext{Context: context.Background(), checks: 3}, transmissions)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+// cancelAfterContext reports context.Canceled from Err after checks calls.
+type cancelAfterContext struct {
+	context.Context
+	checks int
+}
+
+func (c *cancelAfterContext) Err() error {
+	if c.checks > 0 {
+		c.checks--
+		return nil
+	}
+	return context.Canceled
+}
+
+func TestTransmissionAnalyzer_DuplicateRounds(t *testing.T) {
+	mockLogger := mocks.NewMockLogger(gomock.NewController(t))
+	analyzer := NewTransmissionAnalyzer(mockLogger, AnomalyConfig{})
+
+	first := common.HexToAddress("0xa000000000000000000000000000000000000001")
+	second := common.HexToAddress("0xa000000000000000000000000000000000000002")
+	anomalies, err := analyzer.DetectAnomalies(context.Background(), []entities.Transmission{
+		{Epoch: 1, Round: 2, TransmitterAddress: second},
+		{Epoch: 1, Round: 1, TransmitterAddress: first},
+		{Epoch: 1, Round: 2, TransmitterAddress: first},
+		{Epoch: 1, Round: 3, TransmitterAddress: first},
+	})
+	require.NoError(t, err)
+
+	var duplicates []interfaces.TransmissionAnomaly
+	for _, anomaly := range anomalies {
+		if anomaly.Type == interfaces.AnomalyTypeDuplicateRound {
+			duplicates = append(duplicates, anomaly)
+		}
+	}
+	require.Len(t, duplicates, 1)
+	assert.Equal(t, uint32(1<<8|2), duplicates[0].Details["round"])
+	assert.Equal(t, 2, duplicates[0].Details["count"])
+	assert.Len(t, duplicates[0].Details["transmitters"], 2)
+}
+
+func TestTransmissionAnalyzer_EpochReset(t *testing.T) {
+	mockLogger := mocks.NewMockLogger(gomock.NewController(t))
+	analyzer := NewTransmissionAnalyzer(mockLogger, AnomalyConfig{})
+
+	oldDigest, newDigest := [32]byte{1}, [32]byte{2}
+	tx := func(digest [32]byte, epoch uint32, round uint8, block uint64) entities.Transmission {
+		return entities.Transmission{ConfigDigest: digest, Epoch: epoch, Round: round, BlockNumber: block}
+	}
+
+	// A config change at block 103 restarts the epoch; the new era then
+	// skips round 1<<8|2.
+	anomalies, err := analyzer.DetectAnomalies(context.Background(), []entities.Transmission{
+		tx(newDigest, 1, 3, 105),
+		tx(oldDigest, 500, 1, 100),
+		tx(oldDigest, 500, 2, 101),
+		tx(newDigest, 1, 1, 103),
+		tx(oldDigest, 500, 3, 102),
+	})
+	require.NoError(t, err)
+
+	byType := make(map[interfaces.AnomalyType][]interfaces.TransmissionAnomaly)
+	for _, anomaly := range anomalies {
+		byType[anomaly.Type] = append(byType[anomaly.Type], anomaly)
+	}
+
+	require.Len(t, byType[interfaces.AnomalyTypeEpochReset], 1)
+	reset := byType[interfaces.AnomalyTypeEpochReset][0]
+	assert.Equal(t, uint32(500<<8|3), reset.Details["from_round"])
+	assert.Equal(t, uint32(1<<8|1), reset.Details["to_round"])
+	assert.Equal(t, uint64(103), reset.Details["block_number"])
+	assert.Equal(t, fmt.Sprintf("%x", newDigest), reset.Details["config_digest"])
+
+	// Only the gap inside the new era is missing, not the span between eras.
+	require.Len(t, byType[interfaces.AnomalyTypeMissingRound], 1)
+	assert.Equal(t, uint32(1<<8|1), byType[interfaces.AnomalyTypeMissingRound][0].Details["start_round"])
+	assert.Equal(t, uint32(1<<8|3), byType[interfaces.AnomalyTypeMissingRound][0].Details["end_round"])
+}
@@ -0,0 +1,257 @@
+// Package services provides business logic services for the OCR checker application.
+// It contains analyzers and other services that operate on domain entities.
+package services
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Monitor defaults.
+const (
+	// DefaultMonitorLogSampleRate is the number of checks between routine completion logs.
+	DefaultMonitorLogSampleRate = 10
+	// DefaultMonitorAlertCooldown is how long an alert for a status suppresses repeats.
+	DefaultMonitorAlertCooldown = time.Hour
+)
+
+// MonitorOptions configures logging and alert deduplication of a TransmitterMonitor.
+type MonitorOptions struct {
+	// LogSampleRate logs routine check completions on the first check and every
+	// LogSampleRate checks after it. A rate of 1 or less logs every check.
+	LogSampleRate int
+
+	// AlertCooldown suppresses alerts for a status that was alerted within it,
+	// unless an existing problem escalates (WARNING to CRITICAL). A problem that
+	// persists past the cooldown is alerted again as a reminder. Zero alerts on
+	// every status change only.
+	AlertCooldown time.Duration
+
+	// RecoverPanics recovers a check that panics, logging the panic with its
+	// stack and recording it as a failed check, so monitoring continues.
+	RecoverPanics bool
+}
+
+// MonitorStatus is a snapshot of the most recent checks of a TransmitterMonitor.
+type MonitorStatus struct {
+	Transmitter common.Address
+	StartedAt   time.Time
+
+	// LastCheck is when the last check finished, successful or not; zero before the first.
+	LastCheck           time.Time
+	ConsecutiveFailures int
+	LastError           string
+
+	// LastResult is the result of the last successful check, nil before the first.
+	LastResult *interfaces.WatchTransmittersResult
+}
+
+// TransmitterMonitor runs watch checks for a transmitter and records their results.
+// When a notifier is set, alerts are sent when the health status changes,
+// deduplicated per status as configured in MonitorOptions.
+type TransmitterMonitor struct {
+	watchUseCase interfaces.WatchTransmittersUseCase
+	recorder     interfaces.MetricsRecorder
+	notifier     interfaces.Notifier
+	alertOptions AlertMessageOptions
+	logger       interfaces.Logger
+	params       interfaces.WatchTransmittersParams
+	options      MonitorOptions
+	now          func() time.Time
+
+	// checkMu serializes checks, which update lastStatus, lastSent, and checks.
+	checkMu    sync.Mutex
+	lastStatus entities.HealthStatus
+	lastSent   map[entities.HealthStatus]time.Time
+	checks     int
+
+	// statusMu guards status, which is read by the status endpoint while checks run.
+	statusMu sync.Mutex
+	status   MonitorStatus
+}
+
+// NewTransmitterMonitor creates a new transmitter monitor.
+// The notifier may be nil to only record metrics.
+func NewTransmitterMonitor(
+	watchUseCase interfaces.WatchTransmittersUseCase,
+	recorder interfaces.MetricsRecorder,
+	notifier interfaces.Notifier,
+	alertOptions AlertMessageOptions,
+	logger interfaces.Logger,
+	params interfaces.WatchTransmittersParams,
+	options MonitorOptions,
+) *TransmitterMonitor {
+	now := time.Now
+	return &TransmitterMonitor{
+		watchUseCase: watchUseCase,
+		recorder:     recorder,
+		notifier:     notifier,
+		alertOptions: alertOptions,
+		logger:       logger,
+		params:       params,
+		options:      options,
+		now:          now,
+		lastStatus:   entities.HealthStatusOK,
+		lastSent:     make(map[entities.HealthStatus]time.Time),
+		status: MonitorStatus{
+			Transmitter: params.TransmitterAddress,
+			StartedAt:   now(),
+		},
+	}
+}
+
+// Check runs a single watch check and records the result.
+// Failures and status changes are always logged. A check started while another
+// is running waits for it to finish.
+func (m *TransmitterMonitor) Check(ctx context.Context) (result *interfaces.WatchTransmittersResult, err error) {
+	m.checkMu.Lock()
+	defer m.checkMu.Unlock()
+
+	if m.options.RecoverPanics {
+		defer func() {
+			if r := recover(); r != nil {
+				result, err = nil, m.recordPanic(r)
+			}
+		}()
+	}
+
+	return m.check(ctx)
+}
+
+// recordPanic logs and records a recovered check panic and returns it as the
+// check's error.
+func (m *TransmitterMonitor) recordPanic(r interface{}) error {
+	err := fmt.Errorf("check panicked: %v", r)
+	m.logger.Error("Monitor check panicked",
+		"transmitter", m.params.TransmitterAddress.Hex(),
+		"panic", fmt.Sprint(r),
+		"stack", string(debug.Stack()))
+	m.recorder.RecordCheckPanic(m.params.TransmitterAddress)
+	m.recorder.RecordCheckError(m.params.TransmitterAddress)
+	m.recordStatus(nil, err)
+	return err
+}
+
+// check runs a single watch check and records the result.
+func (m *TransmitterMonitor) check(ctx context.Context) (*interfaces.WatchTransmittersResult, error) {
+	m.checks++
+
+	result, err := m.watchUseCase.Execute(ctx, m.params)
+	if err != nil {
+		m.logger.Error("Monitor check failed",
+			"transmitter", m.params.TransmitterAddress.Hex(),
+			"error", err)
+		m.recorder.RecordCheckError(m.params.TransmitterAddress)
+		m.recordStatus(nil, err)
+		return nil, err
+	}
+
+	m.recorder.RecordWatchResult(m.params.TransmitterAddress, result)
+	m.recordStatus(result, nil)
+
+	status := AlertHealthStatus(result.Summary, m.alertOptions)
+	if status != m.lastStatus {
+		m.logger.Info("Monitor status changed",
+			"transmitter", m.params.TransmitterAddress.Hex(),
+			"from", m.lastStatus.String(),
+			"to", status.String())
+	}
+
+	if status != m.lastStatus || m.sampled() {
+		m.logger.Info("Monitor check completed",
+			"transmitter", m.params.TransmitterAddress.Hex(),
+			"check", m.checks,
+			"total", result.Summary.TotalJobs,
+			"found", result.Summary.FoundJobs,
+			"healthScore", result.Summary.HealthScore)
+	}
+
+	m.alertOnChange(ctx, result)
+
+	return result, nil
+}
+
+// Status returns a snapshot of the most recent checks.
+func (m *TransmitterMonitor) Status() MonitorStatus {
+	m.statusMu.Lock()
+	defer m.statusMu.Unlock()
+	return m.status
+}
+
+// recordStatus updates the status snapshot after a check.
+func (m *TransmitterMonitor) recordStatus(result *interfaces.WatchTransmittersResult, err error) {
+	m.statusMu.Lock()
+	defer m.statusMu.Unlock()
+
+	m.status.LastCheck = m.now()
+	if err != nil {
+		m.status.ConsecutiveFailures++
+		m.status.LastError = err.Error()
+		return
+	}
+
+	m.status.ConsecutiveFailures = 0
+	m.status.LastError = ""
+	m.status.LastResult = result
+}
+
+// sampled reports whether the current check is due a routine completion log.
+func (m *TransmitterMonitor) sampled() bool {
+	rate := m.options.LogSampleRate
+	return rate <= 1 || (m.checks-1)%rate == 0
+}
+
+// alertOnChange sends an alert when the health status differs from the previous
+// check, subject to the per-status cooldown. Delivery failures are logged and do
+// not fail the check.
+func (m *TransmitterMonitor) alertOnChange(ctx context.Context, result *interfaces.WatchTransmittersResult) {
+	status := AlertHealthStatus(result.Summary, m.alertOptions)
+	previous := m.lastStatus
+	m.lastStatus = status
+
+	if m.notifier == nil {
+		return
+	}
+
+	now := m.now()
+	if !m.shouldAlert(previous, status, now) {
+		return
+	}
+
+	notification := BuildAlertMessage(m.params.TransmitterAddress, result, m.alertOptions)
+	if err := m.notifier.Notify(ctx, notification); err != nil {
+		m.logger.Warn("Failed to send monitor alert",
+			"transmitter", m.params.TransmitterAddress.Hex(),
+			"status", status.String(),
+			"error", err)
+		return
+	}
+	m.lastSent[status] = now
+}
+
+// shouldAlert decides whether a check with status, following previous, is alerted.
+func (m *TransmitterMonitor) shouldAlert(previous, status entities.HealthStatus, now time.Time) bool {
+	// A problem getting worse always goes out; only a flap from OK is deduplicated.
+	if previous != entities.HealthStatusOK && status > previous {
+		return true
+	}
+
+	sent, alerted := m.lastSent[status]
+	if alerted && now.Sub(sent) < m.options.AlertCooldown {
+		return false
+	}
+
+	if status != previous {
+		return true
+	}
+
+	// An unchanged problem is re-sent once its cooldown has elapsed.
+	return status != entities.HealthStatusOK && alerted && m.options.AlertCooldown > 0
+}
@@ -0,0 +1,345 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransmitterMonitor_Check(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	params := interfaces.WatchTransmittersParams{
+		TransmitterAddress: common.HexToAddress("0xa000000000000000000000000000000000000000"),
+		RoundsToCheck:      10,
+	}
+
+	watchUseCase := mocks.NewMockWatchTransmittersUseCase(ctrl)
+	recorder := mocks.NewMockMetricsRecorder(ctrl)
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	monitor := NewTransmitterMonitor(watchUseCase, recorder, nil, AlertMessageOptions{}, logger, params, MonitorOptions{})
+
+	t.Run("records result", func(t *testing.T) {
+		result := &interfaces.WatchTransmittersResult{}
+		watchUseCase.EXPECT().Execute(ctx, params).Return(result, nil)
+		recorder.EXPECT().RecordWatchResult(params.TransmitterAddress, result)
+
+		got, err := monitor.Check(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, result, got)
+	})
+
+	t.Run("records error", func(t *testing.T) {
+		watchUseCase.EXPECT().Execute(ctx, params).Return(nil, errors.New("db down"))
+		recorder.EXPECT().RecordCheckError(params.TransmitterAddress)
+
+		_, err := monitor.Check(ctx)
+		require.Error(t, err)
+	})
+}
+
+func TestTransmitterMonitor_RecoverPanics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	params := interfaces.WatchTransmittersParams{
+		TransmitterAddress: common.HexToAddress("0xa000000000000000000000000000000000000000"),
+		RoundsToCheck:      10,
+	}
+
+	watchUseCase := mocks.NewMockWatchTransmittersUseCase(ctrl)
+	recorder := mocks.NewMockMetricsRecorder(ctrl)
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	monitor := NewTransmitterMonitor(watchUseCase, recorder, nil, AlertMessageOptions{}, logger, params,
+		MonitorOptions{RecoverPanics: true})
+
+	// A check that panics is logged with its stack and recorded as a failure.
+	watchUseCase.EXPECT().Execute(ctx, params).DoAndReturn(
+		func(context.Context, interfaces.WatchTransmittersParams) (*interfaces.WatchTransmittersResult, error) {
+			var result *interfaces.WatchTransmittersResult
+			_ = result.Summary
+			return result, nil
+		})
+	logger.EXPECT().Error("Monitor check panicked", gomock.Any()).
+		Do(func(_ string, keysAndValues ...interface{}) {
+			fields := make(map[interface{}]interface{})
+			for i := 0; i+1 < len(keysAndValues); i += 2 {
+				fields[keysAndValues[i]] = keysAndValues[i+1]
+			}
+			assert.Contains(t, fields["panic"], "nil pointer dereference")
+			assert.Contains(t, fields["stack"], "TestTransmitterMonitor_RecoverPanics")
+		})
+	recorder.EXPECT().RecordCheckPanic(params.TransmitterAddress)
+	recorder.EXPECT().RecordCheckError(params.TransmitterAddress)
+
+	_, err := monitor.Check(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "check panicked")
+	assert.Equal(t, 1, monitor.Status().ConsecutiveFailures)
+
+	// The next check runs as usual.
+	result := &interfaces.WatchTransmittersResult{}
+	watchUseCase.EXPECT().Execute(ctx, params).Return(result, nil)
+	recorder.EXPECT().RecordWatchResult(params.TransmitterAddress, result)
+
+	got, err := monitor.Check(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, result, got)
+	assert.Equal(t, 0, monitor.Status().ConsecutiveFailures)
+}
+
+func TestTransmitterMonitor_OverlappingChecks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	params := interfaces.WatchTransmittersParams{
+		TransmitterAddress: common.HexToAddress("0xa000000000000000000000000000000000000000"),
+		RoundsToCheck:      10,
+	}
+
+	watchUseCase := mocks.NewMockWatchTransmittersUseCase(ctrl)
+	recorder := mocks.NewMockMetricsRecorder(ctrl)
+	notifier := mocks.NewMockNotifier(ctrl)
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	recorder.EXPECT().RecordWatchResult(gomock.Any(), gomock.Any()).AnyTimes()
+	notifier.EXPECT().Notify(ctx, gomock.Any()).Return(nil).Times(2)
+
+	monitor := NewTransmitterMonitor(watchUseCase, recorder, notifier, AlertMessageOptions{}, logger, params, MonitorOptions{})
+
+	// The first check blocks until released, so the second starts while it runs.
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var inFlight, maxInFlight, calls int32
+	watchUseCase.EXPECT().Execute(ctx, params).DoAndReturn(
+		func(context.Context, interfaces.WatchTransmittersParams) (*interfaces.WatchTransmittersResult, error) {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			if n > atomic.LoadInt32(&maxInFlight) {
+				atomic.StoreInt32(&maxInFlight, n)
+			}
+
+			summary := interfaces.TransmitterSummary{TotalJobs: 1, FoundJobs: 1}
+			if atomic.AddInt32(&calls, 1) == 1 {
+				close(started)
+				<-release
+				summary = interfaces.TransmitterSummary{TotalJobs: 1, MissingJobs: 1}
+			}
+			return &interfaces.WatchTransmittersResult{Summary: summary}, nil
+		}).Times(2)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, _ = monitor.Check(ctx)
+	}()
+	<-started
+	go func() {
+		defer wg.Done()
+		_, _ = monitor.Check(ctx)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// The checks ran one after the other: CRITICAL, then the recovery.
+	assert.Equal(t, int32(1), atomic.LoadInt32(&maxInFlight))
+	assert.Equal(t, 0, monitor.Status().ConsecutiveFailures)
+}
+
+func TestTransmitterMonitor_AlertsOnStatusChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	params := interfaces.WatchTransmittersParams{
+		TransmitterAddress: common.HexToAddress("0xa000000000000000000000000000000000000000"),
+		RoundsToCheck:      10,
+	}
+
+	watchUseCase := mocks.NewMockWatchTransmittersUseCase(ctrl)
+	recorder := mocks.NewMockMetricsRecorder(ctrl)
+	notifier := mocks.NewMockNotifier(ctrl)
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	recorder.EXPECT().RecordWatchResult(gomock.Any(), gomock.Any()).AnyTimes()
+
+	monitor := NewTransmitterMonitor(watchUseCase, recorder, notifier, AlertMessageOptions{}, logger, params, MonitorOptions{})
+
+	check := func(summary interfaces.TransmitterSummary) {
+		watchUseCase.EXPECT().Execute(ctx, params).Return(&interfaces.WatchTransmittersResult{Summary: summary}, nil)
+		_, err := monitor.Check(ctx)
+		require.NoError(t, err)
+	}
+	expectAlert := func(severity interfaces.NotificationSeverity) {
+		notifier.EXPECT().
+			Notify(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, n interfaces.Notification) error {
+				assert.Equal(t, severity, n.Severity)
+				return nil
+			})
+	}
+
+	// Healthy from the start: nothing to report.
+	check(interfaces.TransmitterSummary{TotalJobs: 1, FoundJobs: 1})
+
+	expectAlert(interfaces.NotificationSeverityWarning)
+	check(interfaces.TransmitterSummary{TotalJobs: 1, StaleJobs: 1})
+
+	// Unchanged status is not re-sent.
+	check(interfaces.TransmitterSummary{TotalJobs: 1, StaleJobs: 1})
+
+	expectAlert(interfaces.NotificationSeverityCritical)
+	check(interfaces.TransmitterSummary{TotalJobs: 1, MissingJobs: 1})
+
+	expectAlert(interfaces.NotificationSeverityInfo)
+	check(interfaces.TransmitterSummary{TotalJobs: 1, FoundJobs: 1})
+}
+
+func TestTransmitterMonitor_LogSampling(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	params := interfaces.WatchTransmittersParams{
+		TransmitterAddress: common.HexToAddress("0xa000000000000000000000000000000000000000"),
+		RoundsToCheck:      10,
+	}
+
+	watchUseCase := mocks.NewMockWatchTransmittersUseCase(ctrl)
+	recorder := mocks.NewMockMetricsRecorder(ctrl)
+	recorder.EXPECT().RecordWatchResult(gomock.Any(), gomock.Any()).AnyTimes()
+	recorder.EXPECT().RecordCheckError(gomock.Any()).AnyTimes()
+
+	var completed, changed, failed int
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).
+		Do(func(msg string, _ ...interface{}) {
+			switch msg {
+			case "Monitor check completed":
+				completed++
+			case "Monitor status changed":
+				changed++
+			}
+		}).AnyTimes()
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).
+		Do(func(string, ...interface{}) { failed++ }).AnyTimes()
+
+	monitor := NewTransmitterMonitor(watchUseCase, recorder, nil, AlertMessageOptions{}, logger, params, MonitorOptions{LogSampleRate: 5})
+
+	check := func(summary interfaces.TransmitterSummary) {
+		watchUseCase.EXPECT().Execute(ctx, params).Return(&interfaces.WatchTransmittersResult{Summary: summary}, nil)
+		_, err := monitor.Check(ctx)
+		require.NoError(t, err)
+	}
+	healthy := interfaces.TransmitterSummary{TotalJobs: 1, FoundJobs: 1}
+
+	// Twelve identical healthy checks log on checks 1, 6 and 11 only.
+	for i := 0; i < 12; i++ {
+		check(healthy)
+	}
+	assert.Equal(t, 3, completed)
+	assert.Zero(t, changed)
+
+	// A status change is logged even between samples, and so is the recovery.
+	check(interfaces.TransmitterSummary{TotalJobs: 1, MissingJobs: 1})
+	check(healthy)
+	assert.Equal(t, 5, completed)
+	assert.Equal(t, 2, changed)
+
+	// Failures are never sampled.
+	for i := 0; i < 3; i++ {
+		watchUseCase.EXPECT().Execute(ctx, params).Return(nil, errors.New("db down"))
+		_, err := monitor.Check(ctx)
+		require.Error(t, err)
+	}
+	assert.Equal(t, 3, failed)
+}
+
+func TestTransmitterMonitor_AlertCooldown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	params := interfaces.WatchTransmittersParams{
+		TransmitterAddress: common.HexToAddress("0xa000000000000000000000000000000000000000"),
+		RoundsToCheck:      10,
+	}
+
+	watchUseCase := mocks.NewMockWatchTransmittersUseCase(ctrl)
+	recorder := mocks.NewMockMetricsRecorder(ctrl)
+	recorder.EXPECT().RecordWatchResult(gomock.Any(), gomock.Any()).AnyTimes()
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	var sent []interfaces.NotificationSeverity
+	notifier := mocks.NewMockNotifier(ctrl)
+	notifier.EXPECT().Notify(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, n interfaces.Notification) error {
+			sent = append(sent, n.Severity)
+			return nil
+		}).AnyTimes()
+
+	monitor := NewTransmitterMonitor(watchUseCase, recorder, notifier, AlertMessageOptions{}, logger, params,
+		MonitorOptions{AlertCooldown: time.Hour})
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	monitor.now = func() time.Time { return now }
+
+	stale := interfaces.TransmitterSummary{TotalJobs: 1, StaleJobs: 1}
+	healthy := interfaces.TransmitterSummary{TotalJobs: 1, FoundJobs: 1}
+	missing := interfaces.TransmitterSummary{TotalJobs: 1, MissingJobs: 1}
+	check := func(after time.Duration, summary interfaces.TransmitterSummary) {
+		now = now.Add(after)
+		watchUseCase.EXPECT().Execute(ctx, params).Return(&interfaces.WatchTransmittersResult{Summary: summary}, nil)
+		_, err := monitor.Check(ctx)
+		require.NoError(t, err)
+	}
+
+	// A feed stale for most of an hour, checked every 30 seconds, alerts once.
+	for i := 0; i < 100; i++ {
+		check(30*time.Second, stale)
+	}
+	assert.Equal(t, []interfaces.NotificationSeverity{interfaces.NotificationSeverityWarning}, sent)
+
+	// Flapping back to stale within the cooldown does not re-alert the warning.
+	check(30*time.Second, healthy)
+	check(30*time.Second, stale)
+	assert.Equal(t, []interfaces.NotificationSeverity{
+		interfaces.NotificationSeverityWarning,
+		interfaces.NotificationSeverityInfo,
+	}, sent)
+
+	// Once the cooldown has elapsed the persisting problem is alerted again.
+	check(10*time.Minute, stale)
+	assert.Len(t, sent, 3)
+	assert.Equal(t, interfaces.NotificationSeverityWarning, sent[2])
+
+	// Escalation is never suppressed, even right after the same alert.
+	check(30*time.Second, missing)
+	check(30*time.Second, stale)
+	check(30*time.Second, missing)
+	assert.Equal(t, []interfaces.NotificationSeverity{
+		interfaces.NotificationSeverityCritical,
+		interfaces.NotificationSeverityCritical,
+	}, sent[3:])
+}
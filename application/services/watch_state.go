@@ -0,0 +1,115 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// WatchState holds the per-job statuses of a watch run so the next run can
+// report only the jobs whose status changed.
+type WatchState struct {
+	SavedAt     time.Time                     `json:"saved_at"`
+	Transmitter common.Address                `json:"transmitter"`
+	Jobs        map[string]entities.JobStatus `json:"jobs"`
+}
+
+// StatusChange is a job whose status differs from the previous run.
+// Previous is empty for a job the previous run did not check.
+type StatusChange struct {
+	Previous entities.JobStatus
+	Status   entities.TransmitterStatus
+}
+
+// NewWatchState returns the state of a watch result, keyed by job ID.
+func NewWatchState(transmitter common.Address, result *interfaces.WatchTransmittersResult) WatchState {
+	state := WatchState{
+		SavedAt:     time.Now().UTC(),
+		Transmitter: transmitter,
+		Jobs:        make(map[string]entities.JobStatus, len(result.Statuses)),
+	}
+	for _, status := range result.Statuses {
+		state.Jobs[status.JobID] = status.Status
+	}
+	return state
+}
+
+// Changes returns the statuses of result that differ from the state, in
+// result order. Jobs that are no longer checked are not reported.
+func (s WatchState) Changes(result *interfaces.WatchTransmittersResult) []StatusChange {
+	var changes []StatusChange
+	for _, status := range result.Statuses {
+		previous, ok := s.Jobs[status.JobID]
+		if ok && previous == status.Status {
+			continue
+		}
+		changes = append(changes, StatusChange{Previous: previous, Status: status})
+	}
+	return changes
+}
+
+// BuildStatusChangeMessage builds a notification listing the jobs whose status
+// changed, recoveries included. The summary still covers every job.
+func BuildStatusChangeMessage(
+	transmitter common.Address,
+	result *interfaces.WatchTransmittersResult,
+	changes []StatusChange,
+	opts AlertMessageOptions,
+) interfaces.Notification {
+	notification := BuildAlertMessage(transmitter, &interfaces.WatchTransmittersResult{Summary: result.Summary}, opts)
+	notification.Fields = alertFields(transmitter, result, opts.ChainID)
+	for _, change := range changes {
+		notification.Details = append(notification.Details,
+			fmt.Sprintf("was %s: %s", FormatPreviousStatus(change.Previous), formatJobStatus(change.Status)))
+	}
+	return notification
+}
+
+// FormatPreviousStatus formats a previous status, "new" for a job not seen before.
+func FormatPreviousStatus(status entities.JobStatus) string {
+	if status == "" {
+		return "new"
+	}
+	return string(status)
+}
+
+// WriteWatchState saves a watch state as JSON, replacing the file only once it is fully written.
+func WriteWatchState(path string, state WatchState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode watch state: %w", err)
+	}
+
+	cleanPath := filepath.Clean(path)
+	tmpPath := cleanPath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write watch state: %w", err)
+	}
+	if err := os.Rename(tmpPath, cleanPath); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to replace watch state: %w", err)
+	}
+
+	return nil
+}
+
+// ReadWatchState loads a watch state written by WriteWatchState.
+func ReadWatchState(path string) (WatchState, error) {
+	var state WatchState
+
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return state, fmt.Errorf("failed to read watch state: %w", err)
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return state, fmt.Errorf("failed to decode watch state: %w", err)
+	}
+
+	return state, nil
+}
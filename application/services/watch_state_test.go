@@ -0,0 +1,43 @@
+package services
+
+import (
+	"path/filepath"
+	"testing"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchState_Changes(t *testing.T) {
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000000")
+	previous := NewWatchState(transmitter, &interfaces.WatchTransmittersResult{
+		Statuses: []entities.TransmitterStatus{
+			{JobID: "recovered", Status: entities.JobStatusStale},
+			{JobID: "unchanged", Status: entities.JobStatusFound},
+			{JobID: "removed", Status: entities.JobStatusFound},
+		},
+	})
+
+	// Round-trip through the file the next run reads.
+	path := filepath.Join(t.TempDir(), "state.json")
+	require.NoError(t, WriteWatchState(path, previous))
+	loaded, err := ReadWatchState(path)
+	require.NoError(t, err)
+	assert.Equal(t, transmitter, loaded.Transmitter)
+
+	changes := loaded.Changes(&interfaces.WatchTransmittersResult{
+		Statuses: []entities.TransmitterStatus{
+			{JobID: "unchanged", Status: entities.JobStatusFound},
+			{JobID: "recovered", Status: entities.JobStatusFound},
+			{JobID: "added", Status: entities.JobStatusMissing},
+		},
+	})
+	require.Len(t, changes, 2)
+	assert.Equal(t, "recovered", changes[0].Status.JobID)
+	assert.Equal(t, entities.JobStatusStale, changes[0].Previous)
+	assert.Equal(t, "added", changes[1].Status.JobID)
+	assert.Equal(t, "new", FormatPreviousStatus(changes[1].Previous))
+}
@@ -0,0 +1,197 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultArchiveChunk is the number of blocks fetched and checkpointed at once by an archive.
+const DefaultArchiveChunk = 10000
+
+// defaultArchiveOutput is where a new archive is written without an output path.
+const defaultArchiveOutput = "results/archive-%s.jsonl.gz"
+
+// archiveTransmissionsUseCase implements the ArchiveTransmissionsUseCase interface.
+type archiveTransmissionsUseCase struct {
+	blockchainClient  interfaces.BlockchainClient
+	aggregatorService interfaces.OCR2AggregatorService
+	logger            interfaces.Logger
+}
+
+// NewArchiveTransmissionsUseCase creates a new archive transmissions use case.
+func NewArchiveTransmissionsUseCase(
+	blockchainClient interfaces.BlockchainClient,
+	aggregatorService interfaces.OCR2AggregatorService,
+	logger interfaces.Logger,
+) interfaces.ArchiveTransmissionsUseCase {
+	return &archiveTransmissionsUseCase{
+		blockchainClient:  blockchainClient,
+		aggregatorService: aggregatorService,
+		logger:            logger,
+	}
+}
+
+// Execute archives transmissions from the start block, or the checkpoint, up
+// to head, checkpointing after every chunk. Chunks are fetched one at a time
+// so the checkpoint always covers a contiguous prefix of the range.
+func (uc *archiveTransmissionsUseCase) Execute(
+	ctx context.Context,
+	params interfaces.ArchiveTransmissionsParams,
+) (*interfaces.ArchiveTransmissionsResult, error) {
+	// Validate parameters
+	if err := uc.validateParams(params); err != nil {
+		return nil, err
+	}
+
+	chunkSize := params.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = DefaultArchiveChunk
+	}
+
+	state, err := uc.loadState(params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &interfaces.ArchiveTransmissionsResult{
+		ContractAddress: params.ContractAddress,
+		OutputPath:      state.OutputPath,
+		StartBlock:      state.FromBlock,
+		Resumed:         state.Size > 0,
+		ResumeBlock:     state.NextBlock,
+		Transmissions:   state.Transmissions,
+	}
+
+	// Head already trails the chain by the configured confirmations.
+	head, err := uc.blockchainClient.GetBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.EndBlock = head
+
+	archive, err := services.OpenArchive(state.OutputPath, state.ContractAddress, state.Size)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = archive.Close() }()
+
+	// Checkpoint the header so an interrupted first chunk keeps it.
+	state.Size = archive.Size()
+	if err := services.WriteArchiveState(params.StatePath, state); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Archiving transmissions",
+		"contract", params.ContractAddress.Hex(),
+		"output", state.OutputPath,
+		"resumeBlock", state.NextBlock,
+		"endBlock", head)
+
+	for state.NextBlock <= head {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		start := state.NextBlock
+		end := head
+		if head-start >= chunkSize {
+			end = start + chunkSize - 1
+		}
+
+		transmissions, err := uc.aggregatorService.GetTransmissions(ctx, params.ContractAddress, start, end,
+			interfaces.FetchOptions{SkipTimestamps: params.SkipTimestamps})
+		if err != nil {
+			uc.logger.Error("Failed to fetch transmissions",
+				"startBlock", start,
+				"endBlock", end,
+				"error", err)
+			return result, err
+		}
+
+		if err := archive.Append(transmissions); err != nil {
+			return result, err
+		}
+
+		state.NextBlock = end + 1
+		state.Size = archive.Size()
+		state.Transmissions += len(transmissions)
+		if err := services.WriteArchiveState(params.StatePath, state); err != nil {
+			return result, err
+		}
+
+		result.ChunksFetched++
+		result.Transmissions = state.Transmissions
+
+		uc.logger.Info("Archived chunk",
+			"startBlock", start,
+			"endBlock", end,
+			"transmissions", len(transmissions),
+			"total", state.Transmissions)
+	}
+
+	return result, nil
+}
+
+// loadState returns the checkpoint to continue from, or a fresh state when
+// there is none. A checkpoint of a different archive is rejected.
+func (uc *archiveTransmissionsUseCase) loadState(
+	params interfaces.ArchiveTransmissionsParams,
+) (*services.ArchiveState, error) {
+	state, err := services.ReadArchiveState(params.StatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if state == nil {
+		outputPath := params.OutputPath
+		if outputPath == "" {
+			outputPath = fmt.Sprintf(defaultArchiveOutput, params.ContractAddress.Hex())
+		}
+		return &services.ArchiveState{
+			ContractAddress: params.ContractAddress,
+			OutputPath:      filepath.Clean(outputPath),
+			FromBlock:       params.FromBlock,
+			NextBlock:       params.FromBlock,
+		}, nil
+	}
+
+	validationErr := &errors.ValidationError{}
+	if state.ContractAddress != params.ContractAddress {
+		validationErr.AddFieldError("resume", fmt.Sprintf(
+			"state file is for contract %s, not %s", state.ContractAddress.Hex(), params.ContractAddress.Hex()))
+	}
+	if params.OutputPath != "" && filepath.Clean(params.OutputPath) != state.OutputPath {
+		validationErr.AddFieldError("output", fmt.Sprintf(
+			"state file archives to %s, not %s", state.OutputPath, params.OutputPath))
+	}
+	if validationErr.HasErrors() {
+		return nil, validationErr
+	}
+
+	return state, nil
+}
+
+// validateParams validates the archive parameters.
+func (uc *archiveTransmissionsUseCase) validateParams(params interfaces.ArchiveTransmissionsParams) error {
+	validationErr := &errors.ValidationError{}
+
+	if params.ContractAddress == (common.Address{}) {
+		validationErr.AddFieldError("contract_address", "contract address is required")
+	}
+
+	if params.StatePath == "" {
+		validationErr.AddFieldError("resume", "state file path is required")
+	}
+
+	if validationErr.HasErrors() {
+		return validationErr
+	}
+
+	return nil
+}
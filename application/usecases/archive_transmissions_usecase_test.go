@@ -0,0 +1,143 @@
+package usecases
+
+import (
+	"context"
+	stderrors "errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/helpers"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveTransmissionsUseCase_Resume(t *testing.T) {
+	ctx := context.Background()
+	contractAddr := helpers.RandomAddress()
+	dir := t.TempDir()
+	params := interfaces.ArchiveTransmissionsParams{
+		ContractAddress: contractAddr,
+		FromBlock:       1000,
+		OutputPath:      filepath.Join(dir, "archive.jsonl.gz"),
+		StatePath:       filepath.Join(dir, "archive.state.json"),
+		SkipTimestamps:  true,
+		ChunkSize:       100,
+	}
+	opts := interfaces.FetchOptions{SkipTimestamps: true}
+
+	// One transmission per chunk, numbered by the chunk's first block.
+	chunk := func(start uint64) []entities.Transmission {
+		return []entities.Transmission{{
+			ContractAddress: contractAddr,
+			Epoch:           uint32(start / 100),
+			Round:           1,
+			BlockNumber:     start,
+		}}
+	}
+
+	newUseCase := func(ctrl *gomock.Controller) (
+		interfaces.ArchiveTransmissionsUseCase, *mocks.MockBlockchainClient, *mocks.MockOCR2AggregatorService,
+	) {
+		client := mocks.NewMockBlockchainClient(ctrl)
+		aggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+		logger := mocks.NewMockLogger(ctrl)
+		logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+		logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+		return NewArchiveTransmissionsUseCase(client, aggregator, logger), client, aggregator
+	}
+
+	// The first run archives two chunks, then the third fails as if the
+	// process had been cut off.
+	ctrl := gomock.NewController(t)
+	useCase, client, aggregator := newUseCase(ctrl)
+	client.EXPECT().GetBlockNumber(ctx).Return(uint64(1449), nil)
+	gomock.InOrder(
+		aggregator.EXPECT().GetTransmissions(ctx, contractAddr, uint64(1000), uint64(1099), opts).Return(chunk(1000), nil),
+		aggregator.EXPECT().GetTransmissions(ctx, contractAddr, uint64(1100), uint64(1199), opts).Return(chunk(1100), nil),
+		aggregator.EXPECT().GetTransmissions(ctx, contractAddr, uint64(1200), uint64(1299), opts).
+			Return(nil, stderrors.New("connection reset")),
+	)
+
+	result, err := useCase.Execute(ctx, params)
+	require.Error(t, err)
+	assert.Equal(t, 2, result.ChunksFetched)
+	ctrl.Finish()
+
+	state, err := services.ReadArchiveState(params.StatePath)
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	assert.Equal(t, uint64(1200), state.NextBlock)
+	assert.Equal(t, 2, state.Transmissions)
+
+	// Bytes of a chunk cut off mid-write are dropped on resume.
+	file, err := os.OpenFile(params.OutputPath, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = file.Write([]byte{0x1f, 0x8b, 0x08, 0x00, 0xde, 0xad})
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	// The resumed run only fetches the chunks after the checkpoint.
+	ctrl = gomock.NewController(t)
+	useCase, client, aggregator = newUseCase(ctrl)
+	client.EXPECT().GetBlockNumber(ctx).Return(uint64(1449), nil)
+	gomock.InOrder(
+		aggregator.EXPECT().GetTransmissions(ctx, contractAddr, uint64(1200), uint64(1299), opts).Return(chunk(1200), nil),
+		aggregator.EXPECT().GetTransmissions(ctx, contractAddr, uint64(1300), uint64(1399), opts).Return(chunk(1300), nil),
+		aggregator.EXPECT().GetTransmissions(ctx, contractAddr, uint64(1400), uint64(1449), opts).Return(chunk(1400), nil),
+	)
+
+	resumed := params
+	resumed.FromBlock = 0 // The checkpoint's start wins.
+	result, err = useCase.Execute(ctx, resumed)
+	require.NoError(t, err)
+	ctrl.Finish()
+	assert.True(t, result.Resumed)
+	assert.Equal(t, uint64(1200), result.ResumeBlock)
+	assert.Equal(t, uint64(1000), result.StartBlock)
+	assert.Equal(t, uint64(1449), result.EndBlock)
+	assert.Equal(t, 3, result.ChunksFetched)
+	assert.Equal(t, 5, result.Transmissions)
+
+	// The archive holds every chunk once, in block order.
+	archived, format, err := services.ReadTransmissionResult(params.OutputPath)
+	require.NoError(t, err)
+	assert.Equal(t, interfaces.OutputFormatJSONL, format)
+	assert.Equal(t, contractAddr, archived.ContractAddress)
+	require.Len(t, archived.Transmissions, 5)
+	for i, tx := range archived.Transmissions {
+		assert.Equal(t, uint64(1000+100*i), tx.BlockNumber)
+	}
+}
+
+func TestArchiveTransmissionsUseCase_StateMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir()
+	statePath := filepath.Join(dir, "archive.state.json")
+	require.NoError(t, services.WriteArchiveState(statePath, &services.ArchiveState{
+		ContractAddress: helpers.RandomAddress(),
+		OutputPath:      filepath.Join(dir, "other.jsonl.gz"),
+		Size:            10,
+	}))
+
+	useCase := NewArchiveTransmissionsUseCase(
+		mocks.NewMockBlockchainClient(ctrl), mocks.NewMockOCR2AggregatorService(ctrl), mocks.NewMockLogger(ctrl))
+	_, err := useCase.Execute(context.Background(), interfaces.ArchiveTransmissionsParams{
+		ContractAddress: helpers.RandomAddress(),
+		OutputPath:      filepath.Join(dir, "archive.jsonl.gz"),
+		StatePath:       statePath,
+	})
+
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, validationErr.Fields, "resume")
+	assert.Contains(t, validationErr.Fields, "output")
+}
@@ -0,0 +1,100 @@
+package usecases
+
+import (
+	"context"
+
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// configDiffUseCase implements the ConfigDiffUseCase interface.
+type configDiffUseCase struct {
+	aggregatorService interfaces.OCR2AggregatorService
+	logger            interfaces.Logger
+}
+
+// NewConfigDiffUseCase creates a new config diff use case.
+func NewConfigDiffUseCase(
+	aggregatorService interfaces.OCR2AggregatorService,
+	logger interfaces.Logger,
+) interfaces.ConfigDiffUseCase {
+	return &configDiffUseCase{
+		aggregatorService: aggregatorService,
+		logger:            logger,
+	}
+}
+
+// Execute compares the contract's current ConfigSet with the expected configuration.
+func (uc *configDiffUseCase) Execute(
+	ctx context.Context,
+	params interfaces.ConfigDiffParams,
+) (*interfaces.ConfigDiffResult, error) {
+	// Validate parameters
+	if err := uc.validateParams(params); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Comparing config", "contract", params.ContractAddress.Hex())
+
+	current, err := uc.aggregatorService.GetLatestConfigSet(ctx, params.ContractAddress)
+	if err != nil {
+		uc.logger.Error("Failed to get current config", "error", err)
+		return nil, err
+	}
+
+	expected := params.Expected
+	result := &interfaces.ConfigDiffResult{
+		ContractAddress: params.ContractAddress,
+		BlockNumber:     current.BlockNumber,
+		ConfigDigest:    current.Config.ConfigDigest,
+		ExpectedF:       expected.F,
+		ActualF:         current.Config.Threshold,
+	}
+	if expected.Transmitters != nil {
+		result.AddedTransmitters = missingAddresses(current.Config.Transmitters, expected.Transmitters)
+		result.RemovedTransmitters = missingAddresses(expected.Transmitters, current.Config.Transmitters)
+	}
+	if expected.Signers != nil {
+		result.AddedSigners = missingAddresses(current.Config.Signers, expected.Signers)
+		result.RemovedSigners = missingAddresses(expected.Signers, current.Config.Signers)
+	}
+
+	return result, nil
+}
+
+// validateParams validates the config diff parameters.
+func (uc *configDiffUseCase) validateParams(params interfaces.ConfigDiffParams) error {
+	validationErr := &errors.ValidationError{}
+
+	if params.ContractAddress == (common.Address{}) {
+		validationErr.AddFieldError("contract_address", "contract address is required")
+	}
+
+	expected := params.Expected
+	if expected.Transmitters == nil && expected.Signers == nil && expected.F == nil {
+		validationErr.AddFieldError("expected", "expected config has no transmitters, signers, or f")
+	}
+
+	if validationErr.HasErrors() {
+		return validationErr
+	}
+
+	return nil
+}
+
+// missingAddresses returns the addresses of from that are not in set, in order.
+func missingAddresses(from, set []common.Address) []common.Address {
+	present := make(map[common.Address]bool, len(set))
+	for _, address := range set {
+		present[address] = true
+	}
+
+	var missing []common.Address
+	for _, address := range from {
+		if !present[address] {
+			missing = append(missing, address)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,70 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/helpers"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigDiffUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewConfigDiffUseCase(mockAggregator, mockLogger)
+	ctx := context.Background()
+	contract := helpers.RandomAddress()
+	kept, added, removed := helpers.RandomAddress(), helpers.RandomAddress(), helpers.RandomAddress()
+	f := uint8(1)
+
+	mockAggregator.EXPECT().GetLatestConfigSet(ctx, contract).Return(&entities.ConfigSetEvent{
+		Config: entities.OCR2Config{
+			Transmitters: []common.Address{kept, added},
+			Signers:      []common.Address{helpers.RandomAddress()},
+			Threshold:    1,
+		},
+	}, nil)
+
+	// Signers are left out of the expected config, so they are not compared.
+	result, err := useCase.Execute(ctx, interfaces.ConfigDiffParams{
+		ContractAddress: contract,
+		Expected: entities.ExpectedOCR2Config{
+			Transmitters: []common.Address{removed, kept},
+			F:            &f,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []common.Address{added}, result.AddedTransmitters)
+	assert.Equal(t, []common.Address{removed}, result.RemovedTransmitters)
+	assert.Empty(t, result.AddedSigners)
+	assert.False(t, result.FChanged())
+	assert.True(t, result.HasDrift())
+}
+
+func TestConfigDiffUseCase_EmptyExpectedConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	useCase := NewConfigDiffUseCase(mocks.NewMockOCR2AggregatorService(ctrl), mocks.NewMockLogger(ctrl))
+
+	_, err := useCase.Execute(context.Background(), interfaces.ConfigDiffParams{
+		ContractAddress: helpers.RandomAddress(),
+	})
+
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, validationErr.Fields, "expected")
+}
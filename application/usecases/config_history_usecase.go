@@ -0,0 +1,153 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultConfigHistoryChunk is the number of blocks filtered per ConfigSet query.
+const DefaultConfigHistoryChunk = 10000
+
+// configHistoryUseCase implements the ConfigHistoryUseCase interface.
+type configHistoryUseCase struct {
+	blockchainClient  interfaces.BlockchainClient
+	aggregatorService interfaces.OCR2AggregatorService
+	logger            interfaces.Logger
+	chunkSize         uint64
+}
+
+// NewConfigHistoryUseCase creates a new config history use case.
+func NewConfigHistoryUseCase(
+	blockchainClient interfaces.BlockchainClient,
+	aggregatorService interfaces.OCR2AggregatorService,
+	logger interfaces.Logger,
+) interfaces.ConfigHistoryUseCase {
+	return &configHistoryUseCase{
+		blockchainClient:  blockchainClient,
+		aggregatorService: aggregatorService,
+		logger:            logger,
+		chunkSize:         DefaultConfigHistoryChunk,
+	}
+}
+
+// Execute returns the ConfigSet events for the requested block range in block order.
+func (uc *configHistoryUseCase) Execute(
+	ctx context.Context,
+	params interfaces.ConfigHistoryParams,
+) (*interfaces.ConfigHistoryResult, error) {
+	// Validate parameters
+	if err := uc.validateParams(params); err != nil {
+		return nil, err
+	}
+
+	endBlock, err := uc.resolveEndBlock(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Collecting config history",
+		"contract", params.ContractAddress.Hex(),
+		"startBlock", params.FromBlock,
+		"endBlock", endBlock)
+
+	// Query in chunks so RPC log range limits are respected.
+	var configs []entities.ConfigSetEvent
+	for start := params.FromBlock; start <= endBlock; start += uc.chunkSize {
+		end := endBlock
+		if endBlock-start >= uc.chunkSize {
+			end = start + uc.chunkSize - 1
+		}
+
+		events, err := uc.aggregatorService.GetConfigHistory(ctx, params.ContractAddress, start, end)
+		if err != nil {
+			uc.logger.Error("Failed to fetch config history",
+				"startBlock", start,
+				"endBlock", end,
+				"error", err)
+			return nil, err
+		}
+		configs = append(configs, events...)
+
+		// Guard against wrapping past the last block.
+		if end == endBlock {
+			break
+		}
+	}
+
+	entities.SortConfigSetEvents(configs)
+
+	result := &interfaces.ConfigHistoryResult{
+		ContractAddress: params.ContractAddress,
+		StartBlock:      params.FromBlock,
+		EndBlock:        endBlock,
+		Configs:         configs,
+		Transmitter:     params.Transmitter,
+	}
+
+	if params.Transmitter != nil {
+		result.IndexChanges = entities.TransmitterIndexChanges(configs, *params.Transmitter)
+		for _, change := range result.IndexChanges {
+			uc.logger.Warn("Transmitter index changed",
+				"transmitter", params.Transmitter.Hex(),
+				"block", change.BlockNumber,
+				"configDigest", fmt.Sprintf("%x", change.ConfigDigest),
+				"oldIndex", change.OldIndex,
+				"newIndex", change.NewIndex)
+		}
+	}
+
+	return result, nil
+}
+
+// validateParams validates the config history parameters.
+func (uc *configHistoryUseCase) validateParams(params interfaces.ConfigHistoryParams) error {
+	validationErr := &errors.ValidationError{}
+
+	if params.ContractAddress == (common.Address{}) {
+		validationErr.AddFieldError("contract_address", "contract address is required")
+	}
+
+	if params.ToBlock != nil && params.FromBlock > *params.ToBlock {
+		validationErr.AddFieldError(
+			"block_range",
+			fmt.Sprintf("invalid range: from=%d > to=%d", params.FromBlock, *params.ToBlock),
+		)
+	}
+
+	if validationErr.HasErrors() {
+		return validationErr
+	}
+
+	return nil
+}
+
+// resolveEndBlock returns the last block of the range, defaulting to head.
+func (uc *configHistoryUseCase) resolveEndBlock(
+	ctx context.Context,
+	params interfaces.ConfigHistoryParams,
+) (uint64, error) {
+	if params.ToBlock != nil {
+		return *params.ToBlock, nil
+	}
+
+	head, err := uc.blockchainClient.GetBlockNumber(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if params.FromBlock > head {
+		validationErr := &errors.ValidationError{}
+		validationErr.AddFieldError(
+			"block_range",
+			fmt.Sprintf("invalid range: from=%d > to=%d", params.FromBlock, head),
+		)
+		return 0, validationErr
+	}
+
+	return head, nil
+}
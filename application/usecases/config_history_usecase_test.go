@@ -0,0 +1,132 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/helpers"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigHistoryUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockBlockchainClient(ctrl)
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewConfigHistoryUseCase(mockClient, mockAggregator, mockLogger)
+	ctx := context.Background()
+	contractAddr := helpers.RandomAddress()
+
+	t.Run("chunks the range and lists configs in block order", func(t *testing.T) {
+		to := uint64(2*DefaultConfigHistoryChunk + 150)
+
+		gomock.InOrder(
+			mockAggregator.EXPECT().
+				GetConfigHistory(ctx, contractAddr, uint64(100), uint64(DefaultConfigHistoryChunk+99)).
+				Return([]entities.ConfigSetEvent{{BlockNumber: 500, ConfigCount: 1}}, nil),
+			mockAggregator.EXPECT().
+				GetConfigHistory(ctx, contractAddr, uint64(DefaultConfigHistoryChunk+100), uint64(2*DefaultConfigHistoryChunk+99)).
+				Return([]entities.ConfigSetEvent{
+					{BlockNumber: 15000, ConfigCount: 3},
+					{BlockNumber: 12000, ConfigCount: 2},
+				}, nil),
+			mockAggregator.EXPECT().
+				GetConfigHistory(ctx, contractAddr, uint64(2*DefaultConfigHistoryChunk+100), to).
+				Return(nil, nil),
+		)
+
+		result, err := useCase.Execute(ctx, interfaces.ConfigHistoryParams{
+			ContractAddress: contractAddr,
+			FromBlock:       100,
+			ToBlock:         &to,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100), result.StartBlock)
+		assert.Equal(t, to, result.EndBlock)
+		require.Len(t, result.Configs, 3)
+		for i, event := range result.Configs {
+			assert.Equal(t, uint64(i+1), event.ConfigCount)
+		}
+	})
+
+	t.Run("runs to head without to-block", func(t *testing.T) {
+		mockClient.EXPECT().GetBlockNumber(ctx).Return(uint64(5000), nil)
+		mockAggregator.EXPECT().
+			GetConfigHistory(ctx, contractAddr, uint64(4000), uint64(5000)).
+			Return(nil, nil)
+
+		result, err := useCase.Execute(ctx, interfaces.ConfigHistoryParams{
+			ContractAddress: contractAddr,
+			FromBlock:       4000,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5000), result.EndBlock)
+		assert.Empty(t, result.Configs)
+	})
+
+	t.Run("reports transmitter index drift", func(t *testing.T) {
+		address := func(b byte) common.Address { return common.BytesToAddress([]byte{b}) }
+		ours := address(0xaa)
+		to := uint64(3000)
+
+		// Our transmitter sits at index 3 until transmitter 2 is removed,
+		// which shifts it to index 2; a later rotation keeps it there.
+		mockAggregator.EXPECT().
+			GetConfigHistory(ctx, contractAddr, uint64(1000), to).
+			Return([]entities.ConfigSetEvent{
+				{BlockNumber: 1100, ConfigCount: 1, Config: entities.OCR2Config{
+					ConfigDigest: [32]byte{1},
+					Transmitters: []common.Address{address(1), address(2), address(3), ours},
+				}},
+				{BlockNumber: 2200, ConfigCount: 2, Config: entities.OCR2Config{
+					ConfigDigest: [32]byte{2},
+					Transmitters: []common.Address{address(1), address(3), ours},
+				}},
+				{BlockNumber: 2900, ConfigCount: 3, Config: entities.OCR2Config{
+					ConfigDigest: [32]byte{3},
+					Transmitters: []common.Address{address(4), address(3), ours},
+				}},
+			}, nil)
+		mockLogger.EXPECT().Warn("Transmitter index changed", gomock.Any()).Times(1)
+
+		result, err := useCase.Execute(ctx, interfaces.ConfigHistoryParams{
+			ContractAddress: contractAddr,
+			FromBlock:       1000,
+			ToBlock:         &to,
+			Transmitter:     &ours,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &ours, result.Transmitter)
+		assert.Equal(t, []entities.TransmitterIndexChange{{
+			BlockNumber:  2200,
+			ConfigCount:  2,
+			ConfigDigest: [32]byte{2},
+			OldIndex:     3,
+			NewIndex:     2,
+		}}, result.IndexChanges)
+	})
+
+	t.Run("rejects inverted range", func(t *testing.T) {
+		to := uint64(10)
+		_, err := useCase.Execute(ctx, interfaces.ConfigHistoryParams{
+			ContractAddress: contractAddr,
+			FromBlock:       20,
+			ToBlock:         &to,
+		})
+		var validationErr *errors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
@@ -0,0 +1,185 @@
+// Package usecases contains application use cases that orchestrate business logic.
+// It implements the primary operations for fetching, parsing, and watching OCR transmissions.
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultInfoBlocks is the default number of blocks scanned back from head.
+const DefaultInfoBlocks = 1000
+
+// contractInfoUseCase implements the ContractInfoUseCase interface.
+type contractInfoUseCase struct {
+	blockchainClient    interfaces.BlockchainClient
+	transmissionFetcher interfaces.TransmissionFetcher
+	logger              interfaces.Logger
+	now                 func() time.Time
+}
+
+// NewContractInfoUseCase creates a new contract info use case.
+func NewContractInfoUseCase(
+	blockchainClient interfaces.BlockchainClient,
+	transmissionFetcher interfaces.TransmissionFetcher,
+	logger interfaces.Logger,
+) interfaces.ContractInfoUseCase {
+	return &contractInfoUseCase{
+		blockchainClient:    blockchainClient,
+		transmissionFetcher: transmissionFetcher,
+		logger:              logger,
+		now:                 time.Now,
+	}
+}
+
+// Execute computes round statistics for the requested block window.
+func (uc *contractInfoUseCase) Execute(
+	ctx context.Context,
+	params interfaces.ContractInfoParams,
+) (*interfaces.ContractInfoResult, error) {
+	// Validate parameters
+	if err := uc.validateParams(params); err != nil {
+		return nil, err
+	}
+
+	startBlock, endBlock, err := uc.blockWindow(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Collecting contract info",
+		"contract", params.ContractAddress.Hex(),
+		"startBlock", startBlock,
+		"endBlock", endBlock)
+
+	transmissions, err := uc.transmissionFetcher.FetchByBlocks(
+		ctx,
+		params.ContractAddress,
+		startBlock,
+		endBlock,
+		interfaces.FetchOptions{SkipTimestamps: true},
+	)
+	if err != nil {
+		uc.logger.Error("Failed to fetch transmissions", "error", err)
+		return nil, err
+	}
+
+	result := &interfaces.ContractInfoResult{
+		ContractAddress:      params.ContractAddress,
+		StartBlock:           startBlock,
+		EndBlock:             endBlock,
+		TransmissionCount:    len(transmissions.Transmissions),
+		DistinctTransmitters: transmissions.CountTransmitters(),
+	}
+
+	for i, tx := range transmissions.Transmissions {
+		roundID := tx.PackedRound()
+		if i == 0 || roundID < result.FirstRound {
+			result.FirstRound = roundID
+			result.FirstAggregatorRoundID = tx.AggregatorRoundID
+		}
+		if roundID > result.LastRound {
+			result.LastRound = roundID
+			result.LastAggregatorRoundID = tx.AggregatorRoundID
+		}
+	}
+
+	return result, nil
+}
+
+// validateParams validates the contract info parameters.
+func (uc *contractInfoUseCase) validateParams(params interfaces.ContractInfoParams) error {
+	validationErr := &errors.ValidationError{}
+
+	if params.ContractAddress == (common.Address{}) {
+		validationErr.AddFieldError("contract_address", "contract address is required")
+	}
+
+	if params.FromBlock == nil && params.ToBlock == nil && params.Since == 0 && params.Blocks == 0 {
+		validationErr.AddFieldError("blocks", "blocks must be positive")
+	}
+
+	if params.Since < 0 {
+		validationErr.AddFieldError("since", "since must be positive")
+	}
+
+	if params.Since != 0 && params.FromBlock != nil {
+		validationErr.AddFieldError("since", "since cannot be combined with from block")
+	}
+
+	if params.FromBlock != nil && params.ToBlock != nil && *params.FromBlock > *params.ToBlock {
+		validationErr.AddFieldError(
+			"block_range",
+			fmt.Sprintf("invalid range: from=%d > to=%d", *params.FromBlock, *params.ToBlock),
+		)
+	}
+
+	if validationErr.HasErrors() {
+		return validationErr
+	}
+
+	return nil
+}
+
+// blockWindow resolves the block window to scan.
+// An explicit range wins; a missing start bound falls back to the Since lookback,
+// then to the Blocks window, and a missing end bound to head.
+func (uc *contractInfoUseCase) blockWindow(
+	ctx context.Context,
+	params interfaces.ContractInfoParams,
+) (uint64, uint64, error) {
+	if params.FromBlock != nil && params.ToBlock != nil {
+		return *params.FromBlock, *params.ToBlock, nil
+	}
+
+	var endBlock uint64
+	if params.ToBlock != nil {
+		endBlock = *params.ToBlock
+	} else {
+		head, err := uc.blockchainClient.GetBlockNumber(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+		endBlock = head
+	}
+
+	if params.FromBlock != nil {
+		if *params.FromBlock > endBlock {
+			validationErr := &errors.ValidationError{}
+			validationErr.AddFieldError(
+				"block_range",
+				fmt.Sprintf("invalid range: from=%d > to=%d", *params.FromBlock, endBlock),
+			)
+			return 0, 0, validationErr
+		}
+		return *params.FromBlock, endBlock, nil
+	}
+
+	if params.Since > 0 {
+		startBlock, err := uc.blockchainClient.GetBlockByTimestamp(ctx, uc.now().Add(-params.Since))
+		if err != nil {
+			return 0, 0, err
+		}
+		if startBlock > endBlock {
+			validationErr := &errors.ValidationError{}
+			validationErr.AddFieldError(
+				"block_range",
+				fmt.Sprintf("invalid range: from=%d > to=%d", startBlock, endBlock),
+			)
+			return 0, 0, validationErr
+		}
+		return startBlock, endBlock, nil
+	}
+
+	startBlock := uint64(0)
+	if endBlock+1 > params.Blocks {
+		startBlock = endBlock + 1 - params.Blocks
+	}
+
+	return startBlock, endBlock, nil
+}
@@ -0,0 +1,143 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/helpers"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractInfoUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockBlockchainClient(ctrl)
+	mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewContractInfoUseCase(mockClient, mockFetcher, mockLogger)
+	ctx := context.Background()
+	contractAddr := helpers.RandomAddress()
+	skipTimestamps := interfaces.FetchOptions{SkipTimestamps: true}
+
+	t.Run("head-relative window by default", func(t *testing.T) {
+		mockClient.EXPECT().GetBlockNumber(ctx).Return(uint64(5000), nil)
+		mockFetcher.EXPECT().
+			FetchByBlocks(ctx, contractAddr, uint64(4001), uint64(5000), skipTimestamps).
+			Return(&entities.TransmissionResult{}, nil)
+
+		result, err := useCase.Execute(ctx, interfaces.ContractInfoParams{
+			ContractAddress: contractAddr,
+			Blocks:          1000,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(4001), result.StartBlock)
+		assert.Equal(t, uint64(5000), result.EndBlock)
+	})
+
+	t.Run("explicit range overrides head-relative window", func(t *testing.T) {
+		from, to := uint64(100), uint64(200)
+		transmitter := helpers.RandomAddress()
+
+		// No GetBlockNumber call is expected for an explicit range.
+		mockFetcher.EXPECT().
+			FetchByBlocks(ctx, contractAddr, from, to, skipTimestamps).
+			Return(&entities.TransmissionResult{
+				Transmissions: []entities.Transmission{
+					{Epoch: 2, Round: 1, AggregatorRoundID: 12, TransmitterAddress: transmitter},
+					{Epoch: 1, Round: 3, AggregatorRoundID: 11, TransmitterAddress: transmitter},
+				},
+			}, nil)
+
+		result, err := useCase.Execute(ctx, interfaces.ContractInfoParams{
+			ContractAddress: contractAddr,
+			Blocks:          1000,
+			FromBlock:       &from,
+			ToBlock:         &to,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, from, result.StartBlock)
+		assert.Equal(t, to, result.EndBlock)
+		assert.Equal(t, 2, result.TransmissionCount)
+		assert.Equal(t, uint32(1<<8|3), result.FirstRound)
+		assert.Equal(t, uint32(2<<8|1), result.LastRound)
+		assert.Equal(t, uint32(11), result.FirstAggregatorRoundID)
+		assert.Equal(t, uint32(12), result.LastAggregatorRoundID)
+		require.Len(t, result.DistinctTransmitters, 1)
+		assert.Equal(t, 2, result.DistinctTransmitters[0].Count)
+	})
+
+	t.Run("from only runs to head", func(t *testing.T) {
+		from := uint64(4500)
+		mockClient.EXPECT().GetBlockNumber(ctx).Return(uint64(5000), nil)
+		mockFetcher.EXPECT().
+			FetchByBlocks(ctx, contractAddr, from, uint64(5000), skipTimestamps).
+			Return(&entities.TransmissionResult{}, nil)
+
+		_, err := useCase.Execute(ctx, interfaces.ContractInfoParams{
+			ContractAddress: contractAddr,
+			FromBlock:       &from,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("since resolves start block by timestamp", func(t *testing.T) {
+		now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+		useCase := NewContractInfoUseCase(mockClient, mockFetcher, mockLogger).(*contractInfoUseCase)
+		useCase.now = func() time.Time { return now }
+
+		mockClient.EXPECT().GetBlockNumber(ctx).Return(uint64(5000), nil)
+		mockClient.EXPECT().GetBlockByTimestamp(ctx, now.Add(-2*time.Hour)).Return(uint64(2600), nil)
+		mockFetcher.EXPECT().
+			FetchByBlocks(ctx, contractAddr, uint64(2600), uint64(5000), skipTimestamps).
+			Return(&entities.TransmissionResult{}, nil)
+
+		result, err := useCase.Execute(ctx, interfaces.ContractInfoParams{
+			ContractAddress: contractAddr,
+			Blocks:          1000,
+			Since:           2 * time.Hour,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2600), result.StartBlock)
+		assert.Equal(t, uint64(5000), result.EndBlock)
+	})
+
+	t.Run("validation error - since with from block", func(t *testing.T) {
+		from := uint64(100)
+
+		_, err := useCase.Execute(ctx, interfaces.ContractInfoParams{
+			ContractAddress: contractAddr,
+			Since:           time.Hour,
+			FromBlock:       &from,
+		})
+		require.Error(t, err)
+		validErr, ok := err.(*errors.ValidationError)
+		require.True(t, ok)
+		assert.Contains(t, validErr.Fields, "since")
+	})
+
+	t.Run("validation error - from after to", func(t *testing.T) {
+		from, to := uint64(200), uint64(100)
+
+		_, err := useCase.Execute(ctx, interfaces.ContractInfoParams{
+			ContractAddress: contractAddr,
+			FromBlock:       &from,
+			ToBlock:         &to,
+		})
+		require.Error(t, err)
+		validErr, ok := err.(*errors.ValidationError)
+		require.True(t, ok)
+		assert.Contains(t, validErr.Fields, "block_range")
+	})
+}
@@ -12,23 +12,38 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// DefaultMaxRoundRange is the default maximum number of rounds fetched in one invocation.
+const DefaultMaxRoundRange = 10000
+
 // fetchTransmissionsUseCase implements the FetchTransmissionsUseCase interface.
 type fetchTransmissionsUseCase struct {
 	transmissionFetcher    interfaces.TransmissionFetcher
+	aggregatorService      interfaces.OCR2AggregatorService
 	transmissionRepository interfaces.TransmissionRepository
 	logger                 interfaces.Logger
+	maxRoundRange          uint32
 }
 
 // NewFetchTransmissionsUseCase creates a new fetch transmissions use case.
+// A zero maxRoundRange falls back to DefaultMaxRoundRange. When aggregatorService
+// is nil, transmissions are not tagged with quorum.
 func NewFetchTransmissionsUseCase(
 	transmissionFetcher interfaces.TransmissionFetcher,
+	aggregatorService interfaces.OCR2AggregatorService,
 	transmissionRepository interfaces.TransmissionRepository,
 	logger interfaces.Logger,
+	maxRoundRange uint32,
 ) interfaces.FetchTransmissionsUseCase {
+	if maxRoundRange == 0 {
+		maxRoundRange = DefaultMaxRoundRange
+	}
+
 	return &fetchTransmissionsUseCase{
 		transmissionFetcher:    transmissionFetcher,
+		aggregatorService:      aggregatorService,
 		transmissionRepository: transmissionRepository,
 		logger:                 logger,
+		maxRoundRange:          maxRoundRange,
 	}
 }
 
@@ -42,26 +57,48 @@ func (uc *fetchTransmissionsUseCase) Execute(
 		return nil, err
 	}
 
-	uc.logger.Info("Fetching transmissions",
-		"contract", params.ContractAddress.Hex(),
-		"startRound", params.StartRound,
-		"endRound", params.EndRound)
-
 	// Fetch transmissions from blockchain
-	result, err := uc.transmissionFetcher.FetchByRounds(
-		ctx,
-		params.ContractAddress,
-		params.StartRound,
-		params.EndRound,
+	opts := interfaces.FetchOptions{
+		SkipTimestamps: params.SkipTimestamps,
+		RawLogs:        params.RawLogs,
+		Transmitter:    params.Transmitter,
+		ConfigDigest:   params.ConfigDigest,
+	}
+	var (
+		result *entities.TransmissionResult
+		err    error
 	)
+	if params.ByBlocks {
+		uc.logger.Info("Fetching transmissions",
+			"contract", params.ContractAddress.Hex(),
+			"startBlock", params.StartBlock,
+			"endBlock", params.EndBlock)
+		result, err = uc.transmissionFetcher.FetchByBlocks(
+			ctx, params.ContractAddress, params.StartBlock, params.EndBlock, opts)
+	} else {
+		uc.logger.Info("Fetching transmissions",
+			"contract", params.ContractAddress.Hex(),
+			"startRound", params.StartRound,
+			"endRound", params.EndRound)
+		opts.MaxBlockRange = params.MaxBlockRange
+		result, err = uc.transmissionFetcher.FetchByRounds(
+			ctx, params.ContractAddress, params.StartRound, params.EndRound, opts)
+	}
 	if err != nil {
 		uc.logger.Error("Failed to fetch transmissions", "error", err)
 		return nil, err
 	}
 
+	result.DistinctTransmitters = result.CountTransmitters()
+
+	if uc.aggregatorService != nil && len(result.Transmissions) > 0 {
+		uc.tagQuorum(ctx, params.ContractAddress, result)
+	}
+
 	uc.logger.Info("Fetched transmissions",
 		"contract", params.ContractAddress.Hex(),
-		"count", len(result.Transmissions))
+		"count", len(result.Transmissions),
+		"distinctTransmitters", len(result.DistinctTransmitters))
 
 	// Optionally save to repository if configured
 	if uc.transmissionRepository != nil && len(result.Transmissions) > 0 {
@@ -74,6 +111,26 @@ func (uc *fetchTransmissionsUseCase) Execute(
 	return result, nil
 }
 
+// tagQuorum marks which transmissions under the contract's current config met
+// quorum. Failing to read the config is logged and leaves them untagged.
+func (uc *fetchTransmissionsUseCase) tagQuorum(
+	ctx context.Context,
+	contractAddress common.Address,
+	result *entities.TransmissionResult,
+) {
+	configSet, err := uc.aggregatorService.GetLatestConfigSet(ctx, contractAddress)
+	if err != nil {
+		uc.logger.Warn("Failed to read config for quorum tagging",
+			"contract", contractAddress.Hex(),
+			"error", err)
+		return
+	}
+
+	result.TagQuorum(map[[32]byte]uint8{
+		configSet.Config.ConfigDigest: configSet.Config.Threshold,
+	})
+}
+
 // validateParams validates the fetch parameters.
 func (uc *fetchTransmissionsUseCase) validateParams(params interfaces.FetchTransmissionsParams) error {
 	validationErr := &errors.ValidationError{}
@@ -82,15 +139,28 @@ func (uc *fetchTransmissionsUseCase) validateParams(params interfaces.FetchTrans
 		validationErr.AddFieldError("contract_address", "contract address is required")
 	}
 
-	if params.StartRound > params.EndRound {
+	if params.ByBlocks {
+		if params.StartRound != 0 || params.EndRound != 0 {
+			validationErr.AddFieldError("rounds", "a round range cannot be combined with a block range")
+		}
+		if params.StartBlock > params.EndBlock {
+			validationErr.AddFieldError(
+				"blocks",
+				fmt.Sprintf("invalid range: start=%d > end=%d", params.StartBlock, params.EndBlock),
+			)
+		}
+	} else if params.StartRound > params.EndRound {
 		validationErr.AddFieldError(
 			"rounds",
 			fmt.Sprintf("invalid range: start=%d > end=%d", params.StartRound, params.EndRound),
 		)
-	}
-
-	if params.EndRound-params.StartRound > 10000 {
-		validationErr.AddFieldError("rounds", "round range too large (max 10000)")
+	} else if requested := uint64(params.EndRound-params.StartRound) + 1; requested > uint64(uc.maxRoundRange) {
+		validationErr.AddFieldError(
+			"rounds",
+			fmt.Sprintf("round range too large: requested %d rounds, allowed %d; "+
+				"split the fetch into smaller ranges or raise max_round_range",
+				requested, uc.maxRoundRange),
+		)
 	}
 
 	if validationErr.HasErrors() {
@@ -29,7 +29,7 @@ func TestFetchTransmissionsUseCase_Execute(t *testing.T) {
 	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
 	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
 	
-	useCase := NewFetchTransmissionsUseCase(mockFetcher, mockRepo, mockLogger)
+	useCase := NewFetchTransmissionsUseCase(mockFetcher, nil, mockRepo, mockLogger, 0)
 	ctx := context.Background()
 	
 	t.Run("successful fetch", func(t *testing.T) {
@@ -57,7 +57,7 @@ func TestFetchTransmissionsUseCase_Execute(t *testing.T) {
 		}
 		
 		mockFetcher.EXPECT().
-			FetchByRounds(ctx, contractAddr, uint32(1), uint32(10)).
+			FetchByRounds(ctx, contractAddr, uint32(1), uint32(10), interfaces.FetchOptions{}).
 			Return(expectedResult, nil)
 		
 		mockRepo.EXPECT().
@@ -110,7 +110,7 @@ func TestFetchTransmissionsUseCase_Execute(t *testing.T) {
 		}
 		
 		mockFetcher.EXPECT().
-			FetchByRounds(ctx, contractAddr, uint32(1), uint32(10)).
+			FetchByRounds(ctx, contractAddr, uint32(1), uint32(10), interfaces.FetchOptions{}).
 			Return(nil, assert.AnError)
 		
 		result, err := useCase.Execute(ctx, params)
@@ -140,7 +140,7 @@ func TestFetchTransmissionsUseCase_Execute(t *testing.T) {
 		}
 		
 		mockFetcher.EXPECT().
-			FetchByRounds(ctx, contractAddr, uint32(1), uint32(10)).
+			FetchByRounds(ctx, contractAddr, uint32(1), uint32(10), interfaces.FetchOptions{}).
 			Return(expectedResult, nil)
 		
 		mockRepo.EXPECT().
@@ -152,4 +152,204 @@ func TestFetchTransmissionsUseCase_Execute(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, expectedResult, result)
 	})
-}
\ No newline at end of file
+	
+	t.Run("summarizes distinct transmitters", func(t *testing.T) {
+		contractAddr := helpers.RandomAddress()
+		transmitterA := common.HexToAddress("0xa000000000000000000000000000000000000000")
+		transmitterB := common.HexToAddress("0xb000000000000000000000000000000000000000")
+		transmitterC := common.HexToAddress("0xc000000000000000000000000000000000000000")
+		
+		fetched := &entities.TransmissionResult{
+			ContractAddress: contractAddr,
+			StartRound:      1,
+			EndRound:        6,
+			Transmissions: []entities.Transmission{
+				{Round: 1, TransmitterAddress: transmitterB},
+				{Round: 2, TransmitterAddress: transmitterA},
+				{Round: 3, TransmitterAddress: transmitterB},
+				{Round: 4, TransmitterAddress: transmitterC},
+				{Round: 5, TransmitterAddress: transmitterB},
+				{Round: 6, TransmitterAddress: transmitterA},
+			},
+		}
+		
+		mockFetcher.EXPECT().
+			FetchByRounds(ctx, contractAddr, uint32(1), uint32(6), interfaces.FetchOptions{}).
+			Return(fetched, nil)
+		mockRepo.EXPECT().
+			SaveBatch(ctx, gomock.Any()).
+			Return(nil)
+		
+		result, err := useCase.Execute(ctx, interfaces.FetchTransmissionsParams{
+			ContractAddress: contractAddr,
+			StartRound:      1,
+			EndRound:        6,
+		})
+		require.NoError(t, err)
+		require.Len(t, result.DistinctTransmitters, 3)
+		assert.Equal(t, []entities.TransmitterCount{
+			{Address: transmitterB, Count: 3},
+			{Address: transmitterA, Count: 2},
+			{Address: transmitterC, Count: 1},
+		}, result.DistinctTransmitters)
+	})
+	
+	t.Run("validation error - round range exceeds default limit", func(t *testing.T) {
+		params := interfaces.FetchTransmissionsParams{
+			ContractAddress: helpers.RandomAddress(),
+			StartRound:      1,
+			EndRound:        10001,
+		}
+		
+		result, err := useCase.Execute(ctx, params)
+		require.Error(t, err)
+		assert.Nil(t, result)
+		validErr, ok := err.(*errors.ValidationError)
+		require.True(t, ok)
+		assert.Contains(t, validErr.Fields["rounds"][0], "requested 10001 rounds, allowed 10000")
+	})
+}
+
+func TestFetchTransmissionsUseCase_MaxRoundRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	
+	mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	
+	useCase := NewFetchTransmissionsUseCase(mockFetcher, nil, nil, mockLogger, 50)
+	ctx := context.Background()
+	contractAddr := helpers.RandomAddress()
+	
+	t.Run("range within configured limit", func(t *testing.T) {
+		mockFetcher.EXPECT().
+			FetchByRounds(ctx, contractAddr, uint32(1), uint32(50), interfaces.FetchOptions{}).
+			Return(&entities.TransmissionResult{ContractAddress: contractAddr}, nil)
+		
+		_, err := useCase.Execute(ctx, interfaces.FetchTransmissionsParams{
+			ContractAddress: contractAddr,
+			StartRound:      1,
+			EndRound:        50,
+		})
+		require.NoError(t, err)
+	})
+	
+	t.Run("range exceeding configured limit", func(t *testing.T) {
+		_, err := useCase.Execute(ctx, interfaces.FetchTransmissionsParams{
+			ContractAddress: contractAddr,
+			StartRound:      1,
+			EndRound:        51,
+		})
+		require.Error(t, err)
+		validErr, ok := err.(*errors.ValidationError)
+		require.True(t, ok)
+		msg := validErr.Fields["rounds"][0]
+		assert.Contains(t, msg, "requested 51 rounds")
+		assert.Contains(t, msg, "allowed 50")
+		assert.Contains(t, msg, "max_round_range")
+	})
+}
+
+func TestFetchTransmissionsUseCase_TagsQuorum(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewFetchTransmissionsUseCase(mockFetcher, mockAggregator, nil, mockLogger, 0)
+	ctx := context.Background()
+	contractAddr := helpers.RandomAddress()
+	current, previous := [32]byte{2}, [32]byte{1}
+
+	params := interfaces.FetchTransmissionsParams{ContractAddress: contractAddr, StartRound: 1, EndRound: 4}
+	fetched := func() *entities.TransmissionResult {
+		return &entities.TransmissionResult{
+			ContractAddress: contractAddr,
+			Transmissions: []entities.Transmission{
+				{Round: 1, ConfigDigest: current, ObserverCount: 7}, // exactly 2F+1
+				{Round: 2, ConfigDigest: current, ObserverCount: 10},
+				{Round: 3, ConfigDigest: current, ObserverCount: 6},
+				{Round: 4, ConfigDigest: previous, ObserverCount: 10},
+			},
+		}
+	}
+
+	t.Run("tags rounds under the current config", func(t *testing.T) {
+		mockFetcher.EXPECT().FetchByRounds(ctx, contractAddr, uint32(1), uint32(4), interfaces.FetchOptions{}).
+			Return(fetched(), nil)
+		mockAggregator.EXPECT().GetLatestConfigSet(ctx, contractAddr).
+			Return(&entities.ConfigSetEvent{Config: entities.OCR2Config{ConfigDigest: current, Threshold: 3}}, nil)
+
+		result, err := useCase.Execute(ctx, params)
+		require.NoError(t, err)
+
+		tagged := make([]bool, 0, len(result.Transmissions))
+		for _, tx := range result.Transmissions {
+			tagged = append(tagged, tx.MetQuorum)
+		}
+		// Round 4 ran under an older config whose F is unknown.
+		assert.Equal(t, []bool{true, true, false, false}, tagged)
+	})
+
+	t.Run("config read failure leaves transmissions untagged", func(t *testing.T) {
+		mockFetcher.EXPECT().FetchByRounds(ctx, contractAddr, uint32(1), uint32(4), interfaces.FetchOptions{}).
+			Return(fetched(), nil)
+		mockAggregator.EXPECT().GetLatestConfigSet(ctx, contractAddr).
+			Return(nil, errors.NewDomainError(errors.ErrNotFound, "no ConfigSet event"))
+
+		result, err := useCase.Execute(ctx, params)
+		require.NoError(t, err)
+		for _, tx := range result.Transmissions {
+			assert.False(t, tx.MetQuorum)
+		}
+	})
+}
+
+func TestFetchTransmissionsUseCase_ByBlocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewFetchTransmissionsUseCase(mockFetcher, nil, nil, mockLogger, 50)
+	ctx := context.Background()
+	contractAddr := helpers.RandomAddress()
+
+	t.Run("fetches the block range", func(t *testing.T) {
+		// The block range is not limited by max_round_range.
+		mockFetcher.EXPECT().
+			FetchByBlocks(ctx, contractAddr, uint64(50000000), uint64(50010000), interfaces.FetchOptions{}).
+			Return(&entities.TransmissionResult{ContractAddress: contractAddr}, nil)
+
+		_, err := useCase.Execute(ctx, interfaces.FetchTransmissionsParams{
+			ContractAddress: contractAddr,
+			ByBlocks:        true,
+			StartBlock:      50000000,
+			EndBlock:        50010000,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects an inverted or mixed range", func(t *testing.T) {
+		_, err := useCase.Execute(ctx, interfaces.FetchTransmissionsParams{
+			ContractAddress: contractAddr,
+			StartRound:      1,
+			EndRound:        2,
+			ByBlocks:        true,
+			StartBlock:      20,
+			EndBlock:        10,
+		})
+		require.Error(t, err)
+		validErr, ok := err.(*errors.ValidationError)
+		require.True(t, ok)
+		assert.Contains(t, validErr.Fields["blocks"][0], "start=20 > end=10")
+		assert.Contains(t, validErr.Fields["rounds"][0], "cannot be combined")
+	})
+}
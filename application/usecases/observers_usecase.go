@@ -0,0 +1,100 @@
+package usecases
+
+import (
+	"context"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// observersUseCase implements the ObserversUseCase interface.
+type observersUseCase struct {
+	aggregatorService interfaces.OCR2AggregatorService
+	logger            interfaces.Logger
+}
+
+// NewObserversUseCase creates a new observers use case.
+func NewObserversUseCase(
+	aggregatorService interfaces.OCR2AggregatorService,
+	logger interfaces.Logger,
+) interfaces.ObserversUseCase {
+	return &observersUseCase{
+		aggregatorService: aggregatorService,
+		logger:            logger,
+	}
+}
+
+// Execute reads the contract's config and lists its transmitters by observer index.
+// The current config is read from its ConfigSet event, which includes the
+// signers; a config at a past block is read from the contract, which does not.
+func (uc *observersUseCase) Execute(
+	ctx context.Context,
+	params interfaces.ObserversParams,
+) (*interfaces.ObserversResult, error) {
+	// Validate parameters
+	if err := uc.validateParams(params); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Listing observers", "contract", params.ContractAddress.Hex())
+
+	result := &interfaces.ObserversResult{ContractAddress: params.ContractAddress}
+
+	var config *entities.OCR2Config
+	if params.AtBlock != nil {
+		atBlock, err := uc.aggregatorService.GetConfigFromBlock(ctx, params.ContractAddress, *params.AtBlock)
+		if err != nil {
+			uc.logger.Error("Failed to get config", "block", *params.AtBlock, "error", err)
+			return nil, err
+		}
+		config = atBlock
+		result.BlockNumber = *params.AtBlock
+	} else {
+		current, err := uc.aggregatorService.GetLatestConfigSet(ctx, params.ContractAddress)
+		if err != nil {
+			uc.logger.Error("Failed to get current config", "error", err)
+			return nil, err
+		}
+		config = &current.Config
+		result.BlockNumber = current.BlockNumber
+	}
+
+	result.ConfigDigest = config.ConfigDigest
+	result.Observers = make([]interfaces.ObserverEntry, 0, len(config.Transmitters))
+	for i, transmitter := range config.Transmitters {
+		entry := interfaces.ObserverEntry{
+			Index:       uint8(i), // #nosec G115 -- at most 31 observers
+			Transmitter: transmitter,
+		}
+		if i < len(config.Signers) {
+			signer := config.Signers[i]
+			entry.Signer = &signer
+		}
+		result.Observers = append(result.Observers, entry)
+	}
+
+	return result, nil
+}
+
+// validateParams validates the observers parameters.
+func (uc *observersUseCase) validateParams(params interfaces.ObserversParams) error {
+	validationErr := &errors.ValidationError{}
+
+	if params.ContractAddress == (common.Address{}) {
+		validationErr.AddFieldError("contract_address", "contract address is required")
+	}
+
+	// Block 0 reads the latest config in GetConfigFromBlock, so it is rejected
+	// rather than silently showing the current config.
+	if params.AtBlock != nil && *params.AtBlock == 0 {
+		validationErr.AddFieldError("at_block", "block must be greater than 0")
+	}
+
+	if validationErr.HasErrors() {
+		return validationErr
+	}
+
+	return nil
+}
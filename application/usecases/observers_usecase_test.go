@@ -0,0 +1,65 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/helpers"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserversUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewObserversUseCase(mockAggregator, mockLogger)
+	ctx := context.Background()
+	contract := helpers.RandomAddress()
+	first, second := helpers.RandomAddress(), helpers.RandomAddress()
+	signer := helpers.RandomAddress()
+
+	// A signer list shorter than the transmitters leaves the rest without one.
+	mockAggregator.EXPECT().GetLatestConfigSet(ctx, contract).Return(&entities.ConfigSetEvent{
+		BlockNumber: 77,
+		Config: entities.OCR2Config{
+			ConfigDigest: [32]byte{0x01},
+			Transmitters: []common.Address{first, second},
+			Signers:      []common.Address{signer},
+		},
+	}, nil)
+
+	result, err := useCase.Execute(ctx, interfaces.ObserversParams{ContractAddress: contract})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(77), result.BlockNumber)
+	assert.Equal(t, [32]byte{0x01}, result.ConfigDigest)
+	require.Len(t, result.Observers, 2)
+	assert.Equal(t, interfaces.ObserverEntry{Index: 0, Transmitter: first, Signer: &signer}, result.Observers[0])
+	assert.Equal(t, interfaces.ObserverEntry{Index: 1, Transmitter: second}, result.Observers[1])
+}
+
+func TestObserversUseCase_InvalidParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	useCase := NewObserversUseCase(mocks.NewMockOCR2AggregatorService(ctrl), mocks.NewMockLogger(ctrl))
+	block := uint64(0)
+
+	_, err := useCase.Execute(context.Background(), interfaces.ObserversParams{AtBlock: &block})
+
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, validationErr.Fields, "contract_address")
+	assert.Contains(t, validationErr.Fields, "at_block")
+}
@@ -0,0 +1,172 @@
+package usecases
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+)
+
+// htmlReport is the data rendered by htmlReportTemplate.
+type htmlReport struct {
+	GroupBy            interfaces.GroupByUnit
+	Columns            []string
+	Rows               []htmlReportRow
+	TotalTransmissions int
+	Anomalies          []htmlReportAnomaly
+}
+
+// htmlReportRow is one observer in the activity table.
+type htmlReportRow struct {
+	ObserverIndex  uint8
+	Address        string
+	OtherAddresses []string
+	Total          int
+	Counts         []int
+}
+
+// htmlReportAnomaly is one row of the anomaly table.
+type htmlReportAnomaly struct {
+	Severity    interfaces.AnomalySeverity
+	Type        interfaces.AnomalyType
+	Description string
+	Time        string
+}
+
+// htmlReportTemplate renders a self-contained report page. html/template
+// escapes every value, so addresses and descriptions cannot inject markup.
+var htmlReportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8"/>
+<title>Observer Activity Report</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+th { background: #f0f0f0; }
+td.num { text-align: right; }
+.address { font-family: monospace; }
+.severity-high { background: #f8d7da; }
+.severity-medium { background: #fff3cd; }
+.severity-low { background: #e2e3e5; }
+</style>
+</head>
+<body>
+<h1>Observer Activity Report</h1>
+<p>Group by: {{.GroupBy}}. {{len .Rows}} observers, {{.TotalTransmissions}} transmissions.</p>
+<table id="activity">
+<thead>
+<tr><th>Index</th><th>Address</th><th>Total</th>{{range .Columns}}<th>{{.}}</th>{{end}}</tr>
+</thead>
+<tbody>
+{{- range .Rows}}
+<tr><td class="num">{{.ObserverIndex}}</td><td class="address">{{.Address}}{{range .OtherAddresses}}<br/>{{.}}{{end}}</td><td class="num">{{.Total}}</td>{{range .Counts}}<td class="num">{{.}}</td>{{end}}</tr>
+{{- end}}
+</tbody>
+</table>
+<h2>Anomalies</h2>
+{{- if .Anomalies}}
+<table id="anomalies">
+<thead>
+<tr><th>Severity</th><th>Type</th><th>Description</th><th>Time</th></tr>
+</thead>
+<tbody>
+{{- range .Anomalies}}
+<tr class="severity-{{.Severity}}"><td>{{.Severity}}</td><td>{{.Type}}</td><td>{{.Description}}</td><td>{{.Time}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+{{- else}}
+<p>No anomalies detected.</p>
+{{- end}}
+</body>
+</html>
+`))
+
+// outputHTML outputs observer activities and anomalies as an HTML page.
+func (uc *parseTransmissionsUseCase) outputHTML(
+	w io.Writer,
+	activities []entities.ObserverActivity,
+	anomalies []interfaces.TransmissionAnomaly,
+	groupBy interfaces.GroupByUnit,
+	showAllAddresses bool,
+) error {
+	report := htmlReport{GroupBy: groupBy}
+
+	// The grouped columns, and how to read an observer's count for each.
+	var count func(activity entities.ObserverActivity, column int) int
+	switch groupBy {
+	case interfaces.GroupByDay:
+		report.Columns = activityKeys(activities, func(a entities.ObserverActivity) map[string]int { return a.DailyCount })
+		count = func(a entities.ObserverActivity, i int) int { return a.DailyCount[report.Columns[i]] }
+	case interfaces.GroupByMonth:
+		report.Columns = activityKeys(activities, func(a entities.ObserverActivity) map[string]int { return a.MonthlyCount })
+		count = func(a entities.ObserverActivity, i int) int { return a.MonthlyCount[report.Columns[i]] }
+	case interfaces.GroupByEpoch:
+		epochs := activityEpochs(activities)
+		for _, epoch := range epochs {
+			report.Columns = append(report.Columns, fmt.Sprintf("epoch %d", epoch))
+		}
+		count = func(a entities.ObserverActivity, i int) int { return a.EpochCount[epochs[i]] }
+	}
+
+	for _, activity := range activities {
+		row := htmlReportRow{
+			ObserverIndex: activity.ObserverIndex,
+			Address:       activity.Address.Hex(),
+			Total:         activity.TotalCount,
+		}
+		if showAllAddresses {
+			for _, address := range activity.Addresses {
+				if address != activity.Address {
+					row.OtherAddresses = append(row.OtherAddresses, address.Hex())
+				}
+			}
+		}
+		for i := range report.Columns {
+			row.Counts = append(row.Counts, count(activity, i))
+		}
+		report.Rows = append(report.Rows, row)
+		report.TotalTransmissions += activity.TotalCount
+	}
+	sort.Slice(report.Rows, func(i, j int) bool {
+		return report.Rows[i].ObserverIndex < report.Rows[j].ObserverIndex
+	})
+
+	for _, anomaly := range anomalies {
+		report.Anomalies = append(report.Anomalies, htmlReportAnomaly{
+			Severity:    anomaly.Severity,
+			Type:        anomaly.Type,
+			Description: anomaly.Description,
+			Time:        time.Unix(anomaly.Timestamp, 0).UTC().Format(time.RFC3339),
+		})
+	}
+
+	return htmlReportTemplate.Execute(w, report)
+}
+
+// activityKeys returns the keys of a per-observer count map across activities, sorted.
+func activityKeys(
+	activities []entities.ObserverActivity,
+	counts func(entities.ObserverActivity) map[string]int,
+) []string {
+	seen := make(map[string]bool)
+	for _, activity := range activities {
+		for key := range counts(activity) {
+			seen[key] = true
+		}
+	}
+
+	keys := make([]string, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -8,15 +8,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"os"
-	"path/filepath"
 	"sort"
 	"strings"
 
+	"chainlink-ocr-checker/application/services"
 	"chainlink-ocr-checker/domain/entities"
 	"chainlink-ocr-checker/domain/errors"
 	"chainlink-ocr-checker/domain/interfaces"
-	"gopkg.in/yaml.v2"
 )
 
 // parseTransmissionsUseCase implements the ParseTransmissionsUseCase interface.
@@ -37,7 +35,7 @@ func NewParseTransmissionsUseCase(
 }
 
 // Execute parses transmission data and generates reports.
-func (uc *parseTransmissionsUseCase) Execute(_ context.Context, params interfaces.ParseTransmissionsParams) error {
+func (uc *parseTransmissionsUseCase) Execute(ctx context.Context, params interfaces.ParseTransmissionsParams) error {
 	// Validate parameters
 	if err := uc.validateParams(params); err != nil {
 		return err
@@ -48,10 +46,9 @@ func (uc *parseTransmissionsUseCase) Execute(_ context.Context, params interface
 		"groupBy", params.GroupBy,
 		"format", params.OutputFormat)
 	
-	// Read input file
-	transmissions, err := uc.readTransmissions(params.InputPath)
+	// Read input files
+	transmissions, err := uc.readTransmissions(params)
 	if err != nil {
-		uc.logger.Error("Failed to read transmissions", "error", err)
 		return err
 	}
 	
@@ -63,7 +60,7 @@ func (uc *parseTransmissionsUseCase) Execute(_ context.Context, params interface
 	uc.logger.Info("Loaded transmissions", "count", len(transmissions))
 	
 	// Analyze transmissions
-	observerActivities, err := uc.analyzer.AnalyzeObserverActivity(transmissions)
+	observerActivities, err := uc.analyzer.AnalyzeObserverActivity(ctx, transmissions)
 	if err != nil {
 		uc.logger.Error("Failed to analyze observer activity", "error", err)
 		return err
@@ -75,18 +72,65 @@ func (uc *parseTransmissionsUseCase) Execute(_ context.Context, params interface
 		return uc.outputJSON(params.OutputWriter, observerActivities, params.GroupBy)
 	case interfaces.OutputFormatCSV:
 		return uc.outputCSV(params.OutputWriter, observerActivities, params.GroupBy)
+	case interfaces.OutputFormatHTML:
+		anomalies, err := uc.analyzer.DetectAnomalies(ctx, transmissions)
+		if err != nil {
+			uc.logger.Error("Failed to detect anomalies", "error", err)
+			return err
+		}
+		return uc.outputHTML(params.OutputWriter, observerActivities, anomalies, params.GroupBy, params.ShowAllAddresses)
 	case interfaces.OutputFormatText:
-		return uc.outputText(params.OutputWriter, observerActivities, params.GroupBy)
+		return uc.outputText(params.OutputWriter, observerActivities, params.GroupBy, params.ShowAllAddresses)
 	default:
-		return uc.outputText(params.OutputWriter, observerActivities, params.GroupBy)
+		return uc.outputText(params.OutputWriter, observerActivities, params.GroupBy, params.ShowAllAddresses)
 	}
 }
 
+// readTransmissions reads the transmissions of the input files, seeking to the
+// round range when one is given. Several files are combined in block order
+// without the transmissions they share.
+func (uc *parseTransmissionsUseCase) readTransmissions(
+	params interfaces.ParseTransmissionsParams,
+) ([]entities.Transmission, error) {
+	paths := params.InputPaths
+	if len(paths) == 0 {
+		paths = []string{params.InputPath}
+	}
+	
+	results := make([]*entities.TransmissionResult, 0, len(paths))
+	for _, path := range paths {
+		var result *entities.TransmissionResult
+		var err error
+		if params.EndRound > 0 {
+			result, err = services.ReadTransmissionRange(path, params.StartRound, params.EndRound)
+		} else {
+			result, _, err = services.ReadTransmissionResult(path)
+		}
+		if err != nil {
+			uc.logger.Error("Failed to read transmissions", "input", path, "error", err)
+			return nil, err
+		}
+		if params.ConfigDigest != ([32]byte{}) {
+			result.FilterConfigDigest(params.ConfigDigest)
+		}
+		results = append(results, result)
+	}
+	
+	if len(results) == 1 {
+		return results[0].Transmissions, nil
+	}
+	
+	transmissions := services.MergeTransmissions(results)
+	uc.logger.Info("Combined input files", "files", len(results), "transmissions", len(transmissions))
+	
+	return transmissions, nil
+}
+
 // validateParams validates the parse parameters.
 func (uc *parseTransmissionsUseCase) validateParams(params interfaces.ParseTransmissionsParams) error {
 	validationErr := &errors.ValidationError{}
 	
-	if params.InputPath == "" {
+	if params.InputPath == "" && len(params.InputPaths) == 0 {
 		validationErr.AddFieldError("input_path", "input path is required")
 	}
 	
@@ -94,10 +138,18 @@ func (uc *parseTransmissionsUseCase) validateParams(params interfaces.ParseTrans
 		validationErr.AddFieldError("output_writer", "output writer is required")
 	}
 	
+	if params.EndRound > 0 && params.StartRound > params.EndRound {
+		validationErr.AddFieldError(
+			"rounds",
+			fmt.Sprintf("invalid range: start round %d is after end round %d", params.StartRound, params.EndRound),
+		)
+	}
+	
 	validGroupBy := map[interfaces.GroupByUnit]bool{
 		interfaces.GroupByDay:   true,
 		interfaces.GroupByMonth: true,
 		interfaces.GroupByRound: true,
+		interfaces.GroupByEpoch: true,
 	}
 	
 	if !validGroupBy[params.GroupBy] {
@@ -112,6 +164,7 @@ func (uc *parseTransmissionsUseCase) validateParams(params interfaces.ParseTrans
 		interfaces.OutputFormatCSV:  true,
 		interfaces.OutputFormatText: true,
 		interfaces.OutputFormatYAML: true,
+		interfaces.OutputFormatHTML: true,
 	}
 	
 	if !validFormats[params.OutputFormat] {
@@ -128,29 +181,6 @@ func (uc *parseTransmissionsUseCase) validateParams(params interfaces.ParseTrans
 	return nil
 }
 
-// readTransmissions reads transmissions from a YAML file.
-func (uc *parseTransmissionsUseCase) readTransmissions(path string) ([]entities.Transmission, error) {
-	// Clean and validate the path
-	cleanPath := filepath.Clean(path)
-	file, err := os.Open(cleanPath) // #nosec G304 -- path is cleaned
-	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %w", err)
-	}
-	defer func() {
-		if cerr := file.Close(); cerr != nil {
-			uc.logger.Error("Failed to close file", "error", cerr)
-		}
-	}()
-	
-	var result entities.TransmissionResult
-	decoder := yaml.NewDecoder(file)
-	if err := decoder.Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode YAML: %w", err)
-	}
-	
-	return result.Transmissions, nil
-}
-
 // outputJSON outputs observer activities as JSON.
 func (uc *parseTransmissionsUseCase) outputJSON(
 	w io.Writer,
@@ -160,9 +190,12 @@ func (uc *parseTransmissionsUseCase) outputJSON(
 	encoder := json.NewEncoder(w)
 	encoder.SetIndent("", "  ")
 	
+	participationCV := services.ParticipationCV(activities)
 	output := map[string]interface{}{
-		"groupBy":    groupBy,
-		"activities": activities,
+		"groupBy":             groupBy,
+		"activities":          activities,
+		"participationCV":     participationCV,
+		"unevenParticipation": services.IsParticipationUneven(participationCV),
 	}
 	
 	return encoder.Encode(output)
@@ -200,6 +233,14 @@ func (uc *parseTransmissionsUseCase) outputCSV(
 		header = append(header, sortedDays...)
 	}
 	
+	var epochs []uint32
+	if groupBy == interfaces.GroupByEpoch {
+		epochs = activityEpochs(activities)
+		for _, epoch := range epochs {
+			header = append(header, fmt.Sprintf("epoch %d", epoch))
+		}
+	}
+	
 	if err := writer.Write(header); err != nil {
 		return err
 	}
@@ -221,6 +262,13 @@ func (uc *parseTransmissionsUseCase) outputCSV(
 			}
 		}
 		
+		if groupBy == interfaces.GroupByEpoch {
+			// Add epoch counts.
+			for _, epoch := range epochs {
+				row = append(row, fmt.Sprintf("%d", activity.EpochCount[epoch]))
+			}
+		}
+		
 		if err := writer.Write(row); err != nil {
 			return err
 		}
@@ -234,6 +282,7 @@ func (uc *parseTransmissionsUseCase) outputText(
 	w io.Writer,
 	activities []entities.ObserverActivity,
 	groupBy interfaces.GroupByUnit,
+	showAllAddresses bool,
 ) error {
 	// Sort activities by observer index.
 	sort.Slice(activities, func(i, j int) bool {
@@ -253,6 +302,9 @@ func (uc *parseTransmissionsUseCase) outputText(
 	case interfaces.GroupByMonth:
 		_, _ = fmt.Fprintf(w, "%-5s %-44s %-10s %s\n", "Index", "Address", "Total", "Monthly Activity")
 		_, _ = fmt.Fprintf(w, "%s\n", strings.Repeat("-", 100))
+	case interfaces.GroupByEpoch:
+		_, _ = fmt.Fprintf(w, "%-5s %-44s %-10s %s\n", "Index", "Address", "Total", "Epoch Activity")
+		_, _ = fmt.Fprintf(w, "%s\n", strings.Repeat("-", 100))
 	default:
 		_, _ = fmt.Fprintf(w, "%-5s %-44s %-10s\n", "Index", "Address", "Total")
 		_, _ = fmt.Fprintf(w, "%s\n", strings.Repeat("-", 60))
@@ -296,9 +348,27 @@ func (uc *parseTransmissionsUseCase) outputText(
 				}
 			}
 			_, _ = fmt.Fprintf(w, " %s", strings.Join(monthlyStr, ", "))
+		case interfaces.GroupByEpoch:
+			// Print epoch counts in epoch order.
+			epochStr := make([]string, 0, len(activity.EpochCount))
+			for _, epoch := range activityEpochs([]entities.ObserverActivity{activity}) {
+				if count := activity.EpochCount[epoch]; count > 0 {
+					epochStr = append(epochStr, fmt.Sprintf("%d:%d", epoch, count))
+				}
+			}
+			_, _ = fmt.Fprintf(w, " %s", strings.Join(epochStr, ", "))
 		}
 		
 		_, _ = fmt.Fprintln(w)
+		
+		// List the addresses the index rotated to below the first one.
+		if showAllAddresses {
+			for _, address := range activity.Addresses {
+				if address != activity.Address {
+					_, _ = fmt.Fprintf(w, "%-5s %s\n", "", address.Hex())
+				}
+			}
+		}
 	}
 	
 	// Print summary.
@@ -313,5 +383,31 @@ func (uc *parseTransmissionsUseCase) outputText(
 	}
 	_, _ = fmt.Fprintf(w, "Total Transmissions: %d\n", totalTransmissions)
 	
+	// Flag feeds where a few observers carry most of the transmissions.
+	participationCV := services.ParticipationCV(activities)
+	_, _ = fmt.Fprintf(w, "Participation CV: %.2f", participationCV)
+	if services.IsParticipationUneven(participationCV) {
+		_, _ = fmt.Fprintf(w, " (uneven participation)")
+	}
+	_, _ = fmt.Fprintln(w)
+	
 	return nil
-}
\ No newline at end of file
+}
+
+// activityEpochs returns the epochs seen across activities in ascending order.
+func activityEpochs(activities []entities.ObserverActivity) []uint32 {
+	seen := make(map[uint32]bool)
+	for _, activity := range activities {
+		for epoch := range activity.EpochCount {
+			seen[epoch] = true
+		}
+	}
+	
+	epochs := make([]uint32, 0, len(seen))
+	for epoch := range seen {
+		epochs = append(epochs, epoch)
+	}
+	sort.Slice(epochs, func(i, j int) bool { return epochs[i] < epochs[j] })
+	
+	return epochs
+}
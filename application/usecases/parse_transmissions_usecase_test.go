@@ -0,0 +1,301 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTransmissionsUseCase_GroupByEpoch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewParseTransmissionsUseCase(services.NewTransmissionAnalyzer(mockLogger, services.AnomalyConfig{}), mockLogger)
+
+	// Observer 0 reports in both epochs, observer 1 only in the second.
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	timestamp := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tx := func(epoch uint32, round uint8, observer uint8) entities.Transmission {
+		return entities.Transmission{
+			ContractAddress:    contract,
+			Epoch:              epoch,
+			Round:              round,
+			ObserverIndex:      observer,
+			TransmitterAddress: common.BigToAddress(common.Big1),
+			BlockTimestamp:     timestamp,
+		}
+	}
+	path := filepath.Join(t.TempDir(), "result.json")
+	require.NoError(t, services.WriteTransmissionResult(path, &entities.TransmissionResult{
+		ContractAddress: contract,
+		Transmissions: []entities.Transmission{
+			tx(1, 1, 0), tx(1, 2, 0), tx(1, 3, 0),
+			tx(2, 1, 0), tx(2, 2, 1), tx(2, 3, 1),
+		},
+	}, interfaces.OutputFormatJSON))
+
+	execute := func(format interfaces.OutputFormat) string {
+		var out bytes.Buffer
+		err := useCase.Execute(context.Background(), interfaces.ParseTransmissionsParams{
+			InputPath:    path,
+			OutputWriter: &out,
+			GroupBy:      interfaces.GroupByEpoch,
+			OutputFormat: format,
+		})
+		require.NoError(t, err)
+		return out.String()
+	}
+
+	t.Run("text", func(t *testing.T) {
+		out := execute(interfaces.OutputFormatText)
+		assert.Contains(t, out, "Epoch Activity")
+		assert.Regexp(t, `(?m)^0 .* 4 +1:3, 2:1$`, out)
+		assert.Regexp(t, `(?m)^1 .* 2 +2:2$`, out)
+		assert.Regexp(t, `(?m)^Participation CV: 0.33$`, out)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		records, err := csv.NewReader(bytes.NewBufferString(execute(interfaces.OutputFormatCSV))).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		assert.Equal(t, []string{"Observer Index", "Address", "Total Count", "epoch 1", "epoch 2"}, records[0])
+		address := common.BigToAddress(common.Big1).Hex()
+		assert.Equal(t, []string{"0", address, "4", "3", "1"}, records[1])
+		assert.Equal(t, []string{"1", address, "2", "0", "2"}, records[2])
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var output struct {
+			GroupBy    interfaces.GroupByUnit      `json:"groupBy"`
+			Activities []entities.ObserverActivity `json:"activities"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(execute(interfaces.OutputFormatJSON)), &output))
+		assert.Equal(t, interfaces.GroupByEpoch, output.GroupBy)
+		require.Len(t, output.Activities, 2)
+		assert.Equal(t, map[uint32]int{1: 3, 2: 1}, output.Activities[0].EpochCount)
+		assert.Equal(t, map[uint32]int{2: 2}, output.Activities[1].EpochCount)
+	})
+}
+
+func TestParseTransmissionsUseCase_ShowAllAddresses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewParseTransmissionsUseCase(services.NewTransmissionAnalyzer(mockLogger, services.AnomalyConfig{}), mockLogger)
+
+	// A config rotation moved observer index 0 from the old to the new address.
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	oldAddress := common.HexToAddress("0xa000000000000000000000000000000000000000")
+	newAddress := common.HexToAddress("0xb000000000000000000000000000000000000000")
+	timestamp := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "result.json")
+	require.NoError(t, services.WriteTransmissionResult(path, &entities.TransmissionResult{
+		ContractAddress: contract,
+		Transmissions: []entities.Transmission{
+			{ContractAddress: contract, Epoch: 1, Round: 1, TransmitterAddress: oldAddress, BlockTimestamp: timestamp},
+			{ContractAddress: contract, Epoch: 2, Round: 1, TransmitterAddress: newAddress, BlockTimestamp: timestamp},
+			{ContractAddress: contract, Epoch: 2, Round: 2, TransmitterAddress: newAddress, BlockTimestamp: timestamp},
+		},
+	}, interfaces.OutputFormatJSON))
+
+	execute := func(showAll bool) string {
+		var out bytes.Buffer
+		err := useCase.Execute(context.Background(), interfaces.ParseTransmissionsParams{
+			InputPath:        path,
+			OutputWriter:     &out,
+			GroupBy:          interfaces.GroupByRound,
+			OutputFormat:     interfaces.OutputFormatText,
+			ShowAllAddresses: showAll,
+		})
+		require.NoError(t, err)
+		return out.String()
+	}
+
+	t.Run("first address only by default", func(t *testing.T) {
+		out := execute(false)
+		assert.Regexp(t, `(?m)^0 +`+oldAddress.Hex()+` +3 *$`, out)
+		assert.NotContains(t, out, newAddress.Hex())
+	})
+
+	t.Run("all addresses", func(t *testing.T) {
+		out := execute(true)
+		assert.Regexp(t, `(?m)^0 +`+oldAddress.Hex()+` +3 *\n +`+newAddress.Hex()+`$`, out)
+	})
+}
+
+func TestParseTransmissionsUseCase_ConfigDigest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewParseTransmissionsUseCase(services.NewTransmissionAnalyzer(mockLogger, services.AnomalyConfig{}), mockLogger)
+
+	// The range spans two configs with different transmitters.
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	oldAddress := common.HexToAddress("0xa000000000000000000000000000000000000000")
+	newAddress := common.HexToAddress("0xb000000000000000000000000000000000000000")
+	oldDigest, newDigest := [32]byte{1}, [32]byte{2}
+	timestamp := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "result.json")
+	require.NoError(t, services.WriteTransmissionResult(path, &entities.TransmissionResult{
+		ContractAddress: contract,
+		Transmissions: []entities.Transmission{
+			{ConfigDigest: oldDigest, Epoch: 1, Round: 1, TransmitterAddress: oldAddress, ObserverIndex: 0, BlockTimestamp: timestamp},
+			{ConfigDigest: oldDigest, Epoch: 1, Round: 2, TransmitterAddress: oldAddress, ObserverIndex: 0, BlockTimestamp: timestamp},
+			{ConfigDigest: newDigest, Epoch: 2, Round: 1, TransmitterAddress: newAddress, ObserverIndex: 1, BlockTimestamp: timestamp},
+		},
+	}, interfaces.OutputFormatJSON))
+
+	var out bytes.Buffer
+	err := useCase.Execute(context.Background(), interfaces.ParseTransmissionsParams{
+		InputPath:    path,
+		OutputWriter: &out,
+		GroupBy:      interfaces.GroupByRound,
+		OutputFormat: interfaces.OutputFormatText,
+		ConfigDigest: newDigest,
+	})
+	require.NoError(t, err)
+
+	assert.Regexp(t, `(?m)^1 +`+newAddress.Hex()+` +1 *$`, out.String())
+	assert.NotContains(t, out.String(), oldAddress.Hex())
+}
+
+func TestParseTransmissionsUseCase_HTML(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewParseTransmissionsUseCase(services.NewTransmissionAnalyzer(mockLogger, services.AnomalyConfig{}), mockLogger)
+
+	first := common.HexToAddress("0xa000000000000000000000000000000000000000")
+	second := common.HexToAddress("0xb000000000000000000000000000000000000000")
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "result.json")
+	require.NoError(t, services.WriteTransmissionResult(path, &entities.TransmissionResult{
+		Transmissions: []entities.Transmission{
+			{Epoch: 1, Round: 1, TransmitterAddress: first, ObserverIndex: 0, BlockTimestamp: day},
+			{Epoch: 1, Round: 1, TransmitterAddress: second, ObserverIndex: 1, BlockTimestamp: day},
+			{Epoch: 1, Round: 2, TransmitterAddress: first, ObserverIndex: 0, BlockTimestamp: day.Add(24 * time.Hour)},
+		},
+	}, interfaces.OutputFormatJSON))
+
+	var out bytes.Buffer
+	err := useCase.Execute(context.Background(), interfaces.ParseTransmissionsParams{
+		InputPath:    path,
+		OutputWriter: &out,
+		GroupBy:      interfaces.GroupByDay,
+		OutputFormat: interfaces.OutputFormatHTML,
+	})
+	require.NoError(t, err)
+	page := out.String()
+
+	assert.Contains(t, page, `<tr><th>Index</th><th>Address</th><th>Total</th><th>2024-01-01</th><th>2024-01-02</th></tr>`)
+	assert.Contains(t, page, `<tr><td class="num">0</td><td class="address">`+first.Hex()+
+		`</td><td class="num">2</td><td class="num">1</td><td class="num">1</td></tr>`)
+	assert.Contains(t, page, `<tr><td class="num">1</td><td class="address">`+second.Hex()+
+		`</td><td class="num">1</td><td class="num">1</td><td class="num">0</td></tr>`)
+
+	// Round 1 was transmitted twice.
+	assert.Regexp(t, `<tr class="severity-high"><td>high</td><td>duplicate_round</td>`, page)
+
+	// Every element is closed and nested correctly.
+	decoder := xml.NewDecoder(strings.NewReader(page))
+	decoder.Strict = true
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		switch token.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	assert.Zero(t, depth)
+}
+
+func TestParseTransmissionsUseCase_MultipleFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewParseTransmissionsUseCase(services.NewTransmissionAnalyzer(mockLogger, services.AnomalyConfig{}), mockLogger)
+
+	// The January file ends with rounds 3 and 4, which the February file starts with.
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	tx := func(aggregatorRoundID uint32, observer uint8, month time.Month) entities.Transmission {
+		return entities.Transmission{
+			ContractAddress:    contract,
+			Epoch:              1,
+			Round:              uint8(aggregatorRoundID),
+			AggregatorRoundID:  aggregatorRoundID,
+			ObserverIndex:      observer,
+			TransmitterAddress: common.BigToAddress(common.Big1),
+			BlockNumber:        uint64(aggregatorRoundID),
+			BlockTimestamp:     time.Date(2024, month, int(aggregatorRoundID), 0, 0, 0, 0, time.UTC),
+		}
+	}
+	dir := t.TempDir()
+	january := filepath.Join(dir, "2024-01.json")
+	february := filepath.Join(dir, "2024-02.jsonl")
+	require.NoError(t, services.WriteTransmissionResult(january, &entities.TransmissionResult{
+		ContractAddress: contract,
+		Transmissions:   []entities.Transmission{tx(1, 0, 1), tx(2, 1, 1), tx(3, 0, 1), tx(4, 1, 1)},
+	}, interfaces.OutputFormatJSON))
+	require.NoError(t, services.WriteTransmissionResult(february, &entities.TransmissionResult{
+		ContractAddress: contract,
+		Transmissions:   []entities.Transmission{tx(3, 0, 1), tx(4, 1, 1), tx(5, 0, 2), tx(6, 0, 2)},
+	}, interfaces.OutputFormatJSONL))
+
+	var out bytes.Buffer
+	err := useCase.Execute(context.Background(), interfaces.ParseTransmissionsParams{
+		InputPaths:   []string{february, january},
+		OutputWriter: &out,
+		GroupBy:      interfaces.GroupByMonth,
+		OutputFormat: interfaces.OutputFormatJSON,
+	})
+	require.NoError(t, err)
+
+	var output struct {
+		Activities []entities.ObserverActivity `json:"activities"`
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &output))
+	require.Len(t, output.Activities, 2)
+	assert.Equal(t, 4, output.Activities[0].TotalCount)
+	assert.Equal(t, map[string]int{"2024-01": 2, "2024-02": 2}, output.Activities[0].MonthlyCount)
+	assert.Equal(t, 2, output.Activities[1].TotalCount)
+	assert.Equal(t, map[string]int{"2024-01": 2}, output.Activities[1].MonthlyCount)
+}
@@ -0,0 +1,184 @@
+// Package usecases contains application use cases that orchestrate business logic.
+// It implements the primary operations for fetching, parsing, and watching OCR transmissions.
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// reindexTransmissionsUseCase implements the ReindexTransmissionsUseCase interface.
+type reindexTransmissionsUseCase struct {
+	aggregatorService interfaces.OCR2AggregatorService
+	logger            interfaces.Logger
+}
+
+// NewReindexTransmissionsUseCase creates a new reindex transmissions use case.
+func NewReindexTransmissionsUseCase(
+	aggregatorService interfaces.OCR2AggregatorService,
+	logger interfaces.Logger,
+) interfaces.ReindexTransmissionsUseCase {
+	return &reindexTransmissionsUseCase{
+		aggregatorService: aggregatorService,
+		logger:            logger,
+	}
+}
+
+// Execute re-derives observer indices and rewrites the transmission file.
+func (uc *reindexTransmissionsUseCase) Execute(
+	ctx context.Context,
+	params interfaces.ReindexTransmissionsParams,
+) (*interfaces.ReindexTransmissionsResult, error) {
+	// Validate parameters
+	if err := uc.validateParams(params); err != nil {
+		return nil, err
+	}
+
+	data, format, err := services.ReadTransmissionResult(params.InputPath)
+	if err != nil {
+		uc.logger.Error("Failed to read transmissions", "error", err)
+		return nil, err
+	}
+
+	// Refuse to rewrite an archive that was fetched for a different contract.
+	if data.ContractAddress != (common.Address{}) && data.ContractAddress != params.ContractAddress {
+		validationErr := &errors.ValidationError{}
+		validationErr.AddFieldError(
+			"contract_address",
+			fmt.Sprintf("contract %s does not match file contract %s",
+				params.ContractAddress.Hex(), data.ContractAddress.Hex()),
+		)
+		return nil, validationErr
+	}
+
+	outputPath := params.OutputPath
+	if outputPath == "" {
+		outputPath = params.InputPath
+	} else {
+		format = services.ResultFileFormat(outputPath)
+	}
+
+	uc.logger.Info("Reindexing transmissions",
+		"input", params.InputPath,
+		"output", outputPath,
+		"contract", params.ContractAddress.Hex())
+
+	result := &interfaces.ReindexTransmissionsResult{
+		TotalTransmissions: len(data.Transmissions),
+	}
+
+	// Configs are cached by digest so each config is fetched from chain once.
+	configs := make(map[[32]byte]*entities.OCR2Config)
+
+	for i := range data.Transmissions {
+		tx := &data.Transmissions[i]
+
+		config, err := uc.configFor(ctx, params.ContractAddress, *tx, configs)
+		if err != nil {
+			uc.logger.Warn("Failed to get config for transmission",
+				"block", tx.BlockNumber,
+				"error", err)
+			tx.ObserverIndex = entities.UnknownObserverIndex
+			result.UnresolvedCount++
+			continue
+		}
+
+		index, ok := config.TransmitterIndex(tx.TransmitterAddress)
+		if !ok {
+			uc.logger.Warn("Transmitter not found in config",
+				"transmitter", tx.TransmitterAddress.Hex(),
+				"block", tx.BlockNumber)
+			tx.ObserverIndex = entities.UnknownObserverIndex
+			result.UnresolvedCount++
+			continue
+		}
+
+		if tx.ObserverIndex != index {
+			tx.ObserverIndex = index
+			result.UpdatedCount++
+		}
+	}
+
+	// Rebuild the round index when the input had one, since rewritten lines move offsets.
+	if format == interfaces.OutputFormatJSONL && services.HasTransmissionIndex(params.InputPath) {
+		err = services.WriteIndexedTransmissionResult(outputPath, data)
+	} else {
+		err = services.WriteTransmissionResult(outputPath, data, format)
+	}
+	if err != nil {
+		uc.logger.Error("Failed to write transmissions", "error", err)
+		return nil, err
+	}
+
+	uc.logger.Info("Reindex completed",
+		"total", result.TotalTransmissions,
+		"updated", result.UpdatedCount,
+		"unresolved", result.UnresolvedCount)
+
+	return result, nil
+}
+
+// validateParams validates the reindex parameters.
+func (uc *reindexTransmissionsUseCase) validateParams(params interfaces.ReindexTransmissionsParams) error {
+	validationErr := &errors.ValidationError{}
+
+	if params.InputPath == "" {
+		validationErr.AddFieldError("input_path", "input path is required")
+	}
+
+	if params.ContractAddress == (common.Address{}) {
+		validationErr.AddFieldError("contract_address", "contract address is required")
+	}
+
+	if validationErr.HasErrors() {
+		return validationErr
+	}
+
+	return nil
+}
+
+// configFor returns the OCR2 configuration that was active for a transmission.
+func (uc *reindexTransmissionsUseCase) configFor(
+	ctx context.Context,
+	contractAddress common.Address,
+	tx entities.Transmission,
+	cache map[[32]byte]*entities.OCR2Config,
+) (*entities.OCR2Config, error) {
+	// Without a recorded digest the config can't be verified or shared, so query the block.
+	if tx.ConfigDigest == ([32]byte{}) {
+		return uc.aggregatorService.GetConfigFromBlock(ctx, contractAddress, tx.BlockNumber)
+	}
+
+	if config, ok := cache[tx.ConfigDigest]; ok {
+		return config, nil
+	}
+
+	config, err := uc.aggregatorService.GetConfigFromBlock(ctx, contractAddress, tx.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// The config is read as of the end of the block, so a setConfig that landed
+	// after the transmission in the same block hides the config it used.
+	if config.ConfigDigest != tx.ConfigDigest && tx.BlockNumber > 0 {
+		config, err = uc.aggregatorService.GetConfigFromBlock(ctx, contractAddress, tx.BlockNumber-1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if config.ConfigDigest != tx.ConfigDigest {
+		return nil, fmt.Errorf("config digest mismatch at block %d: expected %x, got %x",
+			tx.BlockNumber, tx.ConfigDigest, config.ConfigDigest)
+	}
+
+	cache[tx.ConfigDigest] = config
+
+	return config, nil
+}
@@ -0,0 +1,254 @@
+package usecases
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReindexTransmissionsUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	// Set up logger expectations
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewReindexTransmissionsUseCase(mockAggregator, mockLogger)
+	ctx := context.Background()
+
+	contractAddr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	transmitterA := common.HexToAddress("0xa000000000000000000000000000000000000000")
+	transmitterB := common.HexToAddress("0xb000000000000000000000000000000000000000")
+	transmitterC := common.HexToAddress("0xc000000000000000000000000000000000000000")
+	unknown := common.HexToAddress("0xd000000000000000000000000000000000000000")
+	digest := [32]byte{1}
+
+	t.Run("rewrites wrong observer indices", func(t *testing.T) {
+		inputPath := filepath.Join(t.TempDir(), "results.yaml")
+		fixture := &entities.TransmissionResult{
+			ContractAddress: contractAddr,
+			StartRound:      1,
+			EndRound:        4,
+			Transmissions: []entities.Transmission{
+				{ConfigDigest: digest, Round: 1, TransmitterAddress: transmitterA, ObserverIndex: 255, BlockNumber: 100},
+				{ConfigDigest: digest, Round: 2, TransmitterAddress: transmitterB, ObserverIndex: 255, BlockNumber: 101},
+				{ConfigDigest: digest, Round: 3, TransmitterAddress: transmitterC, ObserverIndex: 7, BlockNumber: 102},
+				{ConfigDigest: digest, Round: 4, TransmitterAddress: unknown, ObserverIndex: 3, BlockNumber: 103},
+			},
+		}
+		require.NoError(t, services.WriteTransmissionResult(inputPath, fixture, interfaces.OutputFormatYAML))
+
+		// The config is looked up once per digest.
+		mockAggregator.EXPECT().
+			GetConfigFromBlock(ctx, contractAddr, uint64(100)).
+			Return(&entities.OCR2Config{
+				ConfigDigest: digest,
+				Transmitters: []common.Address{transmitterC, transmitterA, transmitterB},
+			}, nil).
+			Times(1)
+
+		result, err := useCase.Execute(ctx, interfaces.ReindexTransmissionsParams{
+			InputPath:       inputPath,
+			ContractAddress: contractAddr,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4, result.TotalTransmissions)
+		assert.Equal(t, 3, result.UpdatedCount)
+		assert.Equal(t, 1, result.UnresolvedCount)
+
+		written, _, err := services.ReadTransmissionResult(inputPath)
+		require.NoError(t, err)
+		require.Len(t, written.Transmissions, 4)
+		assert.Equal(t, uint8(1), written.Transmissions[0].ObserverIndex)
+		assert.Equal(t, uint8(2), written.Transmissions[1].ObserverIndex)
+		assert.Equal(t, uint8(0), written.Transmissions[2].ObserverIndex)
+		assert.Equal(t, entities.UnknownObserverIndex, written.Transmissions[3].ObserverIndex)
+	})
+
+	t.Run("writes to a separate output path", func(t *testing.T) {
+		dir := t.TempDir()
+		inputPath := filepath.Join(dir, "results.yaml")
+		outputPath := filepath.Join(dir, "reindexed.json")
+		fixture := &entities.TransmissionResult{
+			ContractAddress: contractAddr,
+			Transmissions: []entities.Transmission{
+				{TransmitterAddress: transmitterB, ObserverIndex: 255, BlockNumber: 200},
+			},
+		}
+		require.NoError(t, services.WriteTransmissionResult(inputPath, fixture, interfaces.OutputFormatYAML))
+
+		mockAggregator.EXPECT().
+			GetConfigFromBlock(ctx, contractAddr, uint64(200)).
+			Return(&entities.OCR2Config{
+				Transmitters: []common.Address{transmitterA, transmitterB},
+			}, nil)
+
+		_, err := useCase.Execute(ctx, interfaces.ReindexTransmissionsParams{
+			InputPath:       inputPath,
+			OutputPath:      outputPath,
+			ContractAddress: contractAddr,
+		})
+		require.NoError(t, err)
+
+		written, _, err := services.ReadTransmissionResult(outputPath)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(1), written.Transmissions[0].ObserverIndex)
+		_, format, err := services.ReadTransmissionResult(outputPath)
+		require.NoError(t, err)
+		assert.Equal(t, interfaces.OutputFormatJSON, format)
+
+		original, _, err := services.ReadTransmissionResult(inputPath)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(255), original.Transmissions[0].ObserverIndex)
+	})
+
+	t.Run("config lookup error marks transmission unresolved", func(t *testing.T) {
+		inputPath := filepath.Join(t.TempDir(), "results.yaml")
+		fixture := &entities.TransmissionResult{
+			ContractAddress: contractAddr,
+			Transmissions: []entities.Transmission{
+				{ConfigDigest: digest, TransmitterAddress: transmitterA, ObserverIndex: 4, BlockNumber: 300},
+			},
+		}
+		require.NoError(t, services.WriteTransmissionResult(inputPath, fixture, interfaces.OutputFormatYAML))
+
+		mockAggregator.EXPECT().
+			GetConfigFromBlock(ctx, contractAddr, uint64(300)).
+			Return(nil, assert.AnError)
+
+		result, err := useCase.Execute(ctx, interfaces.ReindexTransmissionsParams{
+			InputPath:       inputPath,
+			ContractAddress: contractAddr,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.UpdatedCount)
+		assert.Equal(t, 1, result.UnresolvedCount)
+
+		written, _, err := services.ReadTransmissionResult(inputPath)
+		require.NoError(t, err)
+		assert.Equal(t, entities.UnknownObserverIndex, written.Transmissions[0].ObserverIndex)
+	})
+
+	t.Run("digest mismatch retries previous block", func(t *testing.T) {
+		inputPath := filepath.Join(t.TempDir(), "results.yaml")
+		newDigest := [32]byte{2}
+		fixture := &entities.TransmissionResult{
+			ContractAddress: contractAddr,
+			Transmissions: []entities.Transmission{
+				{ConfigDigest: digest, TransmitterAddress: transmitterA, ObserverIndex: 255, BlockNumber: 400},
+				{ConfigDigest: digest, TransmitterAddress: transmitterB, ObserverIndex: 255, BlockNumber: 400},
+			},
+		}
+		require.NoError(t, services.WriteTransmissionResult(inputPath, fixture, interfaces.OutputFormatYAML))
+
+		// A setConfig later in block 400 replaced the config used by the transmissions.
+		mockAggregator.EXPECT().
+			GetConfigFromBlock(ctx, contractAddr, uint64(400)).
+			Return(&entities.OCR2Config{
+				ConfigDigest: newDigest,
+				Transmitters: []common.Address{transmitterB, transmitterA},
+			}, nil).
+			Times(1)
+		mockAggregator.EXPECT().
+			GetConfigFromBlock(ctx, contractAddr, uint64(399)).
+			Return(&entities.OCR2Config{
+				ConfigDigest: digest,
+				Transmitters: []common.Address{transmitterA, transmitterB},
+			}, nil).
+			Times(1)
+
+		result, err := useCase.Execute(ctx, interfaces.ReindexTransmissionsParams{
+			InputPath:       inputPath,
+			ContractAddress: contractAddr,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.UpdatedCount)
+
+		written, _, err := services.ReadTransmissionResult(inputPath)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(0), written.Transmissions[0].ObserverIndex)
+		assert.Equal(t, uint8(1), written.Transmissions[1].ObserverIndex)
+	})
+
+	t.Run("digest mismatch marks transmission unresolved", func(t *testing.T) {
+		inputPath := filepath.Join(t.TempDir(), "results.yaml")
+		fixture := &entities.TransmissionResult{
+			ContractAddress: contractAddr,
+			Transmissions: []entities.Transmission{
+				{ConfigDigest: digest, TransmitterAddress: transmitterA, ObserverIndex: 1, BlockNumber: 500},
+			},
+		}
+		require.NoError(t, services.WriteTransmissionResult(inputPath, fixture, interfaces.OutputFormatYAML))
+
+		mockAggregator.EXPECT().
+			GetConfigFromBlock(ctx, contractAddr, gomock.Any()).
+			Return(&entities.OCR2Config{
+				ConfigDigest: [32]byte{3},
+				Transmitters: []common.Address{transmitterB, transmitterA},
+			}, nil).
+			Times(2)
+
+		result, err := useCase.Execute(ctx, interfaces.ReindexTransmissionsParams{
+			InputPath:       inputPath,
+			ContractAddress: contractAddr,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.UnresolvedCount)
+
+		written, _, err := services.ReadTransmissionResult(inputPath)
+		require.NoError(t, err)
+		assert.Equal(t, entities.UnknownObserverIndex, written.Transmissions[0].ObserverIndex)
+	})
+
+	t.Run("validation error - contract mismatch", func(t *testing.T) {
+		inputPath := filepath.Join(t.TempDir(), "results.yaml")
+		fixture := &entities.TransmissionResult{
+			ContractAddress: contractAddr,
+			Transmissions: []entities.Transmission{
+				{TransmitterAddress: transmitterA, ObserverIndex: 2, BlockNumber: 600},
+			},
+		}
+		require.NoError(t, services.WriteTransmissionResult(inputPath, fixture, interfaces.OutputFormatYAML))
+
+		result, err := useCase.Execute(ctx, interfaces.ReindexTransmissionsParams{
+			InputPath:       inputPath,
+			ContractAddress: unknown,
+		})
+		require.Error(t, err)
+		assert.Nil(t, result)
+		validErr, ok := err.(*errors.ValidationError)
+		require.True(t, ok)
+		assert.Contains(t, validErr.Fields["contract_address"][0], "does not match")
+
+		// The archive must be left untouched.
+		original, _, err := services.ReadTransmissionResult(inputPath)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(2), original.Transmissions[0].ObserverIndex)
+	})
+
+	t.Run("validation error - missing contract", func(t *testing.T) {
+		result, err := useCase.Execute(ctx, interfaces.ReindexTransmissionsParams{
+			InputPath: "results.yaml",
+		})
+		require.Error(t, err)
+		assert.Nil(t, result)
+		validErr, ok := err.(*errors.ValidationError)
+		require.True(t, ok)
+		assert.Contains(t, validErr.Fields["contract_address"][0], "contract address is required")
+	})
+}
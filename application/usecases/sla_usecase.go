@@ -0,0 +1,179 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// slaUseCase implements the SLAUseCase interface.
+type slaUseCase struct {
+	transmissionFetcher interfaces.TransmissionFetcher
+	aggregatorService   interfaces.OCR2AggregatorService
+	logger              interfaces.Logger
+	now                 func() time.Time
+}
+
+// NewSLAUseCase creates a new SLA use case.
+func NewSLAUseCase(
+	transmissionFetcher interfaces.TransmissionFetcher,
+	aggregatorService interfaces.OCR2AggregatorService,
+	logger interfaces.Logger,
+) interfaces.SLAUseCase {
+	return &slaUseCase{
+		transmissionFetcher: transmissionFetcher,
+		aggregatorService:   aggregatorService,
+		logger:              logger,
+		now:                 time.Now,
+	}
+}
+
+// Execute counts the rounds in the time range the transmitter observed.
+// A zero To runs the range to now.
+func (uc *slaUseCase) Execute(
+	ctx context.Context,
+	params interfaces.SLAParams,
+) (*interfaces.SLAResult, error) {
+	if params.To.IsZero() {
+		params.To = uc.now()
+	}
+
+	// Validate parameters
+	if err := uc.validateParams(params); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Computing transmitter SLA",
+		"contract", params.ContractAddress.Hex(),
+		"transmitter", params.TransmitterAddress.Hex(),
+		"from", params.From,
+		"to", params.To)
+
+	// Block timestamps are not needed once the time range is resolved to blocks.
+	result, err := uc.transmissionFetcher.FetchByTimeRange(
+		ctx,
+		params.ContractAddress,
+		params.From,
+		params.To,
+		interfaces.FetchOptions{SkipTimestamps: true},
+	)
+	if err != nil {
+		uc.logger.Error("Failed to fetch transmissions", "error", err)
+		return nil, err
+	}
+
+	indices, err := uc.resolveOracleIndices(ctx, params, result.Transmissions)
+	if err != nil {
+		return nil, err
+	}
+
+	participated, expected := countParticipation(result.Transmissions, indices)
+
+	sla := &interfaces.SLAResult{
+		ContractAddress:    params.ContractAddress,
+		TransmitterAddress: params.TransmitterAddress,
+		From:               params.From,
+		To:                 params.To,
+		ParticipatedRounds: participated,
+		ExpectedRounds:     expected,
+		TotalRounds:        len(result.Transmissions),
+	}
+	if expected > 0 {
+		sla.Percentage = float64(participated) / float64(expected) * 100
+	}
+
+	return sla, nil
+}
+
+// resolveOracleIndices returns the transmitter's oracle index under each config
+// digest seen in the transmissions. Digests whose config does not include the
+// transmitter are marked as non-members.
+func (uc *slaUseCase) resolveOracleIndices(
+	ctx context.Context,
+	params interfaces.SLAParams,
+	transmissions []entities.Transmission,
+) (map[[32]byte]oracleIndex, error) {
+	indices := make(map[[32]byte]oracleIndex)
+	for _, tx := range transmissions {
+		if _, ok := indices[tx.ConfigDigest]; ok {
+			continue
+		}
+
+		config, err := uc.aggregatorService.GetConfigFromBlock(ctx, params.ContractAddress, tx.BlockNumber)
+		if err != nil {
+			uc.logger.Error("Failed to get config",
+				"block", tx.BlockNumber,
+				"error", err)
+			return nil, err
+		}
+
+		index, ok := config.TransmitterIndex(params.TransmitterAddress)
+		indices[tx.ConfigDigest] = oracleIndex{index: index, member: ok}
+	}
+
+	return indices, nil
+}
+
+// oracleIndex is a transmitter's position within a config.
+type oracleIndex struct {
+	index  uint8
+	member bool
+}
+
+// countParticipation returns the rounds the oracle observed and the rounds it
+// was expected to observe, given its index under each config digest.
+// A round counts as observed when the oracle contributed an observation or
+// transmitted the report.
+func countParticipation(
+	transmissions []entities.Transmission,
+	indices map[[32]byte]oracleIndex,
+) (participated, expected int) {
+	for i := range transmissions {
+		tx := &transmissions[i]
+		oracle := indices[tx.ConfigDigest]
+		if !oracle.member {
+			continue
+		}
+
+		expected++
+		if tx.HasObserver(oracle.index) || tx.ObserverIndex == oracle.index {
+			participated++
+		}
+	}
+
+	return participated, expected
+}
+
+// validateParams validates the SLA parameters.
+func (uc *slaUseCase) validateParams(params interfaces.SLAParams) error {
+	validationErr := &errors.ValidationError{}
+
+	if params.ContractAddress == (common.Address{}) {
+		validationErr.AddFieldError("contract_address", "contract address is required")
+	}
+
+	if params.TransmitterAddress == (common.Address{}) {
+		validationErr.AddFieldError("transmitter_address", "transmitter address is required")
+	}
+
+	if params.From.IsZero() {
+		validationErr.AddFieldError("from", "start time is required")
+	} else if !params.From.Before(params.To) {
+		validationErr.AddFieldError(
+			"time_range",
+			fmt.Sprintf("invalid range: from=%s is not before to=%s",
+				params.From.Format(time.RFC3339), params.To.Format(time.RFC3339)),
+		)
+	}
+
+	if validationErr.HasErrors() {
+		return validationErr
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/helpers"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSLAUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewSLAUseCase(mockFetcher, mockAggregator, mockLogger)
+	ctx := context.Background()
+
+	contract := helpers.RandomAddress()
+	transmitter := helpers.RandomAddress()
+	other := helpers.RandomAddress()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	// The transmitter is oracle 1 under the first config, oracle 0 under the
+	// second, and absent from the third.
+	first, second, removed := [32]byte{1}, [32]byte{2}, [32]byte{3}
+	tx := func(digest [32]byte, block uint64, transmitterIndex uint8, observers ...uint8) entities.Transmission {
+		return entities.Transmission{
+			ConfigDigest:  digest,
+			BlockNumber:   block,
+			ObserverIndex: transmitterIndex,
+			Observers:     observers,
+		}
+	}
+	fixture := []entities.Transmission{
+		tx(first, 100, 0, 0, 1, 2),  // observed
+		tx(first, 101, 0, 0, 2, 3),  // missed
+		tx(first, 102, 1, 0, 2, 3),  // transmitted, observation not listed
+		tx(second, 200, 2, 0, 1, 2), // observed
+		tx(second, 201, 1, 1, 2, 3), // missed
+		tx(removed, 300, 0, 0, 1),   // excluded
+	}
+
+	mockFetcher.EXPECT().
+		FetchByTimeRange(ctx, contract, from, to, interfaces.FetchOptions{SkipTimestamps: true}).
+		Return(&entities.TransmissionResult{Transmissions: fixture}, nil)
+	mockAggregator.EXPECT().GetConfigFromBlock(ctx, contract, uint64(100)).
+		Return(&entities.OCR2Config{Transmitters: []common.Address{other, transmitter}}, nil)
+	mockAggregator.EXPECT().GetConfigFromBlock(ctx, contract, uint64(200)).
+		Return(&entities.OCR2Config{Transmitters: []common.Address{transmitter, other}}, nil)
+	mockAggregator.EXPECT().GetConfigFromBlock(ctx, contract, uint64(300)).
+		Return(&entities.OCR2Config{Transmitters: []common.Address{other}}, nil)
+
+	result, err := useCase.Execute(ctx, interfaces.SLAParams{
+		ContractAddress:    contract,
+		TransmitterAddress: transmitter,
+		From:               from,
+		To:                 to,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, result.ParticipatedRounds)
+	assert.Equal(t, 5, result.ExpectedRounds)
+	assert.Equal(t, 6, result.TotalRounds)
+	assert.InDelta(t, 60.0, result.Percentage, 1e-9)
+}
+
+func TestSLAUseCase_InvalidRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	useCase := NewSLAUseCase(
+		mocks.NewMockTransmissionFetcher(ctrl),
+		mocks.NewMockOCR2AggregatorService(ctrl),
+		mocks.NewMockLogger(ctrl),
+	)
+
+	from := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	_, err := useCase.Execute(context.Background(), interfaces.SLAParams{
+		ContractAddress:    helpers.RandomAddress(),
+		TransmitterAddress: helpers.RandomAddress(),
+		From:               from,
+		To:                 from.Add(-time.Hour),
+	})
+
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, validationErr.Fields, "time_range")
+}
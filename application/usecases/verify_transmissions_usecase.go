@@ -0,0 +1,244 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// verifyTransmissionsUseCase implements the VerifyTransmissionsUseCase interface.
+type verifyTransmissionsUseCase struct {
+	transmissionFetcher interfaces.TransmissionFetcher
+	logger              interfaces.Logger
+}
+
+// NewVerifyTransmissionsUseCase creates a new verify transmissions use case.
+func NewVerifyTransmissionsUseCase(
+	transmissionFetcher interfaces.TransmissionFetcher,
+	logger interfaces.Logger,
+) interfaces.VerifyTransmissionsUseCase {
+	return &verifyTransmissionsUseCase{
+		transmissionFetcher: transmissionFetcher,
+		logger:              logger,
+	}
+}
+
+// transmissionKey identifies a transmission independently of where it was read from.
+type transmissionKey struct {
+	configDigest [32]byte
+	epoch        uint32
+	round        uint8
+}
+
+// keyOf returns the key of a transmission.
+func keyOf(tx *entities.Transmission) transmissionKey {
+	return transmissionKey{configDigest: tx.ConfigDigest, epoch: tx.Epoch, round: tx.Round}
+}
+
+// Execute re-fetches the file's rounds and compares them with the file.
+func (uc *verifyTransmissionsUseCase) Execute(
+	ctx context.Context,
+	params interfaces.VerifyTransmissionsParams,
+) (*interfaces.VerifyTransmissionsResult, error) {
+	// Validate parameters
+	if err := uc.validateParams(params); err != nil {
+		return nil, err
+	}
+
+	file, _, err := services.ReadTransmissionResult(params.InputPath)
+	if err != nil {
+		uc.logger.Error("Failed to read transmissions", "error", err)
+		return nil, err
+	}
+	if file.ContractAddress == (common.Address{}) {
+		validationErr := &errors.ValidationError{}
+		validationErr.AddFieldError("input_path", "file does not record a contract address")
+		return nil, validationErr
+	}
+
+	uc.logger.Info("Verifying transmissions",
+		"input", params.InputPath,
+		"contract", file.ContractAddress.Hex(),
+		"startRound", file.StartRound,
+		"endRound", file.EndRound)
+
+	result := &interfaces.VerifyTransmissionsResult{
+		ContractAddress: file.ContractAddress,
+		StartRound:      file.StartRound,
+		EndRound:        file.EndRound,
+	}
+
+	var (
+		checked []entities.Transmission
+		chain   []entities.Transmission
+	)
+	if params.SampleSize > 0 && params.SampleSize < len(file.Transmissions) {
+		checked = sampleTransmissions(file.Transmissions, params.SampleSize)
+		chain, err = uc.fetchBlocks(ctx, file.ContractAddress, checked)
+	} else {
+		checked = file.Transmissions
+		chain, err = uc.fetchRounds(ctx, file)
+	}
+	if err != nil {
+		uc.logger.Error("Failed to fetch transmissions", "error", err)
+		return nil, err
+	}
+	result.CheckedTransmissions = len(checked)
+
+	onChain := make(map[transmissionKey]*entities.Transmission, len(chain))
+	for i := range chain {
+		onChain[keyOf(&chain[i])] = &chain[i]
+	}
+
+	inFile := make(map[transmissionKey]bool, len(checked))
+	for i := range checked {
+		saved := &checked[i]
+		inFile[keyOf(saved)] = true
+
+		actual, ok := onChain[keyOf(saved)]
+		if !ok {
+			result.Mismatches = append(result.Mismatches, newMismatch(saved, interfaces.MismatchMissingOnChain, "", ""))
+			continue
+		}
+		result.Mismatches = append(result.Mismatches, compareTransmission(saved, actual)...)
+	}
+
+	// A sample only looks up its own transmissions; the full range also
+	// reports transmissions the file lacks.
+	if len(checked) == len(file.Transmissions) {
+		for i := range chain {
+			if !inFile[keyOf(&chain[i])] {
+				result.Mismatches = append(result.Mismatches,
+					newMismatch(&chain[i], interfaces.MismatchMissingInFile, "", ""))
+			}
+		}
+	}
+
+	uc.logger.Info("Verification completed",
+		"checked", result.CheckedTransmissions,
+		"mismatches", len(result.Mismatches))
+
+	return result, nil
+}
+
+// fetchRounds fetches the file's whole round range from chain.
+func (uc *verifyTransmissionsUseCase) fetchRounds(
+	ctx context.Context,
+	file *entities.TransmissionResult,
+) ([]entities.Transmission, error) {
+	chain, err := uc.transmissionFetcher.FetchByRounds(
+		ctx,
+		file.ContractAddress,
+		file.StartRound,
+		file.EndRound,
+		interfaces.FetchOptions{SkipTimestamps: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return chain.Transmissions, nil
+}
+
+// fetchBlocks fetches the transmissions of the blocks holding the sampled
+// transmissions, one block at a time.
+func (uc *verifyTransmissionsUseCase) fetchBlocks(
+	ctx context.Context,
+	contractAddress common.Address,
+	sample []entities.Transmission,
+) ([]entities.Transmission, error) {
+	var transmissions []entities.Transmission
+	fetched := make(map[uint64]bool)
+	for _, tx := range sample {
+		if fetched[tx.BlockNumber] {
+			continue
+		}
+		fetched[tx.BlockNumber] = true
+
+		chain, err := uc.transmissionFetcher.FetchByBlocks(
+			ctx,
+			contractAddress,
+			tx.BlockNumber,
+			tx.BlockNumber,
+			interfaces.FetchOptions{SkipTimestamps: true},
+		)
+		if err != nil {
+			return nil, err
+		}
+		transmissions = append(transmissions, chain.Transmissions...)
+	}
+	return transmissions, nil
+}
+
+// validateParams validates the verify parameters.
+func (uc *verifyTransmissionsUseCase) validateParams(params interfaces.VerifyTransmissionsParams) error {
+	validationErr := &errors.ValidationError{}
+
+	if params.InputPath == "" {
+		validationErr.AddFieldError("input_path", "input path is required")
+	}
+
+	if params.SampleSize < 0 {
+		validationErr.AddFieldError("sample", "sample size must not be negative")
+	}
+
+	if validationErr.HasErrors() {
+		return validationErr
+	}
+
+	return nil
+}
+
+// sampleTransmissions picks n transmissions spread evenly over transmissions.
+func sampleTransmissions(transmissions []entities.Transmission, n int) []entities.Transmission {
+	sample := make([]entities.Transmission, 0, n)
+	for i := 0; i < n; i++ {
+		sample = append(sample, transmissions[i*len(transmissions)/n])
+	}
+	return sample
+}
+
+// compareTransmission reports the compared fields that differ between a saved
+// transmission and the chain. Observers and answers are skipped when the file
+// predates their capture.
+func compareTransmission(saved, actual *entities.Transmission) []interfaces.TransmissionMismatch {
+	var mismatches []interfaces.TransmissionMismatch
+
+	if saved.TransmitterAddress != actual.TransmitterAddress {
+		mismatches = append(mismatches, newMismatch(saved, "transmitter",
+			saved.TransmitterAddress.Hex(), actual.TransmitterAddress.Hex()))
+	}
+
+	if len(saved.Observers) > 0 && string(saved.Observers) != string(actual.Observers) {
+		mismatches = append(mismatches, newMismatch(saved, "observers",
+			fmt.Sprint(saved.Observers), fmt.Sprint(actual.Observers)))
+	}
+
+	if saved.LatestAnswer != nil && actual.LatestAnswer != nil && saved.LatestAnswer.Cmp(actual.LatestAnswer) != 0 {
+		mismatches = append(mismatches, newMismatch(saved, "answer",
+			saved.LatestAnswer.String(), actual.LatestAnswer.String()))
+	}
+
+	if saved.BlockNumber != actual.BlockNumber {
+		mismatches = append(mismatches, newMismatch(saved, "block",
+			fmt.Sprint(saved.BlockNumber), fmt.Sprint(actual.BlockNumber)))
+	}
+
+	return mismatches
+}
+
+// newMismatch creates a mismatch for a transmission.
+func newMismatch(tx *entities.Transmission, field, file, chain string) interfaces.TransmissionMismatch {
+	return interfaces.TransmissionMismatch{
+		Epoch:       tx.Epoch,
+		Round:       tx.Round,
+		BlockNumber: tx.BlockNumber,
+		Field:       field,
+		File:        file,
+		Chain:       chain,
+	}
+}
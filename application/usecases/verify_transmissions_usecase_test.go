@@ -0,0 +1,132 @@
+package usecases
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/helpers"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyTransmissionsUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewVerifyTransmissionsUseCase(mockFetcher, mockLogger)
+	ctx := context.Background()
+	contract := helpers.RandomAddress()
+	transmissions := []entities.Transmission{}
+	for i := 0; i < 4; i++ {
+		transmissions = append(transmissions, entities.Transmission{
+			ContractAddress:    contract,
+			ConfigDigest:       [32]byte{1},
+			Epoch:              1,
+			Round:              uint8(i + 1),
+			LatestAnswer:       big.NewInt(int64(100 + i)),
+			TransmitterAddress: helpers.RandomAddress(),
+			Observers:          []uint8{0, 1, 2},
+			BlockNumber:        uint64(1000 + i),
+		})
+	}
+	onChain := &entities.TransmissionResult{
+		ContractAddress: contract,
+		StartRound:      257,
+		EndRound:        260,
+		Transmissions:   transmissions,
+	}
+
+	// writeFile saves a copy of the chain data, changed by tamper.
+	writeFile := func(t *testing.T, tamper func(*entities.TransmissionResult)) string {
+		file := *onChain
+		file.Transmissions = make([]entities.Transmission, len(onChain.Transmissions))
+		for i, tx := range onChain.Transmissions {
+			tx.LatestAnswer = new(big.Int).Set(tx.LatestAnswer)
+			tx.Observers = append([]uint8(nil), tx.Observers...)
+			file.Transmissions[i] = tx
+		}
+		if tamper != nil {
+			tamper(&file)
+		}
+		path := filepath.Join(t.TempDir(), "result.json")
+		require.NoError(t, services.WriteTransmissionResult(path, &file, interfaces.OutputFormatJSON))
+		return path
+	}
+
+	expectFetchRange := func() {
+		mockFetcher.EXPECT().
+			FetchByRounds(ctx, contract, uint32(257), uint32(260), interfaces.FetchOptions{SkipTimestamps: true}).
+			Return(onChain, nil)
+	}
+
+	t.Run("matching file passes", func(t *testing.T) {
+		expectFetchRange()
+
+		result, err := useCase.Execute(ctx, interfaces.VerifyTransmissionsParams{InputPath: writeFile(t, nil)})
+		require.NoError(t, err)
+		assert.True(t, result.Passed())
+		assert.Equal(t, 4, result.CheckedTransmissions)
+		assert.Equal(t, contract, result.ContractAddress)
+	})
+
+	t.Run("tampered row fails", func(t *testing.T) {
+		expectFetchRange()
+		forged := helpers.RandomAddress()
+
+		path := writeFile(t, func(file *entities.TransmissionResult) {
+			file.Transmissions[1].TransmitterAddress = forged
+			file.Transmissions[1].LatestAnswer = big.NewInt(999)
+			file.Transmissions = file.Transmissions[:3]
+		})
+		result, err := useCase.Execute(ctx, interfaces.VerifyTransmissionsParams{InputPath: path})
+		require.NoError(t, err)
+		assert.False(t, result.Passed())
+		assert.Equal(t, []interfaces.TransmissionMismatch{
+			{
+				Epoch: 1, Round: 2, BlockNumber: 1001, Field: "transmitter",
+				File: forged.Hex(), Chain: transmissions[1].TransmitterAddress.Hex(),
+			},
+			{Epoch: 1, Round: 2, BlockNumber: 1001, Field: "answer", File: "999", Chain: "101"},
+			{Epoch: 1, Round: 4, BlockNumber: 1003, Field: interfaces.MismatchMissingInFile},
+		}, result.Mismatches)
+	})
+
+	t.Run("sample fetches only the sampled blocks", func(t *testing.T) {
+		for _, block := range []uint64{1000, 1002} {
+			mockFetcher.EXPECT().
+				FetchByBlocks(ctx, contract, block, block, interfaces.FetchOptions{SkipTimestamps: true}).
+				Return(&entities.TransmissionResult{
+					ContractAddress: contract,
+					Transmissions:   []entities.Transmission{transmissions[block-1000]},
+				}, nil)
+		}
+
+		path := writeFile(t, func(file *entities.TransmissionResult) {
+			file.Transmissions[2].Observers = []uint8{0, 1}
+		})
+		result, err := useCase.Execute(ctx, interfaces.VerifyTransmissionsParams{InputPath: path, SampleSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.CheckedTransmissions)
+		require.Len(t, result.Mismatches, 1)
+		assert.Equal(t, "observers", result.Mismatches[0].Field)
+		assert.Equal(t, uint8(3), result.Mismatches[0].Round)
+	})
+
+	t.Run("negative sample is rejected", func(t *testing.T) {
+		_, err := useCase.Execute(ctx, interfaces.VerifyTransmissionsParams{InputPath: "result.json", SampleSize: -1})
+		var validationErr *errors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
@@ -4,6 +4,10 @@ package usecases
 
 import (
 	"context"
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"sync"
 	"time"
 
 	"chainlink-ocr-checker/domain/entities"
@@ -12,74 +16,204 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// contractRetryDelay is how long ContractErrorRetry waits before fetching a
+// failed contract again.
+const contractRetryDelay = 2 * time.Second
+
 // watchTransmittersUseCase implements the WatchTransmittersUseCase interface.
 type watchTransmittersUseCase struct {
 	jobRepository      interfaces.JobRepository
 	transmissionFetcher interfaces.TransmissionFetcher
 	aggregatorService  interfaces.OCR2AggregatorService
 	logger             interfaces.Logger
+	maxBlockRange      uint64
+	now                func() time.Time
+	retryDelay         time.Duration
 }
 
 // NewWatchTransmittersUseCase creates a new watch transmitters use case.
+// maxBlockRange bounds each contract's round fetch to the last that many
+// blocks; zero scans from genesis.
 func NewWatchTransmittersUseCase(
 	jobRepository interfaces.JobRepository,
 	transmissionFetcher interfaces.TransmissionFetcher,
 	aggregatorService interfaces.OCR2AggregatorService,
 	logger interfaces.Logger,
+	maxBlockRange uint64,
 ) interfaces.WatchTransmittersUseCase {
 	return &watchTransmittersUseCase{
 		jobRepository:      jobRepository,
 		transmissionFetcher: transmissionFetcher,
 		aggregatorService:  aggregatorService,
 		logger:             logger,
+		maxBlockRange:      maxBlockRange,
+		now:                time.Now,
+		retryDelay:         contractRetryDelay,
 	}
 }
 
-// Execute watches transmitter activity.
+// Execute watches transmitter activity. A job whose contract cannot be checked
+// is reported with JobStatusError so the remaining jobs still appear in the result.
 func (uc *watchTransmittersUseCase) Execute(
 	ctx context.Context,
 	params interfaces.WatchTransmittersParams,
 ) (*interfaces.WatchTransmittersResult, error) {
+	results, err := uc.ExecuteMany(ctx, interfaces.WatchManyTransmittersParams{
+		TransmitterAddresses: []common.Address{params.TransmitterAddress},
+		RoundsToCheck:        params.RoundsToCheck,
+		DaysToIgnore:         params.DaysToIgnore,
+		Concurrency:          params.Concurrency,
+		ContractErrors:       params.ContractErrors,
+	})
+	if err != nil {
+		return nil, err
+	}
+	
+	return results[0].Result, results[0].Err
+}
+
+// ExecuteMany watches several transmitters together. Their jobs are grouped by
+// contract, so each contract's latest round and transmissions are fetched once
+// and every watched transmitter on it is evaluated against the same window.
+func (uc *watchTransmittersUseCase) ExecuteMany(
+	ctx context.Context,
+	params interfaces.WatchManyTransmittersParams,
+) ([]interfaces.TransmitterWatchResult, error) {
 	// Validate parameters
-	if err := uc.validateParams(params); err != nil {
+	if err := uc.validateManyParams(params); err != nil {
 		return nil, err
 	}
 	
-	uc.logger.Info("Watching transmitter activity",
-		"transmitter", params.TransmitterAddress.Hex(),
-		"rounds", params.RoundsToCheck,
-		"daysToIgnore", params.DaysToIgnore)
+	// Find jobs for each transmitter and collect the contracts to fetch.
+	results := make([]interfaces.TransmitterWatchResult, len(params.TransmitterAddresses))
+	jobsByTransmitter := make([][]entities.Job, len(params.TransmitterAddresses))
+	seen := make(map[common.Address]bool)
+	var contracts []common.Address
 	
-	// Find jobs for the transmitter
-	jobs, err := uc.jobRepository.FindByTransmitter(ctx, params.TransmitterAddress)
-	if err != nil {
-		uc.logger.Error("Failed to find jobs", "error", err)
-		return nil, err
+	for i, transmitter := range params.TransmitterAddresses {
+		results[i].TransmitterAddress = transmitter
+		
+		uc.logger.Debug("Watching transmitter activity",
+			"transmitter", transmitter.Hex(),
+			"rounds", params.RoundsToCheck,
+			"daysToIgnore", params.DaysToIgnore)
+		
+		jobs, err := uc.jobRepository.FindByTransmitter(ctx, transmitter)
+		if err != nil {
+			uc.logger.Error("Failed to find jobs", "error", err)
+			results[i].Err = err
+			continue
+		}
+		
+		if len(jobs) == 0 {
+			uc.logger.Warn("No jobs found for transmitter", "transmitter", transmitter.Hex())
+		}
+		
+		jobsByTransmitter[i] = jobs
+		for _, job := range jobs {
+			contract := job.OracleSpec.ContractAddress
+			if job.Active && !seen[contract] {
+				seen[contract] = true
+				contracts = append(contracts, contract)
+			}
+		}
+	}
+	
+	// Fetch each contract once, then evaluate every job against its contract's window.
+	now := uc.now()
+	windows := uc.fetchWindows(ctx, contracts, params.RoundsToCheck, params.Concurrency, params.ContractErrors)
+	
+	for i, jobs := range jobsByTransmitter {
+		if results[i].Err != nil {
+			continue
+		}
+		
+		statuses := make([]entities.TransmitterStatus, 0, len(jobs))
+		for _, job := range jobs {
+			window := windows[job.OracleSpec.ContractAddress]
+			statuses = append(statuses, uc.checkJobStatus(job, window, params.RoundsToCheck, now, params.DaysToIgnore))
+		}
+		results[i].Result = uc.summarize(results[i].TransmitterAddress, statuses)
 	}
 	
-	if len(jobs) == 0 {
-		uc.logger.Warn("No jobs found for transmitter", "transmitter", params.TransmitterAddress.Hex())
-		return &interfaces.WatchTransmittersResult{
-			Statuses: []entities.TransmitterStatus{},
-			Summary: interfaces.TransmitterSummary{
-				TotalJobs: 0,
-			},
-		}, nil
+	return results, nil
+}
+
+// ExecuteAll watches every transmitter with active jobs. Jobs are loaded a
+// batch at a time, and each batch's contracts are fetched and its jobs checked
+// before the next batch is loaded, so only one batch of jobs is held at once.
+func (uc *watchTransmittersUseCase) ExecuteAll(
+	ctx context.Context,
+	params interfaces.WatchAllTransmittersParams,
+) ([]interfaces.TransmitterWatchResult, error) {
+	// Validate parameters
+	if err := uc.validateAllParams(params); err != nil {
+		return nil, err
 	}
 	
-	// Check each job's status
-	statuses := make([]entities.TransmitterStatus, 0, len(jobs))
-	summary := interfaces.TransmitterSummary{
-		TotalJobs: len(jobs),
+	batchSize := params.BatchSize
+	if batchSize == 0 {
+		batchSize = interfaces.DefaultJobBatchSize
 	}
 	
-	cutoffTime := time.Now().AddDate(0, 0, -params.DaysToIgnore)
+	now := uc.now()
+	statusesByTransmitter := make(map[common.Address][]entities.TransmitterStatus)
 	
-	for _, job := range jobs {
-		status := uc.checkJobStatus(ctx, job, params.RoundsToCheck, cutoffTime)
-		statuses = append(statuses, status)
+	err := uc.jobRepository.FindActiveJobsInBatches(ctx, batchSize, func(batch []entities.Job) error {
+		uc.logger.Debug("Watching active job batch", "jobs", len(batch))
 		
-		// Update summary
+		seen := make(map[common.Address]bool)
+		var contracts []common.Address
+		for _, job := range batch {
+			contract := job.OracleSpec.ContractAddress
+			if !seen[contract] {
+				seen[contract] = true
+				contracts = append(contracts, contract)
+			}
+		}
+		
+		windows := uc.fetchWindows(ctx, contracts, params.RoundsToCheck, params.Concurrency, params.ContractErrors)
+		for _, job := range batch {
+			window := windows[job.OracleSpec.ContractAddress]
+			statusesByTransmitter[job.TransmitterAddress] = append(statusesByTransmitter[job.TransmitterAddress],
+				uc.checkJobStatus(job, window, params.RoundsToCheck, now, params.DaysToIgnore))
+		}
+		
+		return ctx.Err()
+	})
+	if err != nil {
+		uc.logger.Error("Failed to watch active jobs", "error", err)
+		return nil, err
+	}
+	
+	transmitters := make([]common.Address, 0, len(statusesByTransmitter))
+	for transmitter := range statusesByTransmitter {
+		transmitters = append(transmitters, transmitter)
+	}
+	sort.Slice(transmitters, func(i, j int) bool {
+		return transmitters[i].Hex() < transmitters[j].Hex()
+	})
+	
+	results := make([]interfaces.TransmitterWatchResult, 0, len(transmitters))
+	for _, transmitter := range transmitters {
+		results = append(results, interfaces.TransmitterWatchResult{
+			TransmitterAddress: transmitter,
+			Result:             uc.summarize(transmitter, statusesByTransmitter[transmitter]),
+		})
+	}
+	
+	return results, nil
+}
+
+// summarize builds the watch result of a transmitter from its job statuses.
+func (uc *watchTransmittersUseCase) summarize(
+	transmitter common.Address,
+	statuses []entities.TransmitterStatus,
+) *interfaces.WatchTransmittersResult {
+	summary := interfaces.TransmitterSummary{
+		TotalJobs: len(statuses),
+	}
+	for _, status := range statuses {
 		switch status.Status {
 		case entities.JobStatusFound:
 			summary.FoundJobs++
@@ -94,19 +228,24 @@ func (uc *watchTransmittersUseCase) Execute(
 		}
 	}
 	
-	uc.logger.Info("Transmitter watch completed",
-		"transmitter", params.TransmitterAddress.Hex(),
+	if summary.TotalJobs > 0 {
+		summary.HealthScore = float64(summary.FoundJobs) / float64(summary.TotalJobs) * 100
+	}
+	
+	uc.logger.Debug("Transmitter watch completed",
+		"transmitter", transmitter.Hex(),
 		"total", summary.TotalJobs,
 		"found", summary.FoundJobs,
 		"stale", summary.StaleJobs,
 		"missing", summary.MissingJobs,
 		"noActive", summary.NoActiveJobs,
-		"error", summary.ErrorJobs)
+		"error", summary.ErrorJobs,
+		"healthScore", summary.HealthScore)
 	
 	return &interfaces.WatchTransmittersResult{
 		Statuses: statuses,
 		Summary:  summary,
-	}, nil
+	}
 }
 
 // validateParams validates the watch parameters.
@@ -117,53 +256,209 @@ func (uc *watchTransmittersUseCase) validateParams(params interfaces.WatchTransm
 		validationErr.AddFieldError("transmitter_address", "transmitter address is required")
 	}
 	
-	if params.RoundsToCheck <= 0 {
+	addWindowParamErrors(validationErr, params.RoundsToCheck, params.DaysToIgnore, params.Concurrency, params.ContractErrors)
+	
+	if validationErr.HasErrors() {
+		return validationErr
+	}
+	
+	return nil
+}
+
+// validateAllParams validates the parameters of a watch of every transmitter.
+func (uc *watchTransmittersUseCase) validateAllParams(params interfaces.WatchAllTransmittersParams) error {
+	validationErr := &errors.ValidationError{}
+	
+	if params.BatchSize < 0 {
+		validationErr.AddFieldError("batch_size", "batch size cannot be negative")
+	}
+	
+	addWindowParamErrors(validationErr, params.RoundsToCheck, params.DaysToIgnore, params.Concurrency, params.ContractErrors)
+	
+	if validationErr.HasErrors() {
+		return validationErr
+	}
+	
+	return nil
+}
+
+// addWindowParamErrors validates the parameters shared by every kind of watch.
+func addWindowParamErrors(
+	validationErr *errors.ValidationError,
+	roundsToCheck int,
+	daysToIgnore int,
+	concurrency int,
+	policy interfaces.ContractErrorPolicy,
+) {
+	if roundsToCheck <= 0 {
 		validationErr.AddFieldError("rounds_to_check", "rounds to check must be positive")
 	}
 	
-	if params.RoundsToCheck > 100 {
+	if roundsToCheck > 100 {
 		validationErr.AddFieldError("rounds_to_check", "rounds to check must not exceed 100")
 	}
 	
-	if params.DaysToIgnore < 0 {
+	if daysToIgnore < 0 {
 		validationErr.AddFieldError("days_to_ignore", "days to ignore cannot be negative")
 	}
 	
-	if validationErr.HasErrors() {
+	if concurrency < 0 {
+		validationErr.AddFieldError("concurrency", "concurrency cannot be negative")
+	}
+	
+	switch policy {
+	case "", interfaces.ContractErrorFail, interfaces.ContractErrorRetry:
+	default:
+		validationErr.AddFieldError("contract_errors",
+			fmt.Sprintf("unknown contract error policy %q (expected error or retry)", policy))
+	}
+}
+
+// validateManyParams validates the parameters of a grouped watch.
+func (uc *watchTransmittersUseCase) validateManyParams(params interfaces.WatchManyTransmittersParams) error {
+	if len(params.TransmitterAddresses) == 0 {
+		validationErr := &errors.ValidationError{}
+		validationErr.AddFieldError("transmitter_addresses", "at least one transmitter address is required")
 		return validationErr
 	}
 	
+	for _, transmitter := range params.TransmitterAddresses {
+		err := uc.validateParams(interfaces.WatchTransmittersParams{
+			TransmitterAddress: transmitter,
+			RoundsToCheck:      params.RoundsToCheck,
+			DaysToIgnore:       params.DaysToIgnore,
+			Concurrency:        params.Concurrency,
+			ContractErrors:     params.ContractErrors,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	
 	return nil
 }
 
-// checkJobStatus checks the status of a single job.
-func (uc *watchTransmittersUseCase) checkJobStatus(
+// contractWindow is the latest round range of a contract and its transmissions,
+// shared by every watched job on the contract.
+type contractWindow struct {
+	startRound uint32
+	endRound   uint32
+	result     *entities.TransmissionResult
+	err        error
+	reason     string
+	
+	// configs holds the config of each digest in the window. It is nil when a
+	// lookup failed, leaving participation uncounted.
+	configs map[[32]byte]*entities.OCR2Config
+}
+
+// fetchWindows fetches the window of each contract, a bounded number at a time.
+// Under ContractErrorRetry a failed contract is fetched once more.
+func (uc *watchTransmittersUseCase) fetchWindows(
 	ctx context.Context,
-	job entities.Job,
+	contracts []common.Address,
 	roundsToCheck int,
-	cutoffTime time.Time,
-) entities.TransmitterStatus {
-	status := entities.TransmitterStatus{
-		Address:         job.TransmitterAddress,
-		JobID:           job.ExternalJobID,
-		ContractAddress: job.OracleSpec.ContractAddress,
+	concurrency int,
+	policy interfaces.ContractErrorPolicy,
+) map[common.Address]*contractWindow {
+	if concurrency == 0 {
+		concurrency = interfaces.DefaultWatchConcurrency
 	}
 	
-	// Check if job is active.
-	if !job.Active {
-		status.Status = entities.JobStatusNoActive
-		return status
+	windows := make([]*contractWindow, len(contracts))
+	panics := make([]*contractPanic, len(contracts))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	wg.Add(len(contracts))
+	
+	for i, contract := range contracts {
+		go func(i int, contract common.Address) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					panics[i] = &contractPanic{contract: contract, value: r, stack: debug.Stack()}
+				}
+			}()
+			
+			// Acquire semaphore.
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			
+			windows[i] = uc.fetchWindow(ctx, contract, roundsToCheck)
+			if windows[i].err != nil && policy == interfaces.ContractErrorRetry {
+				windows[i] = uc.retryWindow(ctx, contract, roundsToCheck, windows[i])
+			}
+		}(i, contract)
+	}
+	wg.Wait()
+	
+	// Re-raise a contract's panic on the caller's goroutine, where it can be
+	// recovered, rather than letting it end the process from a worker.
+	for _, p := range panics {
+		if p != nil {
+			panic(p)
+		}
 	}
 	
+	byContract := make(map[common.Address]*contractWindow, len(contracts))
+	for i, contract := range contracts {
+		byContract[contract] = windows[i]
+	}
+	
+	return byContract
+}
+
+// contractPanic is a panic raised while fetching a contract's window, carried
+// to the goroutine that started the fetch.
+type contractPanic struct {
+	contract common.Address
+	value    interface{}
+	stack    []byte
+}
+
+// String describes the panic with the stack of the goroutine that raised it.
+func (p *contractPanic) String() string {
+	return fmt.Sprintf("contract %s: %v\n\n%s", p.contract.Hex(), p.value, p.stack)
+}
+
+// retryWindow fetches a failed contract's window again after retryDelay. The
+// failed window is kept if the context ends first.
+func (uc *watchTransmittersUseCase) retryWindow(
+	ctx context.Context,
+	contract common.Address,
+	roundsToCheck int,
+	failed *contractWindow,
+) *contractWindow {
+	uc.logger.Warn("Retrying contract after error",
+		"contract", contract.Hex(),
+		"reason", failed.reason,
+		"error", failed.err)
+	
+	timer := time.NewTimer(uc.retryDelay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		return failed
+	}
+	
+	return uc.fetchWindow(ctx, contract, roundsToCheck)
+}
+
+// fetchWindow fetches the transmissions of the last roundsToCheck rounds of a contract.
+// A failure is kept in the window so every job on the contract reports it.
+func (uc *watchTransmittersUseCase) fetchWindow(
+	ctx context.Context,
+	contract common.Address,
+	roundsToCheck int,
+) *contractWindow {
 	// Get latest round from the aggregator.
-	latestRound, err := uc.aggregatorService.GetLatestRound(ctx, job.OracleSpec.ContractAddress)
+	latestRound, err := uc.aggregatorService.GetLatestRound(ctx, contract)
 	if err != nil {
 		uc.logger.Error("Failed to get latest round",
-			"contract", job.OracleSpec.ContractAddress.Hex(),
+			"contract", contract.Hex(),
 			"error", err)
-		status.Status = entities.JobStatusError
-		status.Error = err
-		return status
+		return &contractWindow{err: err, reason: "latest round lookup failed"}
 	}
 	
 	// Calculate the round range to check.
@@ -178,23 +473,114 @@ func (uc *watchTransmittersUseCase) checkJobStatus(
 			startRound = 1
 		}
 	}
+	window := &contractWindow{startRound: startRound, endRound: endRound}
 	
 	// Fetch transmissions for the round range.
 	result, err := uc.transmissionFetcher.FetchByRounds(
 		ctx,
-		job.OracleSpec.ContractAddress,
+		contract,
 		startRound,
 		endRound,
+		interfaces.FetchOptions{MaxBlockRange: uc.maxBlockRange},
 	)
 	if err != nil {
 		uc.logger.Error("Failed to fetch transmissions",
-			"contract", job.OracleSpec.ContractAddress.Hex(),
+			"contract", contract.Hex(),
 			"error", err)
+		window.err = err
+		window.reason = fmt.Sprintf("fetching rounds %d-%d failed", startRound, endRound)
+		return window
+	}
+	
+	// Several configs in one window means the contract was reconfigured within it.
+	if configs := result.CountConfigDigests(); configs > 1 {
+		uc.logger.Warn("Multiple configs in check window",
+			"contract", contract.Hex(),
+			"configs", configs,
+			"startRound", startRound,
+			"endRound", endRound)
+	}
+	
+	window.result = result
+	window.configs = uc.fetchWindowConfigs(ctx, contract, result.Transmissions)
+	return window
+}
+
+// fetchWindowConfigs looks up the config of each digest in a window once, so
+// every job on the contract can find its oracle's index.
+func (uc *watchTransmittersUseCase) fetchWindowConfigs(
+	ctx context.Context,
+	contract common.Address,
+	transmissions []entities.Transmission,
+) map[[32]byte]*entities.OCR2Config {
+	configs := make(map[[32]byte]*entities.OCR2Config)
+	for _, tx := range transmissions {
+		if _, ok := configs[tx.ConfigDigest]; ok {
+			continue
+		}
+		
+		config, err := uc.aggregatorService.GetConfigFromBlock(ctx, contract, tx.BlockNumber)
+		if err != nil {
+			uc.logger.Warn("Failed to get config, skipping participation",
+				"contract", contract.Hex(),
+				"block", tx.BlockNumber,
+				"error", err)
+			return nil
+		}
+		configs[tx.ConfigDigest] = config
+	}
+	
+	return configs
+}
+
+// checkJobStatus classifies a job against its contract's window and explains the
+// classification in Reason. A transmission older than daysToIgnore before now
+// marks the job stale.
+func (uc *watchTransmittersUseCase) checkJobStatus(
+	job entities.Job,
+	window *contractWindow,
+	roundsToCheck int,
+	now time.Time,
+	daysToIgnore int,
+) entities.TransmitterStatus {
+	status := entities.TransmitterStatus{
+		Address:         job.TransmitterAddress,
+		JobID:           job.ExternalJobID,
+		ContractAddress: job.OracleSpec.ContractAddress,
+	}
+	
+	// Check if job is active.
+	if !job.Active {
+		status.Status = entities.JobStatusNoActive
+		status.Reason = "job is not active"
+		return status
+	}
+	
+	if window.err != nil {
 		status.Status = entities.JobStatusError
-		status.Error = err
+		status.Error = window.err
+		status.Reason = window.reason
 		return status
 	}
 	
+	result := window.result
+	startRound, endRound := window.startRound, window.endRound
+	status.ObserverCounts = result.CountObservers()
+	status.ConfigDigests = result.CountConfigDigests()
+	
+	// Count the rounds the transmitter's oracle took part in, as sla does.
+	if window.configs != nil {
+		indices := make(map[[32]byte]oracleIndex, len(window.configs))
+		for digest, config := range window.configs {
+			index, ok := config.TransmitterIndex(job.TransmitterAddress)
+			indices[digest] = oracleIndex{index: index, member: ok}
+		}
+		status.ObservedRounds, status.ExpectedRounds = countParticipation(result.Transmissions, indices)
+		if status.ExpectedRounds > 0 {
+			status.Participation = float64(status.ObservedRounds) / float64(status.ExpectedRounds)
+		}
+	}
+	
 	// Find transmissions from our transmitter.
 	found := false
 	var lastTransmissionTime time.Time
@@ -211,14 +597,32 @@ func (uc *watchTransmittersUseCase) checkJobStatus(
 	}
 	
 	// Determine status based on findings.
+	cutoffTime := now.AddDate(0, 0, -daysToIgnore)
+	age := formatAge(now.Sub(lastTransmissionTime))
+	threshold := fmt.Sprintf("%dh", daysToIgnore*24)
 	switch {
 	case !found:
 		status.Status = entities.JobStatusMissing
+		status.Reason = fmt.Sprintf("no tx in last %d rounds (%d-%d)", roundsToCheck, startRound, endRound)
 	case lastTransmissionTime.Before(cutoffTime):
 		status.Status = entities.JobStatusStale
+		status.Reason = fmt.Sprintf("last tx %s ago exceeds %s threshold", age, threshold)
 	default:
 		status.Status = entities.JobStatusFound
+		status.Reason = fmt.Sprintf("last tx %s ago within %s threshold", age, threshold)
 	}
 	
 	return status
-}
\ No newline at end of file
+}
+
+// formatAge formats a duration in whole hours, minutes, or seconds.
+func formatAge(d time.Duration) string {
+	switch {
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	case d >= time.Minute:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	default:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	}
+}
@@ -0,0 +1,679 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/helpers"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// watchMaxBlockRange is the max block range the watch use cases under test are built with.
+const watchMaxBlockRange = 10000
+
+func TestWatchTransmittersUseCase_PartialFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockJobRepository(ctrl)
+	mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewWatchTransmittersUseCase(mockRepo, mockFetcher, mockAggregator, mockLogger, watchMaxBlockRange)
+	ctx := context.Background()
+	transmitter := helpers.RandomAddress()
+
+	healthy := helpers.RandomAddress()
+	broken := helpers.RandomAddress()
+	silent := helpers.RandomAddress()
+
+	jobs := make([]entities.Job, 0, 3)
+	for i, contract := range []common.Address{healthy, broken, silent} {
+		jobs = append(jobs, entities.Job{
+			ExternalJobID:      fmt.Sprintf("job-%d", i),
+			OracleSpec:         entities.OracleSpec{ContractAddress: contract},
+			TransmitterAddress: transmitter,
+			Active:             true,
+		})
+	}
+	mockRepo.EXPECT().FindByTransmitter(ctx, transmitter).Return(jobs, nil)
+
+	latest := &entities.Round{RoundID: 1<<8 | 10}
+	mockAggregator.EXPECT().GetLatestRound(ctx, healthy).Return(latest, nil)
+	mockAggregator.EXPECT().GetLatestRound(ctx, broken).Return(nil, fmt.Errorf("execution reverted"))
+	mockAggregator.EXPECT().GetLatestRound(ctx, silent).Return(latest, nil)
+
+	now := time.Now()
+	mockFetcher.EXPECT().
+		FetchByRounds(ctx, healthy, uint32(1<<8|6), uint32(1<<8|10), interfaces.FetchOptions{MaxBlockRange: watchMaxBlockRange}).
+		Return(&entities.TransmissionResult{
+			Transmissions: []entities.Transmission{
+				{Epoch: 1, Round: 9, TransmitterAddress: transmitter, BlockTimestamp: now},
+			},
+		}, nil)
+	mockFetcher.EXPECT().
+		FetchByRounds(ctx, silent, uint32(1<<8|6), uint32(1<<8|10), interfaces.FetchOptions{MaxBlockRange: watchMaxBlockRange}).
+		Return(&entities.TransmissionResult{
+			Transmissions: []entities.Transmission{
+				{Epoch: 1, Round: 9, TransmitterAddress: helpers.RandomAddress(), BlockTimestamp: now},
+			},
+		}, nil)
+	mockAggregator.EXPECT().GetConfigFromBlock(ctx, healthy, uint64(0)).Return(&entities.OCR2Config{}, nil)
+	mockAggregator.EXPECT().GetConfigFromBlock(ctx, silent, uint64(0)).Return(&entities.OCR2Config{}, nil)
+
+	result, err := useCase.Execute(ctx, interfaces.WatchTransmittersParams{
+		TransmitterAddress: transmitter,
+		RoundsToCheck:      5,
+		DaysToIgnore:       1,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Statuses, 3)
+
+	assert.Equal(t, entities.JobStatusFound, result.Statuses[0].Status)
+	assert.Equal(t, uint32(1<<8|9), result.Statuses[0].LastRound)
+
+	assert.Equal(t, entities.JobStatusError, result.Statuses[1].Status)
+	assert.Equal(t, broken, result.Statuses[1].ContractAddress)
+	require.Error(t, result.Statuses[1].Error)
+	assert.Contains(t, result.Statuses[1].Error.Error(), "execution reverted")
+
+	assert.Equal(t, entities.JobStatusMissing, result.Statuses[2].Status)
+
+	assert.Equal(t, 3, result.Summary.TotalJobs)
+	assert.Equal(t, 1, result.Summary.FoundJobs)
+	assert.Equal(t, 1, result.Summary.MissingJobs)
+	assert.Equal(t, 1, result.Summary.ErrorJobs)
+	assert.InDelta(t, 100.0/3, result.Summary.HealthScore, 1e-9)
+}
+
+func TestWatchTransmittersUseCase_ConfigDigests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockJobRepository(ctrl)
+	mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewWatchTransmittersUseCase(mockRepo, mockFetcher, mockAggregator, mockLogger, watchMaxBlockRange)
+	ctx := context.Background()
+	transmitter := helpers.RandomAddress()
+	contract := helpers.RandomAddress()
+
+	mockRepo.EXPECT().FindByTransmitter(ctx, transmitter).Return([]entities.Job{
+		{ExternalJobID: "job", OracleSpec: entities.OracleSpec{ContractAddress: contract}, TransmitterAddress: transmitter, Active: true},
+	}, nil)
+	mockAggregator.EXPECT().GetLatestRound(ctx, contract).Return(&entities.Round{RoundID: 2<<8 | 2}, nil)
+
+	// The contract was reconfigured within the window.
+	now := time.Now()
+	mockFetcher.EXPECT().
+		FetchByRounds(ctx, contract, uint32(1<<8|255), uint32(2<<8|2), interfaces.FetchOptions{MaxBlockRange: watchMaxBlockRange}).
+		Return(&entities.TransmissionResult{
+			Transmissions: []entities.Transmission{
+				{ConfigDigest: [32]byte{1}, Epoch: 1, Round: 255, TransmitterAddress: transmitter, BlockTimestamp: now},
+				{ConfigDigest: [32]byte{2}, Epoch: 2, Round: 1, TransmitterAddress: transmitter, BlockTimestamp: now},
+				{ConfigDigest: [32]byte{2}, Epoch: 2, Round: 2, TransmitterAddress: transmitter, BlockTimestamp: now},
+			},
+		}, nil)
+	mockLogger.EXPECT().Warn("Multiple configs in check window", gomock.Any()).Times(1)
+	mockAggregator.EXPECT().GetConfigFromBlock(ctx, contract, uint64(0)).Return(&entities.OCR2Config{}, nil).Times(2)
+
+	result, err := useCase.Execute(ctx, interfaces.WatchTransmittersParams{
+		TransmitterAddress: transmitter,
+		RoundsToCheck:      4,
+		DaysToIgnore:       1,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Statuses, 1)
+	assert.Equal(t, 2, result.Statuses[0].ConfigDigests)
+}
+
+func TestWatchTransmittersUseCase_Participation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockJobRepository(ctrl)
+	mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewWatchTransmittersUseCase(mockRepo, mockFetcher, mockAggregator, mockLogger, watchMaxBlockRange)
+	ctx := context.Background()
+	transmitter := helpers.RandomAddress()
+	other := helpers.RandomAddress()
+	counted := helpers.RandomAddress()
+	uncounted := helpers.RandomAddress()
+
+	mockRepo.EXPECT().FindByTransmitter(ctx, transmitter).Return([]entities.Job{
+		{ExternalJobID: "counted", OracleSpec: entities.OracleSpec{ContractAddress: counted}, TransmitterAddress: transmitter, Active: true},
+		{ExternalJobID: "uncounted", OracleSpec: entities.OracleSpec{ContractAddress: uncounted}, TransmitterAddress: transmitter, Active: true},
+	}, nil)
+	latest := &entities.Round{RoundID: 1<<8 | 10}
+	mockAggregator.EXPECT().GetLatestRound(ctx, counted).Return(latest, nil)
+	mockAggregator.EXPECT().GetLatestRound(ctx, uncounted).Return(latest, nil)
+
+	// The transmitter is oracle 2 under config A, oracle 0 under config B,
+	// and not a member of config C.
+	now := time.Now()
+	digestA, digestB, digestC := [32]byte{0xa}, [32]byte{0xb}, [32]byte{0xc}
+	window := &entities.TransmissionResult{
+		Transmissions: []entities.Transmission{
+			{ConfigDigest: digestA, Epoch: 1, Round: 4, Observers: []uint8{0, 1, 2}, ObserverIndex: 0, TransmitterAddress: other, BlockNumber: 100, BlockTimestamp: now},
+			{ConfigDigest: digestA, Epoch: 1, Round: 5, Observers: []uint8{0, 1}, ObserverIndex: 0, TransmitterAddress: other, BlockNumber: 101, BlockTimestamp: now},
+			{ConfigDigest: digestA, Epoch: 1, Round: 6, Observers: []uint8{0, 1}, ObserverIndex: 2, TransmitterAddress: transmitter, BlockNumber: 102, BlockTimestamp: now},
+			{ConfigDigest: digestB, Epoch: 1, Round: 7, Observers: []uint8{0, 1}, ObserverIndex: 1, TransmitterAddress: other, BlockNumber: 200, BlockTimestamp: now},
+			{ConfigDigest: digestB, Epoch: 1, Round: 8, Observers: []uint8{1}, ObserverIndex: 1, TransmitterAddress: other, BlockNumber: 201, BlockTimestamp: now},
+			{ConfigDigest: digestC, Epoch: 1, Round: 9, Observers: []uint8{0}, ObserverIndex: 0, TransmitterAddress: other, BlockNumber: 300, BlockTimestamp: now},
+		},
+	}
+	mockFetcher.EXPECT().
+		FetchByRounds(ctx, counted, uint32(1<<8|6), uint32(1<<8|10), interfaces.FetchOptions{MaxBlockRange: watchMaxBlockRange}).
+		Return(window, nil)
+	mockAggregator.EXPECT().GetConfigFromBlock(ctx, counted, uint64(100)).
+		Return(&entities.OCR2Config{Transmitters: []common.Address{other, helpers.RandomAddress(), transmitter}}, nil)
+	mockAggregator.EXPECT().GetConfigFromBlock(ctx, counted, uint64(200)).
+		Return(&entities.OCR2Config{Transmitters: []common.Address{transmitter, other}}, nil)
+	mockAggregator.EXPECT().GetConfigFromBlock(ctx, counted, uint64(300)).
+		Return(&entities.OCR2Config{Transmitters: []common.Address{other}}, nil)
+
+	// A failed config lookup leaves participation uncounted but the job checked.
+	mockFetcher.EXPECT().
+		FetchByRounds(ctx, uncounted, uint32(1<<8|6), uint32(1<<8|10), interfaces.FetchOptions{MaxBlockRange: watchMaxBlockRange}).
+		Return(window, nil)
+	mockAggregator.EXPECT().GetConfigFromBlock(ctx, uncounted, uint64(100)).
+		Return(nil, fmt.Errorf("execution reverted"))
+
+	result, err := useCase.Execute(ctx, interfaces.WatchTransmittersParams{
+		TransmitterAddress: transmitter,
+		RoundsToCheck:      5,
+		DaysToIgnore:       1,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Statuses, 2)
+
+	// Expected: the three config A reports and two config B reports.
+	// Observed: round 4 and 7 by observation, round 6 by transmitting.
+	assert.Equal(t, entities.JobStatusFound, result.Statuses[0].Status)
+	assert.Equal(t, 5, result.Statuses[0].ExpectedRounds)
+	assert.Equal(t, 3, result.Statuses[0].ObservedRounds)
+	assert.InDelta(t, 0.6, result.Statuses[0].Participation, 1e-9)
+
+	assert.Equal(t, entities.JobStatusFound, result.Statuses[1].Status)
+	assert.Zero(t, result.Statuses[1].ExpectedRounds)
+	assert.Zero(t, result.Statuses[1].ObservedRounds)
+	assert.Zero(t, result.Statuses[1].Participation)
+}
+
+func TestWatchTransmittersUseCase_Reasons(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockJobRepository(ctrl)
+	mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	useCase := NewWatchTransmittersUseCase(mockRepo, mockFetcher, mockAggregator, mockLogger, watchMaxBlockRange)
+	useCase.(*watchTransmittersUseCase).now = func() time.Time { return now }
+	ctx := context.Background()
+	transmitter := helpers.RandomAddress()
+
+	stale := helpers.RandomAddress()
+	missing := helpers.RandomAddress()
+	mockRepo.EXPECT().FindByTransmitter(ctx, transmitter).Return([]entities.Job{
+		{ExternalJobID: "stale", OracleSpec: entities.OracleSpec{ContractAddress: stale}, TransmitterAddress: transmitter, Active: true},
+		{ExternalJobID: "missing", OracleSpec: entities.OracleSpec{ContractAddress: missing}, TransmitterAddress: transmitter, Active: true},
+	}, nil)
+
+	latest := &entities.Round{RoundID: 1<<8 | 10}
+	mockAggregator.EXPECT().GetLatestRound(ctx, stale).Return(latest, nil)
+	mockAggregator.EXPECT().GetLatestRound(ctx, missing).Return(latest, nil)
+
+	mockFetcher.EXPECT().
+		FetchByRounds(ctx, stale, uint32(1<<8|6), uint32(1<<8|10), interfaces.FetchOptions{MaxBlockRange: watchMaxBlockRange}).
+		Return(&entities.TransmissionResult{
+			Transmissions: []entities.Transmission{
+				{Epoch: 1, Round: 8, TransmitterAddress: transmitter, BlockTimestamp: now.Add(-26 * time.Hour)},
+			},
+		}, nil)
+	mockFetcher.EXPECT().
+		FetchByRounds(ctx, missing, uint32(1<<8|6), uint32(1<<8|10), interfaces.FetchOptions{MaxBlockRange: watchMaxBlockRange}).
+		Return(&entities.TransmissionResult{}, nil)
+	mockAggregator.EXPECT().GetConfigFromBlock(ctx, stale, uint64(0)).Return(&entities.OCR2Config{}, nil)
+
+	result, err := useCase.Execute(ctx, interfaces.WatchTransmittersParams{
+		TransmitterAddress: transmitter,
+		RoundsToCheck:      5,
+		DaysToIgnore:       1,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Statuses, 2)
+
+	assert.Equal(t, entities.JobStatusStale, result.Statuses[0].Status)
+	assert.Equal(t, "last tx 26h ago exceeds 24h threshold", result.Statuses[0].Reason)
+
+	assert.Equal(t, entities.JobStatusMissing, result.Statuses[1].Status)
+	assert.Equal(t, "no tx in last 5 rounds (262-266)", result.Statuses[1].Reason)
+}
+
+func TestWatchTransmittersUseCase_ContractErrorPolicy(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		policy      interfaces.ContractErrorPolicy
+		lookups     int
+		wantStatus  entities.JobStatus
+		wantHealthy int
+	}{
+		{name: "default fails the job", policy: "", lookups: 1, wantStatus: entities.JobStatusError},
+		{name: "error fails the job", policy: interfaces.ContractErrorFail, lookups: 1, wantStatus: entities.JobStatusError},
+		{name: "retry recovers the job", policy: interfaces.ContractErrorRetry, lookups: 2, wantStatus: entities.JobStatusFound, wantHealthy: 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockJobRepository(ctrl)
+			mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+			mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			mockLogger.EXPECT().Warn("Retrying contract after error", gomock.Any()).Times(tt.lookups - 1)
+
+			useCase := NewWatchTransmittersUseCase(mockRepo, mockFetcher, mockAggregator, mockLogger, watchMaxBlockRange)
+			useCase.(*watchTransmittersUseCase).retryDelay = time.Millisecond
+			ctx := context.Background()
+			transmitter := helpers.RandomAddress()
+			flaky := helpers.RandomAddress()
+
+			mockRepo.EXPECT().FindByTransmitter(ctx, transmitter).Return([]entities.Job{
+				{ExternalJobID: "flaky", OracleSpec: entities.OracleSpec{ContractAddress: flaky}, TransmitterAddress: transmitter, Active: true},
+			}, nil)
+
+			// The contract's first lookup fails; a second one would succeed.
+			gomock.InOrder(
+				mockAggregator.EXPECT().GetLatestRound(ctx, flaky).Return(nil, fmt.Errorf("429 too many requests")),
+				mockAggregator.EXPECT().GetLatestRound(ctx, flaky).Return(&entities.Round{RoundID: 1<<8 | 10}, nil).
+					Times(tt.lookups-1),
+			)
+			mockFetcher.EXPECT().
+				FetchByRounds(ctx, flaky, uint32(1<<8|6), uint32(1<<8|10), interfaces.FetchOptions{MaxBlockRange: watchMaxBlockRange}).
+				Return(&entities.TransmissionResult{
+					Transmissions: []entities.Transmission{
+						{Epoch: 1, Round: 9, TransmitterAddress: transmitter, BlockTimestamp: time.Now()},
+					},
+				}, nil).
+				Times(tt.lookups - 1)
+			mockAggregator.EXPECT().GetConfigFromBlock(ctx, flaky, uint64(0)).Return(&entities.OCR2Config{}, nil).
+				Times(tt.lookups - 1)
+
+			result, err := useCase.Execute(ctx, interfaces.WatchTransmittersParams{
+				TransmitterAddress: transmitter,
+				RoundsToCheck:      5,
+				DaysToIgnore:       1,
+				ContractErrors:     tt.policy,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Statuses, 1)
+			assert.Equal(t, tt.wantStatus, result.Statuses[0].Status)
+			assert.Equal(t, tt.wantHealthy, result.Summary.FoundJobs)
+		})
+	}
+}
+
+func TestWatchTransmittersUseCase_UnknownContractErrorPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	useCase := NewWatchTransmittersUseCase(mocks.NewMockJobRepository(ctrl), mocks.NewMockTransmissionFetcher(ctrl),
+		mocks.NewMockOCR2AggregatorService(ctrl), mocks.NewMockLogger(ctrl), watchMaxBlockRange)
+	_, err := useCase.Execute(context.Background(), interfaces.WatchTransmittersParams{
+		TransmitterAddress: helpers.RandomAddress(),
+		RoundsToCheck:      5,
+		ContractErrors:     "ignore",
+	})
+
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, validationErr.Fields, "contract_errors")
+}
+
+func TestWatchTransmittersUseCase_ChecksJobsConcurrently(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockJobRepository(ctrl)
+	mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewWatchTransmittersUseCase(mockRepo, mockFetcher, mockAggregator, mockLogger, watchMaxBlockRange)
+	ctx := context.Background()
+	transmitter := helpers.RandomAddress()
+	now := time.Now()
+
+	const jobCount, concurrency = 8, 3
+	jobs := make([]entities.Job, 0, jobCount)
+	for i := 0; i < jobCount; i++ {
+		jobs = append(jobs, entities.Job{
+			ExternalJobID:      fmt.Sprintf("job-%d", i),
+			OracleSpec:         entities.OracleSpec{ContractAddress: helpers.RandomAddress()},
+			TransmitterAddress: transmitter,
+			Active:             true,
+		})
+	}
+	mockRepo.EXPECT().FindByTransmitter(ctx, transmitter).Return(jobs, nil)
+
+	// Each latest-round lookup is held until the pool is full, recording how
+	// many lookups are in flight at once.
+	var (
+		mu       sync.Mutex
+		active   int
+		maxSeen  int
+		poolFull = make(chan struct{})
+	)
+	mockAggregator.EXPECT().GetLatestRound(ctx, gomock.Any()).Times(jobCount).DoAndReturn(
+		func(_ context.Context, _ common.Address) (*entities.Round, error) {
+			mu.Lock()
+			active++
+			if active > maxSeen {
+				maxSeen = active
+			}
+			if maxSeen == concurrency && active == concurrency {
+				select {
+				case <-poolFull:
+				default:
+					close(poolFull)
+				}
+			}
+			mu.Unlock()
+
+			select {
+			case <-poolFull:
+			case <-time.After(5 * time.Second):
+			}
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			active--
+			mu.Unlock()
+			return &entities.Round{RoundID: 1<<8 | 10}, nil
+		})
+	mockFetcher.EXPECT().FetchByRounds(ctx, gomock.Any(), gomock.Any(), gomock.Any(), interfaces.FetchOptions{MaxBlockRange: watchMaxBlockRange}).
+		Times(jobCount).
+		Return(&entities.TransmissionResult{
+			Transmissions: []entities.Transmission{
+				{Epoch: 1, Round: 9, TransmitterAddress: transmitter, BlockTimestamp: now},
+			},
+		}, nil)
+	mockAggregator.EXPECT().GetConfigFromBlock(ctx, gomock.Any(), uint64(0)).
+		Times(jobCount).
+		Return(&entities.OCR2Config{}, nil)
+
+	result, err := useCase.Execute(ctx, interfaces.WatchTransmittersParams{
+		TransmitterAddress: transmitter,
+		RoundsToCheck:      5,
+		DaysToIgnore:       1,
+		Concurrency:        concurrency,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, concurrency, maxSeen)
+
+	// Statuses are complete and keep the job order.
+	require.Len(t, result.Statuses, jobCount)
+	for i, status := range result.Statuses {
+		assert.Equal(t, jobs[i].ExternalJobID, status.JobID)
+		assert.Equal(t, jobs[i].OracleSpec.ContractAddress, status.ContractAddress)
+		assert.Equal(t, entities.JobStatusFound, status.Status)
+	}
+	assert.Equal(t, jobCount, result.Summary.FoundJobs)
+}
+
+func TestWatchTransmittersUseCase_ExecuteManySharesContractFetch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockJobRepository(ctrl)
+	mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewWatchTransmittersUseCase(mockRepo, mockFetcher, mockAggregator, mockLogger, watchMaxBlockRange)
+	ctx := context.Background()
+	contract := helpers.RandomAddress()
+	active := helpers.RandomAddress()
+	silent := helpers.RandomAddress()
+
+	for i, transmitter := range []common.Address{active, silent} {
+		mockRepo.EXPECT().FindByTransmitter(ctx, transmitter).Return([]entities.Job{{
+			ExternalJobID:      fmt.Sprintf("job-%d", i),
+			OracleSpec:         entities.OracleSpec{ContractAddress: contract},
+			TransmitterAddress: transmitter,
+			Active:             true,
+		}}, nil)
+	}
+
+	// The shared contract is looked up and fetched exactly once.
+	mockAggregator.EXPECT().GetLatestRound(ctx, contract).Return(&entities.Round{RoundID: 1<<8 | 10}, nil).Times(1)
+	mockFetcher.EXPECT().
+		FetchByRounds(ctx, contract, uint32(1<<8|6), uint32(1<<8|10), interfaces.FetchOptions{MaxBlockRange: watchMaxBlockRange}).
+		Return(&entities.TransmissionResult{
+			Transmissions: []entities.Transmission{
+				{Epoch: 1, Round: 9, TransmitterAddress: active, BlockTimestamp: time.Now()},
+			},
+		}, nil).
+		Times(1)
+	mockAggregator.EXPECT().GetConfigFromBlock(ctx, contract, uint64(0)).Return(&entities.OCR2Config{}, nil).Times(1)
+
+	results, err := useCase.ExecuteMany(ctx, interfaces.WatchManyTransmittersParams{
+		TransmitterAddresses: []common.Address{active, silent},
+		RoundsToCheck:        5,
+		DaysToIgnore:         1,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.Equal(t, active, results[0].TransmitterAddress)
+	require.NoError(t, results[0].Err)
+	require.Len(t, results[0].Result.Statuses, 1)
+	assert.Equal(t, entities.JobStatusFound, results[0].Result.Statuses[0].Status)
+	assert.Equal(t, 1, results[0].Result.Summary.FoundJobs)
+
+	assert.Equal(t, silent, results[1].TransmitterAddress)
+	require.NoError(t, results[1].Err)
+	require.Len(t, results[1].Result.Statuses, 1)
+	assert.Equal(t, entities.JobStatusMissing, results[1].Result.Statuses[0].Status)
+	assert.Equal(t, 1, results[1].Result.Summary.MissingJobs)
+}
+
+func TestWatchTransmittersUseCase_MaxBlockRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockJobRepository(ctrl)
+	mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewWatchTransmittersUseCase(mockRepo, mockFetcher, mockAggregator, mockLogger, 5000)
+	ctx := context.Background()
+	transmitter := helpers.RandomAddress()
+	contract := helpers.RandomAddress()
+
+	mockRepo.EXPECT().FindByTransmitter(ctx, transmitter).Return([]entities.Job{
+		{ExternalJobID: "job", OracleSpec: entities.OracleSpec{ContractAddress: contract}, TransmitterAddress: transmitter, Active: true},
+	}, nil)
+	mockAggregator.EXPECT().GetLatestRound(ctx, contract).Return(&entities.Round{RoundID: 1<<8 | 10}, nil)
+
+	// The configured bound reaches the fetcher, which refuses rounds older than it.
+	mockFetcher.EXPECT().
+		FetchByRounds(ctx, contract, uint32(1<<8|6), uint32(1<<8|10), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context, _ common.Address, _, _ uint32, opts interfaces.FetchOptions,
+		) (*entities.TransmissionResult, error) {
+			assert.Equal(t, uint64(5000), opts.MaxBlockRange)
+			return nil, errors.NewDomainError(errors.ErrInvalidInput, "round 262 is not within the last 5000 blocks")
+		})
+
+	result, err := useCase.Execute(ctx, interfaces.WatchTransmittersParams{
+		TransmitterAddress: transmitter,
+		RoundsToCheck:      5,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Statuses, 1)
+	assert.Equal(t, entities.JobStatusError, result.Statuses[0].Status)
+	assert.Contains(t, result.Statuses[0].Error.Error(), "not within the last 5000 blocks")
+}
+
+func TestWatchTransmittersUseCase_ExecuteAllInBatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockJobRepository(ctrl)
+	mockFetcher := mocks.NewMockTransmissionFetcher(ctrl)
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewWatchTransmittersUseCase(mockRepo, mockFetcher, mockAggregator, mockLogger, watchMaxBlockRange)
+	ctx := context.Background()
+	shared := helpers.RandomAddress()
+	other := helpers.RandomAddress()
+	first := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	second := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	job := func(id string, contract, transmitter common.Address) entities.Job {
+		return entities.Job{
+			ExternalJobID:      id,
+			OracleSpec:         entities.OracleSpec{ContractAddress: contract},
+			TransmitterAddress: transmitter,
+			Active:             true,
+		}
+	}
+	batches := [][]entities.Job{
+		{job("job-1", shared, second), job("job-2", shared, first)},
+		{job("job-3", other, second)},
+	}
+	mockRepo.EXPECT().FindActiveJobsInBatches(ctx, 2, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int, fn func([]entities.Job) error) error {
+			for _, batch := range batches {
+				if err := fn(batch); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+	// Each batch fetches its contracts once, however many jobs share them.
+	for _, contract := range []common.Address{shared, other} {
+		mockAggregator.EXPECT().GetLatestRound(ctx, contract).Return(&entities.Round{RoundID: 1<<8 | 10}, nil).Times(1)
+		mockFetcher.EXPECT().
+			FetchByRounds(ctx, contract, uint32(1<<8|6), uint32(1<<8|10), interfaces.FetchOptions{MaxBlockRange: watchMaxBlockRange}).
+			Return(&entities.TransmissionResult{
+				Transmissions: []entities.Transmission{
+					{Epoch: 1, Round: 9, TransmitterAddress: second, BlockTimestamp: time.Now()},
+				},
+			}, nil).
+			Times(1)
+		mockAggregator.EXPECT().GetConfigFromBlock(ctx, contract, uint64(0)).Return(&entities.OCR2Config{}, nil).Times(1)
+	}
+
+	results, err := useCase.ExecuteAll(ctx, interfaces.WatchAllTransmittersParams{
+		RoundsToCheck: 5,
+		DaysToIgnore:  1,
+		BatchSize:     2,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.Equal(t, first, results[0].TransmitterAddress)
+	require.Len(t, results[0].Result.Statuses, 1)
+	assert.Equal(t, entities.JobStatusMissing, results[0].Result.Statuses[0].Status)
+
+	assert.Equal(t, second, results[1].TransmitterAddress)
+	require.Len(t, results[1].Result.Statuses, 2)
+	assert.Equal(t, "job-1", results[1].Result.Statuses[0].JobID)
+	assert.Equal(t, "job-3", results[1].Result.Statuses[1].JobID)
+	assert.Equal(t, 2, results[1].Result.Summary.FoundJobs)
+}
+
+func TestWatchTransmittersUseCase_ExecuteAllInvalidBatchSize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	useCase := NewWatchTransmittersUseCase(
+		mocks.NewMockJobRepository(ctrl),
+		mocks.NewMockTransmissionFetcher(ctrl),
+		mocks.NewMockOCR2AggregatorService(ctrl),
+		mocks.NewMockLogger(ctrl),
+		watchMaxBlockRange,
+	)
+
+	_, err := useCase.ExecuteAll(context.Background(), interfaces.WatchAllTransmittersParams{
+		RoundsToCheck: 5,
+		BatchSize:     -1,
+	})
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, validationErr.Fields, "batch_size")
+}
+
+func TestWatchTransmittersUseCase_ContractPanic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockJobRepository(ctrl)
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewWatchTransmittersUseCase(mockRepo, mocks.NewMockTransmissionFetcher(ctrl), mockAggregator, mockLogger, watchMaxBlockRange)
+	ctx := context.Background()
+	transmitter := helpers.RandomAddress()
+	contract := helpers.RandomAddress()
+
+	mockRepo.EXPECT().FindByTransmitter(ctx, transmitter).Return([]entities.Job{
+		{ExternalJobID: "job", OracleSpec: entities.OracleSpec{ContractAddress: contract}, TransmitterAddress: transmitter, Active: true},
+	}, nil)
+	// A nil round without an error dereferences nil in the contract's worker.
+	mockAggregator.EXPECT().GetLatestRound(ctx, contract).Return(nil, nil)
+
+	// The panic reaches the caller, where a monitor can recover it.
+	var recovered interface{}
+	func() {
+		defer func() { recovered = recover() }()
+		_, _ = useCase.Execute(ctx, interfaces.WatchTransmittersParams{
+			TransmitterAddress: transmitter,
+			RoundsToCheck:      5,
+		})
+	}()
+	require.NotNil(t, recovered)
+	assert.Contains(t, fmt.Sprint(recovered), contract.Hex())
+	assert.Contains(t, fmt.Sprint(recovered), "nil pointer dereference")
+	assert.Contains(t, fmt.Sprint(recovered), "fetchWindow")
+}
@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+// defaultArchiveState is the state file used without --resume.
+const defaultArchiveState = "results/archive-%s.state.json"
+
+// NewArchiveCommand creates the archive command.
+func NewArchiveCommand(container *config.Container) *cobra.Command {
+	var (
+		fromBlock    uint64
+		outputPath   string
+		statePath    string
+		rps          float64
+		chunkSize    uint64
+		noTimestamps bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "archive [contract]",
+		Short: "Archive a contract's full transmission history",
+		Long: `Archives every transmission of a contract from --from-block (ideally the
+deployment block) to head into a gzipped JSON-lines file, one chunk of blocks
+at a time. Head trails the chain by the configured confirmations.
+
+After every chunk the progress is checkpointed to the --resume state file, so an
+interrupted archive can be restarted with the same command: it picks up after
+the last complete chunk, dropping any partly written one. Running it again
+once complete extends the archive to the new head.
+
+--rps caps RPC requests per second for the archive, like --rpc-rps.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse arguments.
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+			contractAddr := common.HexToAddress(args[0])
+
+			if cmd.Flags().Changed("rps") {
+				if rps < 0 {
+					return fmt.Errorf("--rps must not be negative")
+				}
+				if container.RPCLimiter != nil {
+					container.RPCLimiter.SetRate(rps)
+				}
+			}
+
+			if statePath == "" {
+				statePath = fmt.Sprintf(defaultArchiveState, contractAddr.Hex())
+			}
+
+			// Stop between chunks on interrupt; the checkpoint stays valid.
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			// Execute use case.
+			result, err := container.ArchiveTransmissionsUseCase.Execute(ctx, interfaces.ArchiveTransmissionsParams{
+				ContractAddress: contractAddr,
+				FromBlock:       fromBlock,
+				OutputPath:      outputPath,
+				StatePath:       statePath,
+				SkipTimestamps:  noTimestamps,
+				ChunkSize:       chunkSize,
+			})
+			if result != nil {
+				displayArchiveText(cmd, result, statePath)
+			}
+			if errors.Is(err, context.Canceled) {
+				return fmt.Errorf("archive interrupted; rerun to resume from %s", statePath)
+			}
+			if err != nil {
+				reportValidationErrors(cmd, err, OutputFormatText)
+				return fmt.Errorf("failed to archive transmissions: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	// Add flags.
+	cmd.Flags().Uint64Var(&fromBlock, "from-block", 0,
+		"First block to archive, ideally the contract's deployment block (ignored when resuming)")
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "",
+		"Archive file, gzip-compressed when ending in .gz (default \"results/archive-{contract}.jsonl.gz\")")
+	cmd.Flags().StringVar(&statePath, "resume", "",
+		"Checkpoint file to resume from and update (default \"results/archive-{contract}.state.json\")")
+	cmd.Flags().Float64Var(&rps, "rps", 0, "Maximum RPC requests per second while archiving (0 for unlimited)")
+	cmd.Flags().Uint64Var(&chunkSize, "chunk-size", 0, "Blocks fetched and checkpointed at once (default 10000)")
+	cmd.Flags().BoolVar(&noTimestamps, "no-timestamps", false,
+		"Skip block timestamp lookups for faster archives (time-based grouping won't work on the output)")
+
+	return cmd
+}
+
+// displayArchiveText prints a summary of an archive run.
+func displayArchiveText(cmd *cobra.Command, result *interfaces.ArchiveTransmissionsResult, statePath string) {
+	out := cmd.OutOrStdout()
+	_, _ = fmt.Fprintf(out, "Contract: %s\n", result.ContractAddress.Hex())
+	if result.Resumed {
+		_, _ = fmt.Fprintf(out, "Resumed at block %d\n", result.ResumeBlock)
+	}
+	_, _ = fmt.Fprintf(out, "Blocks: %d - %d\n", result.StartBlock, result.EndBlock)
+	_, _ = fmt.Fprintf(out, "Chunks fetched: %d\n", result.ChunksFetched)
+	_, _ = fmt.Fprintf(out, "Transmissions archived: %d\n", result.Transmissions)
+	_, _ = fmt.Fprintf(out, "Archive: %s\n", result.OutputPath)
+	_, _ = fmt.Fprintf(out, "State: %s\n", statePath)
+}
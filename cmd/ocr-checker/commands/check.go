@@ -0,0 +1,205 @@
+// Package commands provides CLI command implementations for the OCR checker tool.
+// It contains the fetch, parse, watch, and version commands with their associated flags and handlers.
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+// checkTargetResult is the outcome of checking a single transmitter.
+type checkTargetResult struct {
+	Transmitter common.Address                 `json:"transmitter"`
+	Status      string                         `json:"status"`
+	Summary     *interfaces.TransmitterSummary `json:"summary,omitempty"`
+	Error       string                         `json:"error,omitempty"`
+
+	health entities.HealthStatus
+}
+
+// checkOutput is the JSON form of a check run.
+type checkOutput struct {
+	Overall  string              `json:"overall"`
+	ExitCode int                 `json:"exitCode"`
+	Targets  []checkTargetResult `json:"targets"`
+}
+
+// NewCheckCommand creates the check command.
+func NewCheckCommand(container *config.Container) *cobra.Command {
+	var (
+		transmitters []string
+		all          bool
+		batchSize    int
+		outputFormat string
+		daysToIgnore int
+		concurrency  int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "check (--transmitter <address> [--transmitter <address> ...] | --all) [rounds_to_check] [days_to_ignore]",
+		Short: "Check several transmitters once and exit with the overall status",
+		Long: `Runs the watch check for every --transmitter and rolls the per-target
+statuses up into a single worst-case status. The exit code reflects it:
+0 = OK, 1 = WARNING (stale jobs), 2 = CRITICAL (missing or errored jobs,
+or a target whose check failed), 3 = UNKNOWN (the check could not run).
+
+With --all every transmitter with active jobs on the node is checked. Active
+jobs are then loaded --batch-size at a time, and each batch is checked before
+the next one is loaded.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Status exits are expected results, not usage mistakes.
+			cmd.SilenceUsage = true
+
+			if container.WatchTransmittersUseCase == nil {
+				return &ExitError{Code: exitCodeUnknown, Err: fmt.Errorf("database configuration required for check command")}
+			}
+
+			if all && len(transmitters) > 0 {
+				return &ExitError{Code: exitCodeUnknown, Err: fmt.Errorf("--all cannot be combined with --transmitter")}
+			}
+			if !all && len(transmitters) == 0 {
+				return &ExitError{Code: exitCodeUnknown, Err: fmt.Errorf("at least one --transmitter (or --all) is required")}
+			}
+
+			addresses := make([]common.Address, 0, len(transmitters))
+			for _, transmitter := range transmitters {
+				if !common.IsHexAddress(transmitter) {
+					return &ExitError{Code: exitCodeUnknown, Err: fmt.Errorf("invalid transmitter address: %s", transmitter)}
+				}
+				addresses = append(addresses, common.HexToAddress(transmitter))
+			}
+
+			roundsToCheck, err := parseInt(args[0])
+			if err != nil {
+				return &ExitError{Code: exitCodeUnknown, Err: fmt.Errorf("invalid rounds to check: %w", err)}
+			}
+
+			// Days to ignore is optional.
+			if len(args) > 1 {
+				daysToIgnore, err = parseInt(args[1])
+				if err != nil {
+					return &ExitError{Code: exitCodeUnknown, Err: fmt.Errorf("invalid days to ignore: %w", err)}
+				}
+			}
+
+			ctx := context.Background()
+
+			var watchResults []interfaces.TransmitterWatchResult
+			if all {
+				watchResults, err = container.WatchTransmittersUseCase.ExecuteAll(ctx, interfaces.WatchAllTransmittersParams{
+					RoundsToCheck: roundsToCheck,
+					DaysToIgnore:  daysToIgnore,
+					BatchSize:     batchSize,
+					Concurrency:   concurrency,
+				})
+			} else {
+				// Watch all targets together so contracts they share are fetched once.
+				watchResults, err = container.WatchTransmittersUseCase.ExecuteMany(ctx, interfaces.WatchManyTransmittersParams{
+					TransmitterAddresses: addresses,
+					RoundsToCheck:        roundsToCheck,
+					DaysToIgnore:         daysToIgnore,
+					Concurrency:          concurrency,
+				})
+			}
+			if err != nil {
+				reportValidationErrors(cmd, err, outputFormat)
+				return &ExitError{Code: exitCodeUnknown, Err: fmt.Errorf("failed to check transmitters: %w", err)}
+			}
+
+			results := make([]checkTargetResult, 0, len(watchResults))
+			statuses := make([]entities.HealthStatus, 0, len(watchResults))
+			for _, watchResult := range watchResults {
+				target := checkTargetResult{Transmitter: watchResult.TransmitterAddress}
+				if watchResult.Err != nil {
+					target.health = entities.HealthStatusCritical
+					target.Error = watchResult.Err.Error()
+				} else {
+					target.health = watchResult.Result.Summary.HealthStatus()
+					target.Summary = &watchResult.Result.Summary
+				}
+				target.Status = target.health.String()
+
+				results = append(results, target)
+				statuses = append(statuses, target.health)
+			}
+
+			overall := entities.WorstHealthStatus(statuses...)
+
+			if outputFormat == OutputFormatJSON {
+				err = displayCheckResultsJSON(cmd.OutOrStdout(), results, overall)
+			} else {
+				err = displayCheckResultsTable(cmd.OutOrStdout(), results, overall)
+			}
+			if err != nil {
+				return &ExitError{Code: exitCodeUnknown, Err: err}
+			}
+
+			if overall != entities.HealthStatusOK {
+				return &ExitError{Code: overall.ExitCode(), Err: fmt.Errorf("overall status %s", overall)}
+			}
+
+			return nil
+		},
+	}
+
+	// Add flags.
+	cmd.Flags().StringSliceVarP(&transmitters, "transmitter", "t", nil, "Transmitter address to check (repeatable)")
+	cmd.Flags().BoolVar(&all, "all", false, "Check every transmitter with active jobs")
+	cmd.Flags().IntVar(&batchSize, "batch-size", interfaces.DefaultJobBatchSize, "Number of active jobs loaded at a time with --all")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "Output format (table, json)")
+	cmd.Flags().IntVarP(&daysToIgnore, "days", "d", 0, "Days to ignore for stale detection")
+	cmd.Flags().IntVar(&concurrency, "concurrency", interfaces.DefaultWatchConcurrency, "Number of jobs checked at once")
+
+	return cmd
+}
+
+// displayCheckResultsTable displays check results in table format.
+func displayCheckResultsTable(out io.Writer, results []checkTargetResult, overall entities.HealthStatus) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "Transmitter\tStatus\tTotal\tFound\tStale\tMissing\tError")
+	_, _ = fmt.Fprintln(w, "-----------\t------\t-----\t-----\t-----\t-------\t-----")
+
+	for _, result := range results {
+		if result.Summary == nil {
+			_, _ = fmt.Fprintf(w, "%s\t%s (%s)\t-\t-\t-\t-\t-\n", result.Transmitter.Hex(), result.Status, result.Error)
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\n",
+			result.Transmitter.Hex(),
+			result.Status,
+			result.Summary.TotalJobs,
+			result.Summary.FoundJobs,
+			result.Summary.StaleJobs,
+			result.Summary.MissingJobs,
+			result.Summary.ErrorJobs,
+		)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(out, "\nOverall: %s\n", overall)
+	return nil
+}
+
+// displayCheckResultsJSON displays check results in JSON format.
+func displayCheckResultsJSON(out io.Writer, results []checkTargetResult, overall entities.HealthStatus) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(checkOutput{
+		Overall:  overall.String(),
+		ExitCode: overall.ExitCode(),
+		Targets:  results,
+	})
+}
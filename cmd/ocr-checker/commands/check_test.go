@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	stderrors "errors"
+	"testing"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCommand_OverallStatus(t *testing.T) {
+	healthy := common.HexToAddress("0xa000000000000000000000000000000000000000")
+	stale := common.HexToAddress("0xb000000000000000000000000000000000000000")
+	missing := common.HexToAddress("0xc000000000000000000000000000000000000000")
+
+	summaries := map[common.Address]interfaces.TransmitterSummary{
+		healthy: {TotalJobs: 2, FoundJobs: 2},
+		stale:   {TotalJobs: 2, FoundJobs: 1, StaleJobs: 1},
+		missing: {TotalJobs: 2, FoundJobs: 1, MissingJobs: 1},
+	}
+
+	run := func(t *testing.T, args ...string) (*bytes.Buffer, error) {
+		ctrl := gomock.NewController(t)
+		useCase := mocks.NewMockWatchTransmittersUseCase(ctrl)
+		useCase.EXPECT().ExecuteMany(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, params interfaces.WatchManyTransmittersParams) ([]interfaces.TransmitterWatchResult, error) {
+				results := make([]interfaces.TransmitterWatchResult, 0, len(params.TransmitterAddresses))
+				for _, address := range params.TransmitterAddresses {
+					result := interfaces.TransmitterWatchResult{TransmitterAddress: address}
+					if summary, ok := summaries[address]; ok {
+						result.Result = &interfaces.WatchTransmittersResult{Summary: summary}
+					} else {
+						result.Err = stderrors.New("rpc unavailable")
+					}
+					results = append(results, result)
+				}
+				return results, nil
+			}).AnyTimes()
+
+		cmd := NewCheckCommand(&config.Container{
+			Config:                   &config.Config{},
+			WatchTransmittersUseCase: useCase,
+		})
+		var stdout bytes.Buffer
+		cmd.SetOut(&stdout)
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(args)
+		return &stdout, cmd.Execute()
+	}
+
+	exitCode := func(err error) int {
+		if err == nil {
+			return 0
+		}
+		var exitErr *ExitError
+		if stderrors.As(err, &exitErr) {
+			return exitErr.Code
+		}
+		return 1
+	}
+
+	t.Run("all healthy", func(t *testing.T) {
+		stdout, err := run(t, "-t", healthy.Hex(), "10")
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "Overall: OK")
+	})
+
+	t.Run("stale is warning", func(t *testing.T) {
+		_, err := run(t, "-t", healthy.Hex(), "-t", stale.Hex(), "10")
+		assert.Equal(t, 1, exitCode(err))
+	})
+
+	t.Run("any critical is critical", func(t *testing.T) {
+		stdout, err := run(t, "-o", "json", "-t", healthy.Hex(), "-t", missing.Hex(), "-t", stale.Hex(), "10")
+		assert.Equal(t, 2, exitCode(err))
+
+		var decoded checkOutput
+		require.NoError(t, json.NewDecoder(stdout).Decode(&decoded))
+		assert.Equal(t, "CRITICAL", decoded.Overall)
+		assert.Equal(t, 2, decoded.ExitCode)
+		require.Len(t, decoded.Targets, 3)
+		assert.Equal(t, "OK", decoded.Targets[0].Status)
+		assert.Equal(t, "CRITICAL", decoded.Targets[1].Status)
+		assert.Equal(t, "WARNING", decoded.Targets[2].Status)
+	})
+
+	t.Run("failed target is critical", func(t *testing.T) {
+		unknown := common.HexToAddress("0xd000000000000000000000000000000000000000")
+		stdout, err := run(t, "-t", healthy.Hex(), "-t", unknown.Hex(), "10")
+		assert.Equal(t, 2, exitCode(err))
+		assert.Contains(t, stdout.String(), "CRITICAL (rpc unavailable)")
+	})
+
+	t.Run("invalid input is unknown", func(t *testing.T) {
+		_, err := run(t, "-t", "not-an-address", "10")
+		assert.Equal(t, exitCodeUnknown, exitCode(err))
+	})
+}
+
+func TestCheckCommand_All(t *testing.T) {
+	run := func(t *testing.T, args ...string) (*bytes.Buffer, error) {
+		ctrl := gomock.NewController(t)
+		useCase := mocks.NewMockWatchTransmittersUseCase(ctrl)
+		useCase.EXPECT().ExecuteAll(gomock.Any(), interfaces.WatchAllTransmittersParams{
+			RoundsToCheck: 10,
+			BatchSize:     100,
+			Concurrency:   interfaces.DefaultWatchConcurrency,
+		}).Return([]interfaces.TransmitterWatchResult{
+			{
+				TransmitterAddress: common.HexToAddress("0xa000000000000000000000000000000000000000"),
+				Result: &interfaces.WatchTransmittersResult{
+					Summary: interfaces.TransmitterSummary{TotalJobs: 1, FoundJobs: 1},
+				},
+			},
+		}, nil).AnyTimes()
+
+		cmd := NewCheckCommand(&config.Container{
+			Config:                   &config.Config{},
+			WatchTransmittersUseCase: useCase,
+		})
+		var stdout bytes.Buffer
+		cmd.SetOut(&stdout)
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(args)
+		return &stdout, cmd.Execute()
+	}
+
+	t.Run("checks every transmitter in batches", func(t *testing.T) {
+		stdout, err := run(t, "--all", "--batch-size", "100", "10")
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "0xa000000000000000000000000000000000000000")
+		assert.Contains(t, stdout.String(), "Overall: OK")
+	})
+
+	t.Run("rejects --all with --transmitter", func(t *testing.T) {
+		_, err := run(t, "--all", "-t", "0xa000000000000000000000000000000000000000", "10")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--all cannot be combined with --transmitter")
+	})
+}
@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+// exitCodeConfigDrift is returned by config-diff when the config differs from the expected one.
+const exitCodeConfigDrift = 1
+
+// configDiffOutput is the JSON shape of the config-diff command.
+type configDiffOutput struct {
+	Contract            common.Address   `json:"contract"`
+	BlockNumber         uint64           `json:"block_number"`
+	ConfigDigest        string           `json:"config_digest"`
+	Drift               bool             `json:"drift"`
+	AddedTransmitters   []common.Address `json:"added_transmitters"`
+	RemovedTransmitters []common.Address `json:"removed_transmitters"`
+	AddedSigners        []common.Address `json:"added_signers"`
+	RemovedSigners      []common.Address `json:"removed_signers"`
+	ExpectedF           *uint8           `json:"expected_f,omitempty"`
+	ActualF             uint8            `json:"actual_f"`
+}
+
+// NewConfigDiffCommand creates the config-diff command.
+func NewConfigDiffCommand(container *config.Container) *cobra.Command {
+	var outputFormat string
+
+	cmd := &cobra.Command{
+		Use:   "config-diff [contract] [expected_file]",
+		Short: "Compare a contract's current config with an expected config file",
+		Long: `Reads the current OCR2 configuration of a contract and compares it with an
+expected configuration kept in a YAML file:
+
+  transmitters: [0x..., 0x...]
+  signers: [0x..., 0x...]
+  f: 1
+
+Transmitters and signers that were added or removed on chain are reported, as
+is a change of F. A list or f left out of the file is not compared. The exit code is 0 without drift, 1 on drift,
+and 3 when the comparison could not run.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Drift is reported through the exit code, not as a usage error.
+			cmd.SilenceUsage = true
+
+			// Parse arguments.
+			if !common.IsHexAddress(args[0]) {
+				return &ExitError{Code: exitCodeUnknown, Err: fmt.Errorf("invalid contract address: %s", args[0])}
+			}
+
+			if outputFormat != OutputFormatText && outputFormat != OutputFormatJSON {
+				return &ExitError{
+					Code: exitCodeUnknown,
+					Err:  fmt.Errorf("invalid output format: %s (expected text or json)", outputFormat),
+				}
+			}
+
+			expected, err := services.ReadExpectedConfig(args[1])
+			if err != nil {
+				return &ExitError{Code: exitCodeUnknown, Err: err}
+			}
+
+			// Execute use case.
+			result, err := container.ConfigDiffUseCase.Execute(context.Background(), interfaces.ConfigDiffParams{
+				ContractAddress: common.HexToAddress(args[0]),
+				Expected:        *expected,
+			})
+			if err != nil {
+				reportValidationErrors(cmd, err, outputFormat)
+				return &ExitError{Code: exitCodeUnknown, Err: fmt.Errorf("failed to diff config: %w", err)}
+			}
+
+			if outputFormat == OutputFormatJSON {
+				err = displayConfigDiffJSON(cmd.OutOrStdout(), result)
+			} else {
+				displayConfigDiffText(cmd.OutOrStdout(), result)
+			}
+			if err != nil {
+				return &ExitError{Code: exitCodeUnknown, Err: err}
+			}
+
+			if result.HasDrift() {
+				return &ExitError{Code: exitCodeConfigDrift, Err: fmt.Errorf("config drift detected")}
+			}
+
+			return nil
+		},
+	}
+
+	// Add flags.
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", OutputFormatText, "Output format (text, json)")
+
+	return cmd
+}
+
+// displayConfigDiffText displays the config diff in text format.
+func displayConfigDiffText(out io.Writer, result *interfaces.ConfigDiffResult) {
+	_, _ = fmt.Fprintf(out, "Contract: %s\n", result.ContractAddress.Hex())
+	_, _ = fmt.Fprintf(out, "Config digest: %x (block %d)\n",
+		result.ConfigDigest, result.BlockNumber)
+
+	printAddresses := func(label string, addresses []common.Address) {
+		for _, address := range addresses {
+			_, _ = fmt.Fprintf(out, "  %s %s\n", label, address.Hex())
+		}
+	}
+	if len(result.AddedTransmitters) > 0 || len(result.RemovedTransmitters) > 0 {
+		_, _ = fmt.Fprintf(out, "Transmitters:\n")
+		printAddresses("+", result.AddedTransmitters)
+		printAddresses("-", result.RemovedTransmitters)
+	}
+	if len(result.AddedSigners) > 0 || len(result.RemovedSigners) > 0 {
+		_, _ = fmt.Fprintf(out, "Signers:\n")
+		printAddresses("+", result.AddedSigners)
+		printAddresses("-", result.RemovedSigners)
+	}
+	if result.FChanged() {
+		_, _ = fmt.Fprintf(out, "F: expected %d, actual %d\n", *result.ExpectedF, result.ActualF)
+	}
+
+	if result.HasDrift() {
+		_, _ = fmt.Fprintf(out, "Drift detected (+ on chain only, - expected only)\n")
+	} else {
+		_, _ = fmt.Fprintf(out, "No drift\n")
+	}
+}
+
+// displayConfigDiffJSON displays the config diff in JSON format.
+func displayConfigDiffJSON(out io.Writer, result *interfaces.ConfigDiffResult) error {
+	nonNil := func(addresses []common.Address) []common.Address {
+		if addresses == nil {
+			return []common.Address{}
+		}
+		return addresses
+	}
+
+	output := configDiffOutput{
+		Contract:            result.ContractAddress,
+		BlockNumber:         result.BlockNumber,
+		ConfigDigest:        fmt.Sprintf("%x", result.ConfigDigest),
+		Drift:               result.HasDrift(),
+		AddedTransmitters:   nonNil(result.AddedTransmitters),
+		RemovedTransmitters: nonNil(result.RemovedTransmitters),
+		AddedSigners:        nonNil(result.AddedSigners),
+		RemovedSigners:      nonNil(result.RemovedSigners),
+		ExpectedF:           result.ExpectedF,
+		ActualF:             result.ActualF,
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	stderrors "errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"chainlink-ocr-checker/application/usecases"
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/infrastructure/config"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigDiffCommand(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	address := func(b byte) common.Address { return common.BytesToAddress([]byte{b}) }
+
+	// On chain, transmitter 3 replaced transmitter 4 and F was raised to 2.
+	current := &entities.ConfigSetEvent{
+		BlockNumber: 1234,
+		Config: entities.OCR2Config{
+			ConfigDigest: [32]byte{0xab},
+			Transmitters: []common.Address{address(1), address(2), address(3)},
+			Signers:      []common.Address{address(0x11), address(0x12), address(0x13)},
+			Threshold:    2,
+		},
+	}
+
+	run := func(t *testing.T, expectedFile string, args ...string) (*bytes.Buffer, error) {
+		ctrl := gomock.NewController(t)
+		aggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+		aggregator.EXPECT().GetLatestConfigSet(gomock.Any(), contract).Return(current, nil)
+		logger := mocks.NewMockLogger(ctrl)
+		logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+		path := filepath.Join(t.TempDir(), "expected.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(expectedFile), 0o600))
+
+		cmd := NewConfigDiffCommand(&config.Container{
+			ConfigDiffUseCase: usecases.NewConfigDiffUseCase(aggregator, logger),
+		})
+		var stdout bytes.Buffer
+		cmd.SetOut(&stdout)
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(append(args, contract.Hex(), path))
+		return &stdout, cmd.Execute()
+	}
+
+	t.Run("drift", func(t *testing.T) {
+		stdout, err := run(t, `
+transmitters:
+  - 0x0000000000000000000000000000000000000001
+  - 0x0000000000000000000000000000000000000002
+  - 0x0000000000000000000000000000000000000004
+signers:
+  - 0x0000000000000000000000000000000000000011
+  - 0x0000000000000000000000000000000000000012
+  - 0x0000000000000000000000000000000000000013
+f: 1
+`, "-o", "json")
+
+		var exitErr *ExitError
+		require.True(t, stderrors.As(err, &exitErr))
+		assert.Equal(t, exitCodeConfigDrift, exitErr.Code)
+
+		var output configDiffOutput
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &output))
+		assert.True(t, output.Drift)
+		assert.Equal(t, []common.Address{address(3)}, output.AddedTransmitters)
+		assert.Equal(t, []common.Address{address(4)}, output.RemovedTransmitters)
+		assert.Empty(t, output.AddedSigners)
+		assert.Empty(t, output.RemovedSigners)
+		require.NotNil(t, output.ExpectedF)
+		assert.Equal(t, uint8(1), *output.ExpectedF)
+		assert.Equal(t, uint8(2), output.ActualF)
+		assert.Equal(t, uint64(1234), output.BlockNumber)
+	})
+
+	t.Run("text drift", func(t *testing.T) {
+		stdout, err := run(t, `
+transmitters: [0x0000000000000000000000000000000000000001, 0x0000000000000000000000000000000000000002]
+f: 1
+`)
+		require.Error(t, err)
+		assert.Contains(t, stdout.String(), "  + "+address(3).Hex()+"\n")
+		assert.Contains(t, stdout.String(), "F: expected 1, actual 2\n")
+		assert.Contains(t, stdout.String(), "Drift detected")
+	})
+
+	t.Run("no drift", func(t *testing.T) {
+		stdout, err := run(t, `
+transmitters:
+  - 0x0000000000000000000000000000000000000003
+  - 0x0000000000000000000000000000000000000002
+  - 0x0000000000000000000000000000000000000001
+f: 2
+`)
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "No drift\n")
+	})
+}
+
+func TestConfigDiffCommand_InvalidExpectedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "expected.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("transmitters: [not-an-address]\n"), 0o600))
+
+	cmd := NewConfigDiffCommand(&config.Container{})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"0x1000000000000000000000000000000000000001", path})
+
+	err := cmd.Execute()
+	var exitErr *ExitError
+	require.True(t, stderrors.As(err, &exitErr))
+	assert.Equal(t, exitCodeUnknown, exitErr.Code)
+	assert.Contains(t, err.Error(), "invalid address in transmitters: not-an-address")
+}
@@ -0,0 +1,227 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+// exitCodeIndexDrift is returned by configs when the tracked transmitter's index changed.
+const exitCodeIndexDrift = 1
+
+// configOutput is the JSON shape of a single ConfigSet event.
+type configOutput struct {
+	BlockNumber  uint64           `json:"block_number"`
+	ConfigCount  uint64           `json:"config_count"`
+	ConfigDigest string           `json:"config_digest"`
+	F            uint8            `json:"f"`
+	Transmitters []common.Address `json:"transmitters"`
+	Signers      []common.Address `json:"signers"`
+}
+
+// indexChangeOutput is the JSON shape of a tracked transmitter's index change.
+// A null index means the transmitter was not in that config.
+type indexChangeOutput struct {
+	BlockNumber  uint64 `json:"block_number"`
+	ConfigCount  uint64 `json:"config_count"`
+	ConfigDigest string `json:"config_digest"`
+	OldIndex     *uint8 `json:"old_index"`
+	NewIndex     *uint8 `json:"new_index"`
+}
+
+// configsOutput is the JSON shape of the configs command.
+type configsOutput struct {
+	Contract     common.Address      `json:"contract"`
+	StartBlock   uint64              `json:"start_block"`
+	EndBlock     uint64              `json:"end_block"`
+	Configs      []configOutput      `json:"configs"`
+	Transmitter  *common.Address     `json:"transmitter,omitempty"`
+	IndexChanges []indexChangeOutput `json:"index_changes,omitempty"`
+}
+
+// NewConfigsCommand creates the configs command.
+func NewConfigsCommand(container *config.Container) *cobra.Command {
+	var (
+		fromBlock    uint64
+		toBlock      uint64
+		transmitter  string
+		outputFormat string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "configs [contract]",
+		Short: "List the ConfigSet history of a contract",
+		Long: `Lists every ConfigSet event emitted by an OCR2 contract in a block range,
+in block order. Each entry shows the block, config digest, F, transmitters,
+and signers. Without --to-block the range runs to head.
+
+With --transmitter the transmitter's index is tracked across the configs, and
+every config that moves it (including removal or re-addition) is reported with
+the old and new index. The command then exits with 1 when the index changed
+and 3 when the history could not be read.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse arguments.
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+
+			var tracked *common.Address
+			if transmitter != "" {
+				if !common.IsHexAddress(transmitter) {
+					return fmt.Errorf("invalid transmitter address: %s", transmitter)
+				}
+				address := common.HexToAddress(transmitter)
+				tracked = &address
+			}
+
+			if outputFormat != OutputFormatText && outputFormat != OutputFormatJSON {
+				return fmt.Errorf("invalid output format: %s (expected text or json)", outputFormat)
+			}
+
+			params := interfaces.ConfigHistoryParams{
+				ContractAddress: common.HexToAddress(args[0]),
+				FromBlock:       fromBlock,
+				Transmitter:     tracked,
+			}
+			if cmd.Flags().Changed("to-block") {
+				params.ToBlock = &toBlock
+			}
+
+			// Execute use case.
+			result, err := container.ConfigHistoryUseCase.Execute(context.Background(), params)
+			if err != nil {
+				reportValidationErrors(cmd, err, outputFormat)
+				err = fmt.Errorf("failed to get config history: %w", err)
+				if tracked != nil {
+					return &ExitError{Code: exitCodeUnknown, Err: err}
+				}
+				return err
+			}
+
+			if outputFormat == OutputFormatJSON {
+				err = displayConfigsJSON(cmd.OutOrStdout(), result)
+			} else {
+				displayConfigsText(cmd.OutOrStdout(), result)
+			}
+			if err != nil {
+				return err
+			}
+
+			if len(result.IndexChanges) > 0 {
+				// Drift is reported through the exit code, not as a usage error.
+				cmd.SilenceUsage = true
+				return &ExitError{
+					Code: exitCodeIndexDrift,
+					Err: fmt.Errorf("transmitter %s changed index %d times",
+						tracked.Hex(), len(result.IndexChanges)),
+				}
+			}
+
+			return nil
+		},
+	}
+
+	// Add flags.
+	cmd.Flags().Uint64Var(&fromBlock, "from-block", 0, "First block of the range")
+	cmd.Flags().Uint64Var(&toBlock, "to-block", 0, "Last block of the range (default: head)")
+	cmd.Flags().StringVar(&transmitter, "transmitter", "", "Track this transmitter's index across the configs")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", OutputFormatText, "Output format (text, json)")
+
+	return cmd
+}
+
+// displayConfigsText displays the config history in text format.
+func displayConfigsText(out io.Writer, result *interfaces.ConfigHistoryResult) {
+	_, _ = fmt.Fprintf(out, "Contract: %s\n", result.ContractAddress.Hex())
+	_, _ = fmt.Fprintf(out, "Blocks: %d - %d\n", result.StartBlock, result.EndBlock)
+	_, _ = fmt.Fprintf(out, "%d configs set\n", len(result.Configs))
+
+	for _, event := range result.Configs {
+		_, _ = fmt.Fprintf(out, "\nBlock %d (config #%d)\n", event.BlockNumber, event.ConfigCount)
+		_, _ = fmt.Fprintf(out, "  Digest: %x\n", event.Config.ConfigDigest)
+		_, _ = fmt.Fprintf(out, "  F: %d\n", event.Config.Threshold)
+		_, _ = fmt.Fprintf(out, "  Transmitters:\n")
+		for i, transmitter := range event.Config.Transmitters {
+			_, _ = fmt.Fprintf(out, "    %d: %s\n", i, transmitter.Hex())
+		}
+		_, _ = fmt.Fprintf(out, "  Signers:\n")
+		for i, signer := range event.Config.Signers {
+			_, _ = fmt.Fprintf(out, "    %d: %s\n", i, signer.Hex())
+		}
+		for _, anomaly := range services.DetectConfigAnomalies(&event.Config) {
+			_, _ = fmt.Fprintf(out, "  Warning: %s\n", anomaly.Description)
+		}
+	}
+
+	if result.Transmitter == nil {
+		return
+	}
+	_, _ = fmt.Fprintf(out, "\nTransmitter %s:\n", result.Transmitter.Hex())
+	if len(result.IndexChanges) == 0 {
+		_, _ = fmt.Fprintf(out, "  Index unchanged\n")
+	}
+	for _, change := range result.IndexChanges {
+		_, _ = fmt.Fprintf(out, "  Block %d (config #%d, digest %x): index %s -> %s\n",
+			change.BlockNumber, change.ConfigCount, change.ConfigDigest,
+			formatTransmitterIndex(change.OldIndex), formatTransmitterIndex(change.NewIndex))
+	}
+}
+
+// formatTransmitterIndex formats a transmitter index, which may be absent from a config.
+func formatTransmitterIndex(index uint8) string {
+	if index == entities.UnknownObserverIndex {
+		return "absent"
+	}
+	return fmt.Sprint(index)
+}
+
+// transmitterIndexOutput returns a transmitter index for JSON output, nil when absent.
+func transmitterIndexOutput(index uint8) *uint8 {
+	if index == entities.UnknownObserverIndex {
+		return nil
+	}
+	return &index
+}
+
+// displayConfigsJSON displays the config history in JSON format.
+func displayConfigsJSON(out io.Writer, result *interfaces.ConfigHistoryResult) error {
+	output := configsOutput{
+		Contract:   result.ContractAddress,
+		StartBlock: result.StartBlock,
+		EndBlock:   result.EndBlock,
+		Configs:    make([]configOutput, 0, len(result.Configs)),
+	}
+	for _, event := range result.Configs {
+		output.Configs = append(output.Configs, configOutput{
+			BlockNumber:  event.BlockNumber,
+			ConfigCount:  event.ConfigCount,
+			ConfigDigest: fmt.Sprintf("%x", event.Config.ConfigDigest),
+			F:            event.Config.Threshold,
+			Transmitters: event.Config.Transmitters,
+			Signers:      event.Config.Signers,
+		})
+	}
+	output.Transmitter = result.Transmitter
+	for _, change := range result.IndexChanges {
+		output.IndexChanges = append(output.IndexChanges, indexChangeOutput{
+			BlockNumber:  change.BlockNumber,
+			ConfigCount:  change.ConfigCount,
+			ConfigDigest: fmt.Sprintf("%x", change.ConfigDigest),
+			OldIndex:     transmitterIndexOutput(change.OldIndex),
+			NewIndex:     transmitterIndexOutput(change.NewIndex),
+		})
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
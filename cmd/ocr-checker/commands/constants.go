@@ -13,4 +13,7 @@ const (
 	OutputFormatText = "text"
 	// OutputFormatCSV represents CSV output format.
 	OutputFormatCSV = "csv"
-)
\ No newline at end of file
+)
+
+// stdoutPath is the --output value that writes to standard output.
+const stdoutPath = "-"
@@ -0,0 +1,22 @@
+// Package commands provides CLI command implementations for the OCR checker tool.
+package commands
+
+// exitCodeUnknown is returned by status-reporting commands when the check
+// itself could not run, so it can't be confused with a WARNING (1).
+const exitCodeUnknown = 3
+
+// ExitError is returned by commands that need a specific process exit code.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+// Error returns the underlying error message.
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
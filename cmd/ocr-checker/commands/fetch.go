@@ -3,130 +3,489 @@
 package commands
 
 import (
-	"chainlink-ocr-checker/domain/entities"
+	"bufio"
 	"context"
-	"encoding/json"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"os"
-	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/domain/entities"
 	"chainlink-ocr-checker/domain/interfaces"
 	"chainlink-ocr-checker/infrastructure/config"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
-	"gopkg.in/yaml.v2"
 )
 
+// defaultFetchOutput is the output path template used without --output.
+const defaultFetchOutput = "results/{contract}-{start}_{end}.{format}"
+
+// defaultFetchParallel is the number of contracts fetched at once.
+const defaultFetchParallel = 4
+
 // NewFetchCommand creates the fetch command.
 func NewFetchCommand(container *config.Container) *cobra.Command {
 	var (
-		outputFormat string
-		outputPath   string
+		outputFormat  string
+		outputPath    string
+		noTimestamps  bool
+		writeIndex    bool
+		rawLogs       bool
+		transmitter   string
+		configDigest  string
+		contractsFile string
+		parallel      int
+
+		byBlocks      bool
+		startBlock    uint64
+		endBlock      uint64
+		maxBlockRange uint64
+
+		observersOnly    bool
+		transmittersOnly bool
+		minimal          bool
 	)
 
 	cmd := &cobra.Command{
-		Use:   "fetch [contract] [start_round] [end_round]",
-		Short: "Fetch OCR transmission data for a contract",
-		Long: `Fetches historical OCR transmission data for a specific contract
+		Use:   "fetch [contract...] [start_round] [end_round]",
+		Short: "Fetch OCR transmission data for one or more contracts",
+		Long: `Fetches historical OCR transmission data for one or more contracts
 within the given round range. The data includes transmitter participation,
-observer indices, and block information.`,
-		Args: cobra.ExactArgs(3),
-		RunE: func(_ *cobra.Command, args []string) error {
+observer indices, and block information.
+
+Contracts come from the arguments and from --contracts-file (one address per
+line, # comments allowed). Several contracts are fetched concurrently and each
+is written to its own file; --output then must contain {contract}. The output
+path may use {contract}, {start}, {end}, and {format}.
+
+--observers-only, --transmitters-only, and --minimal shrink the output by
+leaving out the transmission fields an analysis does not need. Projected files
+can still be parsed; the omitted fields read back as zero.
+
+With --by-blocks the range is the blocks --start-block through --end-block
+instead, and only contracts are given as arguments; {start} and {end} in the
+output path are then the blocks.
+
+A round range is looked for in the last --max-block-range blocks (default
+max_block_range, 500000) rather than from genesis; a start round older than
+that is an error. 0 scans from genesis.`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			minArgs := 3
+			if byBlocks {
+				minArgs = 1
+			}
+			if contractsFile != "" {
+				minArgs--
+			}
+			return cobra.MinimumNArgs(minArgs)(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if writeIndex && outputFormat != string(interfaces.OutputFormatJSONL) {
+				return fmt.Errorf("--index requires --format jsonl")
+			}
+			if writeIndex && outputPath == stdoutPath {
+				return fmt.Errorf("--index requires an output file")
+			}
+			if parallel <= 0 {
+				return fmt.Errorf("--parallel must be positive")
+			}
+
+			if byBlocks && cmd.Flags().Changed("max-block-range") {
+				return fmt.Errorf("--max-block-range only applies to round ranges, not --by-blocks")
+			}
+			if !cmd.Flags().Changed("max-block-range") {
+				maxBlockRange = container.Config.MaxBlockRange
+			}
+
+			projection, err := fetchProjection(observersOnly, transmittersOnly, minimal)
+			if err != nil {
+				return err
+			}
+			if projection != services.ProjectionFull && outputFormat == string(interfaces.OutputFormatProtobuf) {
+				return fmt.Errorf("--observers-only, --transmitters-only, and --minimal require a yaml, json, or jsonl format")
+			}
+
+			if transmitter != "" && !common.IsHexAddress(transmitter) {
+				return fmt.Errorf("invalid transmitter address: %s", transmitter)
+			}
+			digest, err := parseConfigDigest(configDigest)
+			if err != nil {
+				return err
+			}
+
 			// Parse arguments.
-			contractAddr := common.HexToAddress(args[0])
-			startRound, err := parseUint32(args[1])
+			fetchRange, contractArgs, err := parseFetchRange(cmd, args, byBlocks, startBlock, endBlock)
 			if err != nil {
-				return fmt.Errorf("invalid start round: %w", err)
+				return err
 			}
-			endRound, err := parseUint32(args[2])
+			contracts, err := parseFetchContracts(contractArgs, contractsFile)
 			if err != nil {
-				return fmt.Errorf("invalid end round: %w", err)
+				return err
+			}
+
+			if len(contracts) > 1 {
+				if outputPath == stdoutPath {
+					return fmt.Errorf("cannot stream several contracts to stdout; use an --output template with {contract}")
+				}
+				if outputPath != "" && !strings.Contains(outputPath, "{contract}") {
+					return fmt.Errorf("--output must contain {contract} when fetching several contracts")
+				}
 			}
 
 			// Create context.
 			ctx := context.Background()
 
-			// Execute use case.
-			params := interfaces.FetchTransmissionsParams{
-				ContractAddress: contractAddr,
-				StartRound:      startRound,
-				EndRound:        endRound,
+			newParams := func(contract common.Address) interfaces.FetchTransmissionsParams {
+				params := interfaces.FetchTransmissionsParams{
+					ContractAddress: contract,
+					SkipTimestamps:  noTimestamps,
+					RawLogs:         rawLogs,
+					ConfigDigest:    digest,
+				}
+				if byBlocks {
+					params.ByBlocks = true
+					params.StartBlock = fetchRange.start
+					params.EndBlock = fetchRange.end
+				} else {
+					params.StartRound = uint32(fetchRange.start) // #nosec G115 -- parsed as uint32
+					params.EndRound = uint32(fetchRange.end)     // #nosec G115 -- parsed as uint32
+					params.MaxBlockRange = maxBlockRange
+				}
+				if transmitter != "" {
+					params.Transmitter = common.HexToAddress(transmitter)
+				}
+				return params
 			}
 
-			container.Logger.Info("Fetching transmissions",
-				"contract", contractAddr.Hex(),
-				"startRound", startRound,
-				"endRound", endRound)
+			// Stream to stdout without any summary so the output can be piped.
+			if outputPath == stdoutPath {
+				result, err := executeFetch(ctx, container, newParams(contracts[0]))
+				if err != nil {
+					reportValidationErrors(cmd, err, outputFormat)
+					return err
+				}
+				err = services.EncodeProjectedTransmissionResult(
+					cmd.OutOrStdout(), result, interfaces.OutputFormat(outputFormat), projection)
+				if err != nil {
+					return fmt.Errorf("failed to write results: %w", err)
+				}
+				return nil
+			}
 
-			result, err := container.FetchTransmissionsUseCase.Execute(ctx, params)
-			if err != nil {
-				return fmt.Errorf("failed to fetch transmissions: %w", err)
+			template := outputPath
+			if template == "" {
+				template = defaultFetchOutput
 			}
 
-			container.Logger.Info("Fetch completed",
-				"transmissions", len(result.Transmissions))
+			// Fetch each contract into its own file, a bounded number at a time.
+			results := make([]fetchFileResult, len(contracts))
+			sem := make(chan struct{}, parallel)
+			var wg sync.WaitGroup
+			wg.Add(len(contracts))
 
-			// Save results.
-			if outputPath == "" {
-				outputPath = fmt.Sprintf("results/%s-%d_%d.yaml",
-					contractAddr.Hex(), startRound, endRound)
-			}
+			for i, contract := range contracts {
+				go func(i int, contract common.Address) {
+					defer wg.Done()
 
-			if err := saveResults(result, outputPath, outputFormat); err != nil {
-				return fmt.Errorf("failed to save results: %w", err)
+					// Acquire semaphore.
+					sem <- struct{}{}
+					defer func() { <-sem }()
+
+					path := fetchOutputPath(template, contract, fetchRange, outputFormat)
+					results[i] = fetchFileResult{contract: contract, path: path}
+					results[i].result, results[i].err = fetchToFile(
+						ctx, container, newParams(contract), path, outputFormat, projection, writeIndex)
+				}(i, contract)
 			}
+			wg.Wait()
 
-			container.Logger.Info("Results saved", "path", outputPath)
+			if len(contracts) == 1 {
+				if err := results[0].err; err != nil {
+					reportValidationErrors(cmd, err, outputFormat)
+					return err
+				}
+			}
 
 			// Print summary.
-			fmt.Printf("Fetched %d transmissions for contract %s\n",
-				len(result.Transmissions), contractAddr.Hex())
-			fmt.Printf("Round range: %d - %d\n", startRound, endRound)
-			fmt.Printf("Results saved to: %s\n", outputPath)
+			out := cmd.OutOrStdout()
+			failed := 0
+			for _, r := range results {
+				if r.err != nil {
+					// Parameters are shared, so validation details are the same for every contract.
+					if failed == 0 {
+						reportValidationErrors(cmd, r.err, outputFormat)
+					}
+					failed++
+					_, _ = fmt.Fprintf(out, "Failed to fetch contract %s: %v\n", r.contract.Hex(), r.err)
+					continue
+				}
+				printFetchSummary(out, r.result, r.contract, fetchRange, r.path)
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("failed to fetch %d of %d contracts", failed, len(contracts))
+			}
 
 			return nil
 		},
 	}
 
 	// Add flags.
-	cmd.Flags().StringVarP(&outputFormat, "format", "f", "yaml", "Output format (yaml, json)")
-	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Output file path")
+	cmd.Flags().StringVarP(&outputFormat, "format", "f", "yaml", "Output format (yaml, json, jsonl, protobuf)")
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "",
+		"Output file path or template with {contract}, {start}, {end}, {format} "+
+			"(gzip-compressed when ending in .gz), or - for stdout (default \""+defaultFetchOutput+"\")")
+	cmd.Flags().BoolVar(&noTimestamps, "no-timestamps", false,
+		"Skip block timestamp lookups for faster fetches (time-based grouping won't work on the output)")
+	cmd.Flags().BoolVar(&writeIndex, "index", false,
+		"Write a round index next to JSON-lines output for fast range reads (requires --format jsonl)")
+	cmd.Flags().BoolVar(&rawLogs, "raw-logs", false,
+		"Read events with eth_getLogs and decode them directly instead of through the contract binding")
+	cmd.Flags().StringVar(&transmitter, "transmitter", "",
+		"Keep only transmissions sent by this address")
+	cmd.Flags().StringVar(&configDigest, "config-digest", "",
+		"Keep only transmissions made under the config with this hex digest")
+	cmd.Flags().StringVar(&contractsFile, "contracts-file", "",
+		"File with one contract address per line, fetched in addition to the arguments")
+	cmd.Flags().IntVar(&parallel, "parallel", defaultFetchParallel, "Number of contracts fetched at once")
+	cmd.Flags().BoolVar(&observersOnly, "observers-only", false,
+		"Leave the transmitter address and indices out of each transmission")
+	cmd.Flags().BoolVar(&transmittersOnly, "transmitters-only", false,
+		"Leave the observer list, count, and quorum flag out of each transmission")
+	cmd.Flags().BoolVar(&minimal, "minimal", false,
+		"Keep only the round, transmitter, and block number of each transmission")
+	cmd.Flags().BoolVar(&byBlocks, "by-blocks", false,
+		"Fetch the block range --start-block to --end-block instead of a round range")
+	cmd.Flags().Uint64Var(&startBlock, "start-block", 0, "First block to fetch (requires --by-blocks)")
+	cmd.Flags().Uint64Var(&endBlock, "end-block", 0, "Last block to fetch (requires --by-blocks)")
+	cmd.Flags().Uint64Var(&maxBlockRange, "max-block-range", 0,
+		"Look for a round range in at most this many blocks back from head, 0 for genesis (default: max_block_range)")
 
 	return cmd
 }
 
-// saveResults saves the transmission results to a file.
-func saveResults(result *entities.TransmissionResult, path string, format string) error {
-	// Create directory if needed.
-	dir := filepath.Dir(path)
-	if err := os.MkdirAll(dir, 0750); err != nil {
-		return fmt.Errorf("failed to create directory: %w", err)
+// fetchRange is the round or block range a fetch covers.
+type fetchRange struct {
+	unit       string
+	start, end uint64
+}
+
+// parseFetchRange returns the range to fetch and the contract arguments. By
+// default the last two arguments are the round range; with --by-blocks the
+// range comes from --start-block and --end-block and every argument is a contract.
+func parseFetchRange(
+	cmd *cobra.Command,
+	args []string,
+	byBlocks bool,
+	startBlock, endBlock uint64,
+) (fetchRange, []string, error) {
+	blockFlagSet := cmd.Flags().Changed("start-block") || cmd.Flags().Changed("end-block")
+
+	if !byBlocks {
+		if blockFlagSet {
+			return fetchRange{}, nil, fmt.Errorf("--start-block and --end-block require --by-blocks")
+		}
+		startRound, err := parseUint32(args[len(args)-2])
+		if err != nil {
+			return fetchRange{}, nil, fmt.Errorf("invalid start round: %w", err)
+		}
+		endRound, err := parseUint32(args[len(args)-1])
+		if err != nil {
+			return fetchRange{}, nil, fmt.Errorf("invalid end round: %w", err)
+		}
+		return fetchRange{unit: "Round", start: uint64(startRound), end: uint64(endRound)}, args[:len(args)-2], nil
+	}
+
+	if !cmd.Flags().Changed("start-block") || !cmd.Flags().Changed("end-block") {
+		return fetchRange{}, nil, fmt.Errorf("--by-blocks requires --start-block and --end-block")
+	}
+	if startBlock > endBlock {
+		return fetchRange{}, nil, fmt.Errorf("invalid block range: start=%d > end=%d", startBlock, endBlock)
+	}
+	for _, arg := range args {
+		if _, err := strconv.ParseUint(arg, 10, 64); err == nil {
+			return fetchRange{}, nil, fmt.Errorf("round arguments cannot be combined with --by-blocks: %s", arg)
+		}
+	}
+	return fetchRange{unit: "Block", start: startBlock, end: endBlock}, args, nil
+}
+
+// fetchFileResult is the outcome of fetching one contract into a file.
+type fetchFileResult struct {
+	contract common.Address
+	path     string
+	result   *entities.TransmissionResult
+	err      error
+}
+
+// executeFetch runs the fetch use case for one contract.
+func executeFetch(
+	ctx context.Context,
+	container *config.Container,
+	params interfaces.FetchTransmissionsParams,
+) (*entities.TransmissionResult, error) {
+	container.Logger.Info("Fetching transmissions",
+		"contract", params.ContractAddress.Hex(),
+		"startRound", params.StartRound,
+		"endRound", params.EndRound)
+
+	result, err := container.FetchTransmissionsUseCase.Execute(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch transmissions: %w", err)
+	}
+
+	container.Logger.Info("Fetch completed",
+		"contract", params.ContractAddress.Hex(),
+		"transmissions", len(result.Transmissions))
+
+	return result, nil
+}
+
+// fetchToFile fetches one contract and saves the result to path.
+func fetchToFile(
+	ctx context.Context,
+	container *config.Container,
+	params interfaces.FetchTransmissionsParams,
+	path string,
+	outputFormat string,
+	projection services.TransmissionProjection,
+	writeIndex bool,
+) (*entities.TransmissionResult, error) {
+	result, err := executeFetch(ctx, container, params)
+	if err != nil {
+		return nil, err
 	}
 
-	// Open file.
-	cleanPath := filepath.Clean(path)
-	file, err := os.Create(cleanPath) // #nosec G304 -- path is cleaned
+	if writeIndex {
+		err = services.WriteIndexedProjectedTransmissionResult(path, result, projection)
+	} else {
+		err = services.WriteProjectedTransmissionResult(path, result, interfaces.OutputFormat(outputFormat), projection)
+	}
 	if err != nil {
-		return fmt.Errorf("failed to create file: %w", err)
+		return nil, fmt.Errorf("failed to save results: %w", err)
+	}
+
+	container.Logger.Info("Results saved", "path", path)
+
+	return result, nil
+}
+
+// printFetchSummary prints the summary of one saved fetch.
+func printFetchSummary(
+	out io.Writer,
+	result *entities.TransmissionResult,
+	contract common.Address,
+	fetched fetchRange,
+	path string,
+) {
+	_, _ = fmt.Fprintf(out, "Fetched %d transmissions for contract %s\n",
+		len(result.Transmissions), contract.Hex())
+	_, _ = fmt.Fprintf(out, "%s range: %d - %d\n", fetched.unit, fetched.start, fetched.end)
+	_, _ = fmt.Fprintf(out, "%d distinct transmitters participated\n", len(result.DistinctTransmitters))
+	for _, transmitter := range result.DistinctTransmitters {
+		_, _ = fmt.Fprintf(out, "  %s: %d\n", transmitter.Address.Hex(), transmitter.Count)
 	}
-	defer func() {
-		if cerr := file.Close(); cerr != nil {
-			_, _ = fmt.Fprintf(os.Stderr, "Failed to close file: %v\n", cerr)
+	_, _ = fmt.Fprintf(out, "Results saved to: %s\n", path)
+}
+
+// fetchProjection returns the projection selected by the projection flags, of
+// which at most one may be set.
+func fetchProjection(observersOnly, transmittersOnly, minimal bool) (services.TransmissionProjection, error) {
+	projection := services.ProjectionFull
+	set := 0
+	for _, flag := range []struct {
+		set        bool
+		projection services.TransmissionProjection
+	}{
+		{observersOnly, services.ProjectionObservers},
+		{transmittersOnly, services.ProjectionTransmitters},
+		{minimal, services.ProjectionMinimal},
+	} {
+		if flag.set {
+			projection = flag.projection
+			set++
 		}
-	}()
-
-	// Encode based on format.
-	switch format {
-	case OutputFormatJSON:
-		encoder := json.NewEncoder(file)
-		encoder.SetIndent("", "  ")
-		return encoder.Encode(result)
-	case "yaml":
-		encoder := yaml.NewEncoder(file)
-		return encoder.Encode(result)
-	default:
-		return fmt.Errorf("unsupported format: %s", format)
 	}
+	if set > 1 {
+		return projection, fmt.Errorf("only one of --observers-only, --transmitters-only, and --minimal may be set")
+	}
+	return projection, nil
+}
+
+// fetchOutputPath fills the output path template for a contract.
+func fetchOutputPath(template string, contract common.Address, fetched fetchRange, format string) string {
+	return strings.NewReplacer(
+		"{contract}", contract.Hex(),
+		"{start}", fmt.Sprint(fetched.start),
+		"{end}", fmt.Sprint(fetched.end),
+		"{format}", format,
+	).Replace(template)
+}
+
+// parseFetchContracts collects the contracts from the arguments and the
+// optional contracts file, in order and without duplicates.
+func parseFetchContracts(args []string, contractsFile string) ([]common.Address, error) {
+	values := append([]string(nil), args...)
+
+	if contractsFile != "" {
+		file, err := os.Open(contractsFile) // #nosec G304 -- user-provided path
+		if err != nil {
+			return nil, fmt.Errorf("failed to open contracts file: %w", err)
+		}
+		defer func() { _ = file.Close() }()
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			values = append(values, line)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read contracts file: %w", err)
+		}
+	}
+
+	seen := make(map[common.Address]bool, len(values))
+	contracts := make([]common.Address, 0, len(values))
+	for _, value := range values {
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("invalid contract address: %s", value)
+		}
+		contract := common.HexToAddress(value)
+		if seen[contract] {
+			continue
+		}
+		seen[contract] = true
+		contracts = append(contracts, contract)
+	}
+
+	if len(contracts) == 0 {
+		return nil, fmt.Errorf("at least one contract address is required")
+	}
+
+	return contracts, nil
+}
+
+// parseConfigDigest parses a hex config digest, with or without 0x. An empty
+// string yields the zero digest, which matches every config.
+func parseConfigDigest(s string) ([32]byte, error) {
+	var digest [32]byte
+	if s == "" {
+		return digest, nil
+	}
+
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil || len(b) != len(digest) {
+		return digest, fmt.Errorf("invalid config digest: %s (expected 32 hex bytes)", s)
+	}
+	copy(digest[:], b)
+	return digest, nil
 }
 
 // parseUint32 parses a string to uint32.
@@ -0,0 +1,283 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/application/usecases"
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchCommand_Stdout(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	result := &entities.TransmissionResult{
+		ContractAddress: contract,
+		StartRound:      1,
+		EndRound:        2,
+		Transmissions: []entities.Transmission{
+			{ContractAddress: contract, Epoch: 0, Round: 1, LatestAnswer: big.NewInt(100), BlockNumber: 10},
+			{ContractAddress: contract, Epoch: 0, Round: 2, LatestAnswer: big.NewInt(101), BlockNumber: 11},
+		},
+	}
+
+	for _, format := range []interfaces.OutputFormat{
+		interfaces.OutputFormatJSON,
+		interfaces.OutputFormatYAML,
+		interfaces.OutputFormatJSONL,
+	} {
+		t.Run(string(format), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCase := mocks.NewMockFetchTransmissionsUseCase(ctrl)
+			useCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(result, nil)
+			logger := mocks.NewMockLogger(ctrl)
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+			cmd := NewFetchCommand(&config.Container{
+				Config:                    &config.Config{},
+				Logger:                    logger,
+				FetchTransmissionsUseCase: useCase,
+			})
+			var stdout bytes.Buffer
+			cmd.SetOut(&stdout)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs([]string{"-o", "-", "-f", string(format), contract.Hex(), "1", "2"})
+			require.NoError(t, cmd.Execute())
+
+			// Stdout holds exactly the serialized result, nothing else.
+			var expected bytes.Buffer
+			require.NoError(t, services.EncodeTransmissionResult(&expected, result, format))
+			assert.Equal(t, expected.String(), stdout.String())
+			assert.NotContains(t, stdout.String(), "Fetched")
+		})
+	}
+
+	t.Run("observers only", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase := mocks.NewMockFetchTransmissionsUseCase(ctrl)
+		useCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(result, nil)
+		logger := mocks.NewMockLogger(ctrl)
+		logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+		cmd := NewFetchCommand(&config.Container{
+			Config:                    &config.Config{},
+			Logger:                    logger,
+			FetchTransmissionsUseCase: useCase,
+		})
+		var stdout bytes.Buffer
+		cmd.SetOut(&stdout)
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs([]string{"-o", "-", "-f", "json", "--observers-only", contract.Hex(), "1", "2"})
+		require.NoError(t, cmd.Execute())
+
+		assert.Contains(t, stdout.String(), `"Observers"`)
+		assert.Contains(t, stdout.String(), `"LatestAnswer"`)
+		assert.NotContains(t, stdout.String(), `"TransmitterAddress"`)
+	})
+
+	t.Run("one projection at a time", func(t *testing.T) {
+		cmd := NewFetchCommand(&config.Container{Config: &config.Config{}})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs([]string{"-o", "-", "--observers-only", "--minimal", contract.Hex(), "1", "2"})
+		assert.ErrorContains(t, cmd.Execute(), "only one of")
+	})
+
+	t.Run("index needs a file", func(t *testing.T) {
+		cmd := NewFetchCommand(&config.Container{Config: &config.Config{}})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs([]string{"-o", "-", "-f", "jsonl", "--index", contract.Hex(), "1", "2"})
+		assert.Error(t, cmd.Execute())
+	})
+}
+
+func TestFetchCommand_MultipleContracts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	contracts := []common.Address{
+		common.HexToAddress("0x1000000000000000000000000000000000000001"),
+		common.HexToAddress("0x1000000000000000000000000000000000000002"),
+		common.HexToAddress("0x1000000000000000000000000000000000000003"),
+	}
+
+	useCase := mocks.NewMockFetchTransmissionsUseCase(ctrl)
+	useCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Times(3).DoAndReturn(
+		func(_ context.Context, params interfaces.FetchTransmissionsParams) (*entities.TransmissionResult, error) {
+			return &entities.TransmissionResult{
+				ContractAddress: params.ContractAddress,
+				StartRound:      params.StartRound,
+				EndRound:        params.EndRound,
+				Transmissions: []entities.Transmission{
+					{ContractAddress: params.ContractAddress, Epoch: 0, Round: 5, LatestAnswer: big.NewInt(100)},
+				},
+			}, nil
+		})
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	// Two contracts on the command line, one (plus a duplicate) from a file.
+	dir := t.TempDir()
+	contractsFile := filepath.Join(dir, "contracts.txt")
+	require.NoError(t, os.WriteFile(contractsFile,
+		[]byte("# feeds\n"+contracts[2].Hex()+"\n\n"+contracts[0].Hex()+"\n"), 0o600))
+
+	cmd := NewFetchCommand(&config.Container{
+		Config:                    &config.Config{},
+		Logger:                    logger,
+		FetchTransmissionsUseCase: useCase,
+	})
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{
+		"-f", "json",
+		"-o", filepath.Join(dir, "{contract}-{start}_{end}.{format}"),
+		"--contracts-file", contractsFile,
+		contracts[0].Hex(), contracts[1].Hex(), "5", "10",
+	})
+	require.NoError(t, cmd.Execute())
+
+	for _, contract := range contracts {
+		path := filepath.Join(dir, contract.Hex()+"-5_10.json")
+		result, _, err := services.ReadTransmissionResult(path)
+		require.NoError(t, err)
+		assert.Equal(t, contract, result.ContractAddress)
+		assert.Equal(t, uint32(5), result.StartRound)
+		assert.Equal(t, uint32(10), result.EndRound)
+		assert.Contains(t, stdout.String(), "Results saved to: "+path)
+	}
+
+	t.Run("output must name the contract", func(t *testing.T) {
+		cmd := NewFetchCommand(&config.Container{Config: &config.Config{}})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs([]string{"-o", "results.yaml", contracts[0].Hex(), contracts[1].Hex(), "1", "2"})
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "{contract}")
+	})
+}
+
+func TestFetchCommand_ByBlocks(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	newCommand := func(t *testing.T, fetcher *mocks.MockTransmissionFetcher) *cobra.Command {
+		ctrl := gomock.NewController(t)
+		logger := mocks.NewMockLogger(ctrl)
+		logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+		cmd := NewFetchCommand(&config.Container{
+			Config:                    &config.Config{},
+			Logger:                    logger,
+			FetchTransmissionsUseCase: usecases.NewFetchTransmissionsUseCase(fetcher, nil, nil, logger, 0),
+		})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		return cmd
+	}
+
+	t.Run("fetches the block range", func(t *testing.T) {
+		fetcher := mocks.NewMockTransmissionFetcher(gomock.NewController(t))
+		fetcher.EXPECT().
+			FetchByBlocks(gomock.Any(), contract, uint64(50000000), uint64(50010000), gomock.Any()).
+			Return(&entities.TransmissionResult{ContractAddress: contract}, nil)
+
+		path := filepath.Join(t.TempDir(), "{start}-{end}.json")
+		cmd := newCommand(t, fetcher)
+		var stdout bytes.Buffer
+		cmd.SetOut(&stdout)
+		cmd.SetArgs([]string{"--by-blocks", "--start-block", "50000000", "--end-block", "50010000",
+			"-f", "json", "-o", path, contract.Hex()})
+		require.NoError(t, cmd.Execute())
+
+		assert.Contains(t, stdout.String(), "Block range: 50000000 - 50010000")
+		assert.FileExists(t, filepath.Join(filepath.Dir(path), "50000000-50010000.json"))
+	})
+
+	for name, args := range map[string][]string{
+		"round arguments":    {"--by-blocks", "--start-block", "1", "--end-block", "2", contract.Hex(), "1", "2"},
+		"missing end block":  {"--by-blocks", "--start-block", "1", contract.Hex()},
+		"inverted range":     {"--by-blocks", "--start-block", "2", "--end-block", "1", contract.Hex()},
+		"block without mode": {"--start-block", "1", contract.Hex(), "1", "2"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cmd := newCommand(t, mocks.NewMockTransmissionFetcher(gomock.NewController(t)))
+			cmd.SetArgs(append([]string{"-o", "-"}, args...))
+			assert.Error(t, cmd.Execute())
+		})
+	}
+}
+
+func TestFetchCommand_MaxBlockRange(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	run := func(t *testing.T, maxBlockRange uint64, args ...string) {
+		ctrl := gomock.NewController(t)
+		fetcher := mocks.NewMockTransmissionFetcher(ctrl)
+		fetcher.EXPECT().
+			FetchByRounds(gomock.Any(), contract, uint32(1), uint32(2), interfaces.FetchOptions{MaxBlockRange: maxBlockRange}).
+			Return(&entities.TransmissionResult{ContractAddress: contract}, nil)
+		logger := mocks.NewMockLogger(ctrl)
+		logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+		cmd := NewFetchCommand(&config.Container{
+			Config:                    &config.Config{MaxBlockRange: 500000},
+			Logger:                    logger,
+			FetchTransmissionsUseCase: usecases.NewFetchTransmissionsUseCase(fetcher, nil, nil, logger, 0),
+		})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(append(append([]string{"-o", "-"}, args...), contract.Hex(), "1", "2"))
+		require.NoError(t, cmd.Execute())
+	}
+
+	t.Run("config default", func(t *testing.T) {
+		run(t, 500000)
+	})
+
+	t.Run("flag overrides config", func(t *testing.T) {
+		run(t, 0, "--max-block-range", "0")
+	})
+
+	t.Run("not with blocks", func(t *testing.T) {
+		cmd := NewFetchCommand(&config.Container{Config: &config.Config{}})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs([]string{"--by-blocks", "--start-block", "1", "--end-block", "2",
+			"--max-block-range", "10", contract.Hex()})
+		assert.ErrorContains(t, cmd.Execute(), "--max-block-range")
+	})
+}
+
+func TestParseConfigDigest(t *testing.T) {
+	want := [32]byte{0xab, 31: 0x01}
+	hexDigest := "ab" + strings.Repeat("00", 30) + "01"
+
+	digest, err := parseConfigDigest(hexDigest)
+	require.NoError(t, err)
+	assert.Equal(t, want, digest)
+
+	digest, err = parseConfigDigest("0x" + hexDigest)
+	require.NoError(t, err)
+	assert.Equal(t, want, digest)
+
+	digest, err = parseConfigDigest("")
+	require.NoError(t, err)
+	assert.Zero(t, digest)
+
+	_, err = parseConfigDigest("0xabcd")
+	assert.Error(t, err)
+}
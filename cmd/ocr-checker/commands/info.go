@@ -0,0 +1,274 @@
+// Package commands provides CLI command implementations for the OCR checker tool.
+// It contains the fetch, parse, watch, and version commands with their associated flags and handlers.
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"chainlink-ocr-checker/application/usecases"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+// contractInfoOutput is the JSON shape of one contract's round statistics.
+type contractInfoOutput struct {
+	StartBlock             uint64                   `json:"start_block"`
+	EndBlock               uint64                   `json:"end_block"`
+	Transmissions          int                      `json:"transmissions"`
+	FirstRound             uint32                   `json:"first_round"`
+	LastRound              uint32                   `json:"last_round"`
+	FirstAggregatorRoundID uint32                   `json:"first_aggregator_round_id"`
+	LastAggregatorRoundID  uint32                   `json:"last_aggregator_round_id"`
+	Transmitters           []transmitterCountOutput `json:"transmitters"`
+	Error                  string                   `json:"error,omitempty"`
+}
+
+// transmitterCountOutput is the JSON shape of a transmitter's transmission count.
+type transmitterCountOutput struct {
+	Address common.Address `json:"address"`
+	Count   int            `json:"count"`
+}
+
+// contractInfoOutcome is the result of the info lookup for one contract.
+type contractInfoOutcome struct {
+	contract common.Address
+	result   *interfaces.ContractInfoResult
+	err      error
+}
+
+// NewInfoCommand creates the info command.
+func NewInfoCommand(container *config.Container) *cobra.Command {
+	var (
+		blocks       uint64
+		since        time.Duration
+		fromBlock    uint64
+		toBlock      uint64
+		parallel     int
+		outputFormat string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "info [contract...]",
+		Short: "Show round statistics for one or more contracts",
+		Long: `Shows round statistics for an OCR2 contract over a block window.
+By default the window is the last --blocks blocks from head; --since starts it
+at the block mined that long ago (e.g. 2h), and --from-block and --to-block
+select an explicit historical range instead.
+
+Several contracts are looked up concurrently (--parallel at a time) and shown
+in one table, or one JSON object keyed by contract. Each contract's window is
+resolved separately, so head-relative windows may differ by the blocks mined
+in between.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse arguments.
+			contracts, err := parseFetchContracts(args, "")
+			if err != nil {
+				return err
+			}
+
+			if parallel <= 0 {
+				return fmt.Errorf("--parallel must be positive")
+			}
+
+			if outputFormat != OutputFormatText && outputFormat != OutputFormatJSON {
+				return fmt.Errorf("invalid output format: %s (expected text or json)", outputFormat)
+			}
+
+			newParams := func(contract common.Address) interfaces.ContractInfoParams {
+				params := interfaces.ContractInfoParams{
+					ContractAddress: contract,
+					Blocks:          blocks,
+					Since:           since,
+				}
+				if cmd.Flags().Changed("from-block") {
+					params.FromBlock = &fromBlock
+				}
+				if cmd.Flags().Changed("to-block") {
+					params.ToBlock = &toBlock
+				}
+				return params
+			}
+
+			// Execute use case for each contract, a bounded number at a time.
+			outcomes := fetchContractInfos(context.Background(), container.ContractInfoUseCase,
+				contracts, newParams, parallel)
+
+			failed := 0
+			for _, outcome := range outcomes {
+				if outcome.err == nil {
+					continue
+				}
+				// Parameters are shared, so validation details are the same for every contract.
+				if failed == 0 {
+					reportValidationErrors(cmd, outcome.err, outputFormat)
+				}
+				failed++
+			}
+			if len(contracts) == 1 && failed > 0 {
+				return fmt.Errorf("failed to get contract info: %w", outcomes[0].err)
+			}
+
+			// Print results.
+			out := cmd.OutOrStdout()
+			switch {
+			case outputFormat == OutputFormatJSON:
+				err = displayContractInfosJSON(out, outcomes)
+			case len(contracts) == 1:
+				displayContractInfoText(out, outcomes[0].result)
+			default:
+				err = displayContractInfosTable(out, outcomes)
+			}
+			if err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("failed to get contract info for %d of %d contracts", failed, len(contracts))
+			}
+
+			return nil
+		},
+	}
+
+	// Add flags.
+	cmd.Flags().Uint64Var(&blocks, "blocks", usecases.DefaultInfoBlocks, "Number of blocks to scan back from head")
+	cmd.Flags().DurationVar(&since, "since", 0, "Start the window this long ago, e.g. 2h (overrides --blocks)")
+	cmd.Flags().Uint64Var(&fromBlock, "from-block", 0, "First block of an explicit range (overrides --blocks)")
+	cmd.Flags().Uint64Var(&toBlock, "to-block", 0, "Last block of an explicit range (overrides --blocks)")
+	cmd.Flags().IntVar(&parallel, "parallel", defaultFetchParallel, "Number of contracts looked up at once")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", OutputFormatText, "Output format (text, json)")
+
+	return cmd
+}
+
+// fetchContractInfos runs the info use case for each contract with at most
+// parallel lookups at once. Outcomes are in contract order.
+func fetchContractInfos(
+	ctx context.Context,
+	useCase interfaces.ContractInfoUseCase,
+	contracts []common.Address,
+	newParams func(common.Address) interfaces.ContractInfoParams,
+	parallel int,
+) []contractInfoOutcome {
+	outcomes := make([]contractInfoOutcome, len(contracts))
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+	wg.Add(len(contracts))
+
+	for i, contract := range contracts {
+		go func(i int, contract common.Address) {
+			defer wg.Done()
+
+			// Acquire semaphore.
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			outcomes[i].contract = contract
+			outcomes[i].result, outcomes[i].err = useCase.Execute(ctx, newParams(contract))
+		}(i, contract)
+	}
+	wg.Wait()
+
+	return outcomes
+}
+
+// displayContractInfoText displays one contract's round statistics.
+func displayContractInfoText(out io.Writer, result *interfaces.ContractInfoResult) {
+	_, _ = fmt.Fprintf(out, "Contract: %s\n", result.ContractAddress.Hex())
+	_, _ = fmt.Fprintf(out, "Blocks: %d - %d\n", result.StartBlock, result.EndBlock)
+	_, _ = fmt.Fprintf(out, "Transmissions: %d\n", result.TransmissionCount)
+	if result.TransmissionCount > 0 {
+		_, _ = fmt.Fprintf(out, "Rounds: %d - %d\n", result.FirstRound, result.LastRound)
+		_, _ = fmt.Fprintf(out, "  first: %s\n", formatRound(result.FirstRound, result.FirstAggregatorRoundID))
+		_, _ = fmt.Fprintf(out, "  last: %s\n", formatRound(result.LastRound, result.LastAggregatorRoundID))
+	}
+	_, _ = fmt.Fprintf(out, "%d distinct transmitters participated\n", len(result.DistinctTransmitters))
+	for _, transmitter := range result.DistinctTransmitters {
+		_, _ = fmt.Fprintf(out, "  %s: %d\n", transmitter.Address.Hex(), transmitter.Count)
+	}
+}
+
+// displayContractInfosTable displays the round statistics of several contracts
+// as one table, with a row per contract in argument order.
+func displayContractInfosTable(out io.Writer, outcomes []contractInfoOutcome) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "Contract\tBlocks\tTransmissions\tFirst Round\tLast Round\tTransmitters")
+	_, _ = fmt.Fprintln(w, "--------\t------\t-------------\t-----------\t----------\t------------")
+
+	for _, outcome := range outcomes {
+		if outcome.err != nil {
+			_, _ = fmt.Fprintf(w, "%s\terror: %v\t\t\t\t\n", outcome.contract.Hex(), outcome.err)
+			continue
+		}
+		result := outcome.result
+		firstRound, lastRound := "-", "-"
+		if result.TransmissionCount > 0 {
+			firstRound = formatShortRound(result.FirstRound)
+			lastRound = formatShortRound(result.LastRound)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%d - %d\t%d\t%s\t%s\t%d\n",
+			outcome.contract.Hex(),
+			result.StartBlock,
+			result.EndBlock,
+			result.TransmissionCount,
+			firstRound,
+			lastRound,
+			len(result.DistinctTransmitters),
+		)
+	}
+
+	return w.Flush()
+}
+
+// displayContractInfosJSON displays round statistics as a JSON object keyed by contract.
+func displayContractInfosJSON(out io.Writer, outcomes []contractInfoOutcome) error {
+	output := make(map[string]contractInfoOutput, len(outcomes))
+	for _, outcome := range outcomes {
+		if outcome.err != nil {
+			output[outcome.contract.Hex()] = contractInfoOutput{Error: outcome.err.Error()}
+			continue
+		}
+		result := outcome.result
+		info := contractInfoOutput{
+			StartBlock:             result.StartBlock,
+			EndBlock:               result.EndBlock,
+			Transmissions:          result.TransmissionCount,
+			FirstRound:             result.FirstRound,
+			LastRound:              result.LastRound,
+			FirstAggregatorRoundID: result.FirstAggregatorRoundID,
+			LastAggregatorRoundID:  result.LastAggregatorRoundID,
+			Transmitters:           make([]transmitterCountOutput, 0, len(result.DistinctTransmitters)),
+		}
+		for _, transmitter := range result.DistinctTransmitters {
+			info.Transmitters = append(info.Transmitters, transmitterCountOutput{
+				Address: transmitter.Address,
+				Count:   transmitter.Count,
+			})
+		}
+		output[outcome.contract.Hex()] = info
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
+
+// formatShortRound describes a packed round as epoch/round, e.g. "12/3".
+func formatShortRound(packedRound uint32) string {
+	return fmt.Sprintf("%d/%d", packedRound>>8, packedRound&0xff)
+}
+
+// formatRound describes a packed round with its epoch, round, and aggregator round ID.
+func formatRound(packedRound, aggregatorRoundID uint32) string {
+	return fmt.Sprintf("epoch %d round %d (packed %d, aggregator round %d)",
+		packedRound>>8, packedRound&0xff, packedRound, aggregatorRoundID)
+}
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInfoCommand_MultipleContracts(t *testing.T) {
+	first := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	second := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	transmitter := common.HexToAddress("0x3000000000000000000000000000000000000003")
+
+	results := map[common.Address]*interfaces.ContractInfoResult{
+		first: {
+			ContractAddress:   first,
+			StartBlock:        100,
+			EndBlock:          200,
+			TransmissionCount: 3,
+			FirstRound:        5<<8 | 1,
+			LastRound:         5<<8 | 3,
+			DistinctTransmitters: []entities.TransmitterCount{
+				{Address: transmitter, Count: 3},
+			},
+		},
+		second: {
+			ContractAddress:   second,
+			StartBlock:        100,
+			EndBlock:          200,
+			TransmissionCount: 1,
+			FirstRound:        9<<8 | 2,
+			LastRound:         9<<8 | 2,
+			DistinctTransmitters: []entities.TransmitterCount{
+				{Address: transmitter, Count: 1},
+			},
+		},
+	}
+
+	run := func(t *testing.T, args ...string) (*bytes.Buffer, error) {
+		ctrl := gomock.NewController(t)
+		useCase := mocks.NewMockContractInfoUseCase(ctrl)
+		useCase.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, params interfaces.ContractInfoParams) (*interfaces.ContractInfoResult, error) {
+				result, ok := results[params.ContractAddress]
+				if !ok {
+					return nil, fmt.Errorf("no transmissions")
+				}
+				return result, nil
+			}).Times(2)
+
+		cmd := NewInfoCommand(&config.Container{ContractInfoUseCase: useCase})
+		var stdout bytes.Buffer
+		cmd.SetOut(&stdout)
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(args)
+		return &stdout, cmd.Execute()
+	}
+
+	t.Run("text", func(t *testing.T) {
+		stdout, err := run(t, first.Hex(), second.Hex())
+		require.NoError(t, err)
+
+		rows := make(map[string]string)
+		for _, line := range strings.Split(stdout.String(), "\n") {
+			if fields := strings.Fields(line); len(fields) > 0 && strings.HasPrefix(fields[0], "0x") {
+				rows[fields[0]] = strings.Join(fields[1:], " ")
+			}
+		}
+		assert.Equal(t, map[string]string{
+			first.Hex():  "100 - 200 3 5/1 5/3 1",
+			second.Hex(): "100 - 200 1 9/2 9/2 1",
+		}, rows)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		stdout, err := run(t, "-o", "json", first.Hex(), second.Hex())
+		require.NoError(t, err)
+
+		var output map[string]contractInfoOutput
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &output))
+		require.Len(t, output, 2)
+		assert.Equal(t, 3, output[first.Hex()].Transmissions)
+		assert.Equal(t, uint32(5<<8|1), output[first.Hex()].FirstRound)
+		assert.Equal(t, uint32(5<<8|3), output[first.Hex()].LastRound)
+		assert.Equal(t, 1, output[second.Hex()].Transmissions)
+		assert.Equal(t, uint32(9<<8|2), output[second.Hex()].FirstRound)
+		assert.Equal(t, []transmitterCountOutput{{Address: transmitter, Count: 1}}, output[second.Hex()].Transmitters)
+	})
+
+	t.Run("one contract fails", func(t *testing.T) {
+		missing := common.HexToAddress("0x4000000000000000000000000000000000000004")
+		stdout, err := run(t, first.Hex(), missing.Hex())
+		require.EqualError(t, err, "failed to get contract info for 1 of 2 contracts")
+		assert.Contains(t, stdout.String(), first.Hex())
+		assert.Contains(t, stdout.String(), missing.Hex()+"  error: no transmissions")
+	})
+}
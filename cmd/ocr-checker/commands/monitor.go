@@ -0,0 +1,468 @@
+// Package commands provides CLI command implementations for the OCR checker tool.
+// It contains the fetch, parse, watch, and version commands with their associated flags and handlers.
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"chainlink-ocr-checker/infrastructure/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/robfig/cron/v3"
+	"github.com/spf13/cobra"
+)
+
+// NewMonitorCommand creates the monitor command.
+func NewMonitorCommand(container *config.Container) *cobra.Command {
+	var (
+		interval      string
+		listenAddr    string
+		daysToIgnore  int
+		logSampleRate int
+		alertCooldown time.Duration
+		concurrency   int
+		stateFile     string
+		onError       string
+		recoverPanics bool
+		notifierNames []string
+		noActive      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "monitor [transmitter] [rounds_to_check] [days_to_ignore]",
+		Short: "Continuously watch a transmitter and expose Prometheus metrics",
+		Long: `Runs the watch check on a schedule and exposes the results as Prometheus
+metrics on /metrics, including per-observer transmission counts for each
+contract in the checked window. /health and /status report the last check as
+JSON and return 503 once no check has finished for twice the interval. When notifiers are configured, an alert is
+sent each time the health status changes, routed by severity per [routing].
+Repeats of a status alerted within --alert-cooldown are suppressed unless it
+escalates; a problem that persists past the cooldown is alerted again.
+With --state-file the last-known gauge values are saved on shutdown and
+exported again on startup until the first check completes.
+A contract whose RPC lookups fail marks its jobs as errors; with
+--on-contract-error retry it is fetched once more, 2s later, first.
+With --recover-panics a check that panics is logged with its stack, counted in
+ocr_checker_check_panics_total, and recorded as a failed check instead of
+stopping the monitor.
+With --no-active-status critical, jobs that are not active on the node raise
+the status, and alert, like missing ones, explained as an absent transmitter.
+The transmitter may be omitted when default_transmitter is set in the config.`,
+		Args: cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse the schedule before anything starts so a bad interval fails fast.
+			schedule, err := parseMonitorInterval(interval)
+			if err != nil {
+				return err
+			}
+
+			noActiveStatus, err := parseNoActiveStatus(noActive)
+			if err != nil {
+				return err
+			}
+
+			contractErrors := interfaces.ContractErrorPolicy(onError)
+			if contractErrors != interfaces.ContractErrorFail && contractErrors != interfaces.ContractErrorRetry {
+				return fmt.Errorf("invalid --on-contract-error %q (expected error or retry)", onError)
+			}
+
+			// Check if database is configured.
+			if container.WatchTransmittersUseCase == nil {
+				return fmt.Errorf("database configuration required for monitor command")
+			}
+
+			alertNotifier := container.AlertNotifier
+			if len(notifierNames) > 0 {
+				alertNotifier, err = container.SelectAlertNotifier(notifierNames)
+				if err != nil {
+					return fmt.Errorf("invalid --notifier: %w", err)
+				}
+			}
+
+			// Parse arguments.
+			transmitterAddr, args, err := resolveTransmitterArgs(args, container.Config.DefaultTransmitter)
+			if err != nil {
+				return err
+			}
+
+			roundsToCheck, err := parseInt(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid rounds to check: %w", err)
+			}
+
+			// Days to ignore is optional.
+			if len(args) > 1 {
+				daysToIgnore, err = parseInt(args[1])
+				if err != nil {
+					return fmt.Errorf("invalid days to ignore: %w", err)
+				}
+			}
+
+			// The container's recorder also holds the notifier metrics.
+			recorder := container.Metrics
+			if recorder == nil {
+				recorder = metrics.NewPrometheusRecorder()
+			}
+			if stateFile != "" {
+				restoreMonitorMetrics(recorder, stateFile, container.Logger)
+			}
+			monitor := services.NewTransmitterMonitor(
+				container.WatchTransmittersUseCase,
+				recorder,
+				alertNotifier,
+				services.AlertMessageOptions{
+					HealthScorePrecision: container.Config.HealthScorePrecision,
+					ChainID:              container.Config.ChainID,
+					NoActiveStatus:       noActiveStatus,
+				},
+				container.Logger,
+				interfaces.WatchTransmittersParams{
+					TransmitterAddress: transmitterAddr,
+					RoundsToCheck:      roundsToCheck,
+					DaysToIgnore:       daysToIgnore,
+					Concurrency:        concurrency,
+					ContractErrors:     contractErrors,
+				},
+				services.MonitorOptions{
+					LogSampleRate: logSampleRate,
+					AlertCooldown: alertCooldown,
+					RecoverPanics: recoverPanics,
+				},
+			)
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			// Start metrics server.
+			statusHandler := newMonitorStatusHandler(monitor, 2*scheduleInterval(schedule, time.Now()), time.Now)
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", recorder.Handler())
+			mux.Handle("/health", statusHandler)
+			mux.Handle("/status", statusHandler)
+			server := &http.Server{
+				Addr:              listenAddr,
+				Handler:           mux,
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+
+			// Restart the server if it dies; give up only when it can't rebind.
+			serverErr := make(chan error, 1)
+			go func() {
+				supervisor := serverSupervisor{
+					maxRestarts: metricsServerRestarts,
+					backoff:     metricsServerBackoff,
+					maxBackoff:  metricsServerMaxBackoff,
+					logger:      container.Logger,
+				}
+				serverErr <- supervisor.run(ctx, server.ListenAndServe)
+			}()
+
+			container.Logger.Info("Monitor started",
+				"transmitter", transmitterAddr.Hex(),
+				"interval", interval,
+				"listen", listenAddr)
+			printNextRuns(cmd.OutOrStdout(), schedule, time.Now(), monitorPreviewRuns)
+
+			// Run an initial check, then follow the schedule.
+			_, _ = monitor.Check(ctx)
+
+			// A check still running when the next one is due makes the next one skip.
+			scheduler := cron.New(cron.WithChain(cron.SkipIfStillRunning(skippedCheckLogger{container.Logger})))
+			scheduler.Schedule(schedule, cron.FuncJob(func() {
+				_, _ = monitor.Check(ctx)
+			}))
+			scheduler.Start()
+
+			select {
+			case <-ctx.Done():
+			case err := <-serverErr:
+				if err != nil {
+					<-scheduler.Stop().Done()
+					if stateFile != "" {
+						saveMonitorMetrics(recorder, stateFile, container.Logger)
+					}
+					return fmt.Errorf("metrics server failed: %w", err)
+				}
+			}
+
+			container.Logger.Info("Monitor stopping")
+			<-scheduler.Stop().Done()
+			if stateFile != "" {
+				saveMonitorMetrics(recorder, stateFile, container.Logger)
+			}
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			return server.Shutdown(shutdownCtx)
+		},
+	}
+
+	// Add flags.
+	cmd.Flags().StringVar(&interval, "interval", "@every 5m",
+		"Check schedule: a duration (5m), @every <duration>, a descriptor (@hourly), or a 5-field cron expression")
+	cmd.Flags().StringVar(&listenAddr, "listen", ":9090", "Address for the Prometheus metrics and status endpoints")
+	cmd.Flags().IntVarP(&daysToIgnore, "days", "d", 0, "Days to ignore for stale detection")
+	cmd.Flags().IntVar(&concurrency, "concurrency", interfaces.DefaultWatchConcurrency, "Number of jobs checked at once")
+	cmd.Flags().IntVar(&logSampleRate, "log-sample-rate", services.DefaultMonitorLogSampleRate,
+		"Log routine check completions every N checks (1 logs every check); failures and status changes are always logged")
+	cmd.Flags().DurationVar(&alertCooldown, "alert-cooldown", services.DefaultMonitorAlertCooldown,
+		"Suppress repeat alerts for the same status within this window unless it escalates; 0 alerts on every change")
+	cmd.Flags().StringVar(&stateFile, "state-file", "",
+		"Save last-known metric values here on shutdown and restore them on startup")
+	cmd.Flags().StringVar(&onError, "on-contract-error", string(interfaces.ContractErrorFail),
+		"When a contract's RPC lookups fail: error marks its jobs as errors, retry fetches it once more first")
+	cmd.Flags().BoolVar(&recoverPanics, "recover-panics", false,
+		"Recover a check that panics, log it, and keep monitoring instead of exiting")
+	cmd.Flags().StringSliceVar(&notifierNames, "notifier", nil,
+		"Send alerts only to these configured notifiers (email, slack, pagerduty), ignoring [routing]")
+	cmd.Flags().StringVar(&noActive, "no-active-status", "ok",
+		"Health status jobs that are not active on the node raise alerts to (ok, warning, critical)")
+
+	return cmd
+}
+
+// skippedCheckLogger logs the scheduled checks skipped because the previous
+// check was still running.
+type skippedCheckLogger struct {
+	logger interfaces.Logger
+}
+
+// Info logs a skipped check; the scheduler wrapper only reports skips here.
+func (l skippedCheckLogger) Info(_ string, _ ...interface{}) {
+	l.logger.Warn("Monitor check skipped, previous check still running")
+}
+
+// Error logs a scheduler error.
+func (l skippedCheckLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, append(keysAndValues, "error", err)...)
+}
+
+// parseNoActiveStatus parses the --no-active-status flag; ok leaves No Active
+// jobs out of the alert status.
+func parseNoActiveStatus(value string) (entities.HealthStatus, error) {
+	switch strings.ToLower(value) {
+	case "ok":
+		return entities.HealthStatusOK, nil
+	case "warning":
+		return entities.HealthStatusWarning, nil
+	case "critical":
+		return entities.HealthStatusCritical, nil
+	default:
+		return entities.HealthStatusOK, fmt.Errorf("invalid --no-active-status %q (expected ok, warning, or critical)", value)
+	}
+}
+
+// restoreMonitorMetrics exports the metrics saved by a previous run, if any.
+func restoreMonitorMetrics(recorder *metrics.PrometheusRecorder, path string, logger interfaces.Logger) {
+	snapshot, err := metrics.ReadSnapshot(path)
+	if err != nil {
+		// The first run has nothing to restore.
+		if !stderrors.Is(err, fs.ErrNotExist) {
+			logger.Warn("Failed to restore metrics", "path", path, "error", err)
+		}
+		return
+	}
+
+	recorder.Restore(snapshot)
+	logger.Info("Restored metrics", "path", path, "saved_at", snapshot.SavedAt, "targets", len(snapshot.Targets))
+}
+
+// saveMonitorMetrics saves the last-known metrics for the next run.
+func saveMonitorMetrics(recorder *metrics.PrometheusRecorder, path string, logger interfaces.Logger) {
+	if err := metrics.WriteSnapshot(path, recorder.Snapshot()); err != nil {
+		logger.Error("Failed to save metrics", "path", path, "error", err)
+	}
+}
+
+// monitorPreviewRuns is the number of upcoming run times printed at startup.
+const monitorPreviewRuns = 3
+
+// Metrics server restart policy.
+const (
+	// metricsServerRestarts is the number of consecutive failed restarts after
+	// which the monitor exits.
+	metricsServerRestarts = 5
+	// metricsServerBackoff is the wait before the first restart, doubled for each further one.
+	metricsServerBackoff = time.Second
+	// metricsServerMaxBackoff caps the wait between restarts.
+	metricsServerMaxBackoff = 30 * time.Second
+)
+
+// serverSupervisor keeps a server running, restarting it with exponential
+// backoff when it exits with an error.
+type serverSupervisor struct {
+	maxRestarts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	logger      interfaces.Logger
+}
+
+// run calls serve until ctx is done or the server is shut down, and returns
+// nil in either case. A server that fails is restarted; a run that lasted
+// longer than maxBackoff resets the backoff, so only quick consecutive
+// failures, such as a port that can't be rebound, count toward maxRestarts.
+// Once they are exhausted the last error is returned.
+func (s serverSupervisor) run(ctx context.Context, serve func() error) error {
+	backoff := s.backoff
+	failures := 0
+	for {
+		started := time.Now()
+		err := serve()
+		if err == nil || stderrors.Is(err, http.ErrServerClosed) || ctx.Err() != nil {
+			return nil
+		}
+
+		if time.Since(started) > s.maxBackoff {
+			backoff = s.backoff
+			failures = 0
+		}
+		if failures >= s.maxRestarts {
+			s.logger.Error("Metrics server failed, giving up", "restarts", failures, "error", err)
+			return err
+		}
+		failures++
+
+		s.logger.Warn("Metrics server failed, restarting",
+			"attempt", failures,
+			"backoff", backoff.String(),
+			"error", err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, s.maxBackoff)
+	}
+}
+
+// monitorIntervalHelp lists the accepted interval formats.
+const monitorIntervalHelp = `accepted formats:
+  duration          5m, 90s, 1h30m (same as @every <duration>)
+  @every <duration> @every 5m
+  descriptor        @hourly, @daily, @weekly, @monthly, @yearly
+  cron expression   "*/10 * * * *" (minute hour day-of-month month day-of-week)`
+
+// parseMonitorInterval parses the --interval value into a cron schedule.
+// A bare duration is accepted as shorthand for @every <duration>.
+func parseMonitorInterval(interval string) (cron.Schedule, error) {
+	spec := strings.TrimSpace(interval)
+	if spec == "" {
+		return nil, fmt.Errorf("interval is required\n%s", monitorIntervalHelp)
+	}
+
+	if d, err := time.ParseDuration(spec); err == nil {
+		if d <= 0 {
+			return nil, fmt.Errorf("invalid interval %q: duration must be positive\n%s", interval, monitorIntervalHelp)
+		}
+		spec = "@every " + spec
+	}
+
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid interval %q: %w\n%s", interval, err, monitorIntervalHelp)
+	}
+
+	return schedule, nil
+}
+
+// printNextRuns prints the next scheduled run times after from.
+func printNextRuns(out io.Writer, schedule cron.Schedule, from time.Time, count int) {
+	_, _ = fmt.Fprintf(out, "Next scheduled checks:\n")
+	next := from
+	for i := 0; i < count; i++ {
+		next = schedule.Next(next)
+		_, _ = fmt.Fprintf(out, "  %s\n", next.Format(time.RFC3339))
+	}
+}
+
+// scheduleInterval returns the gap between the two runs of schedule following from.
+func scheduleInterval(schedule cron.Schedule, from time.Time) time.Duration {
+	next := schedule.Next(from)
+	return schedule.Next(next).Sub(next)
+}
+
+// monitorStatusResponse is the JSON body of the monitor's /health and /status endpoints.
+type monitorStatusResponse struct {
+	Status                string             `json:"status"`
+	Transmitter           common.Address     `json:"transmitter"`
+	Health                string             `json:"health,omitempty"`
+	LastCheck             *time.Time         `json:"last_check"`
+	SecondsSinceLastCheck int64              `json:"seconds_since_last_check"`
+	ConsecutiveFailures   int                `json:"consecutive_failures"`
+	LastError             string             `json:"last_error,omitempty"`
+	Jobs                  []monitorJobStatus `json:"jobs"`
+}
+
+// monitorJobStatus is the last status of one job of the monitored transmitter.
+type monitorJobStatus struct {
+	JobID    string             `json:"job_id"`
+	Contract common.Address     `json:"contract"`
+	Status   entities.JobStatus `json:"status"`
+	Reason   string             `json:"reason,omitempty"`
+}
+
+// newMonitorStatusHandler serves the monitor status as JSON. It responds with
+// 503 when no check has finished within staleAfter, counting from monitor start
+// until the first check, which indicates a stalled scheduler.
+func newMonitorStatusHandler(
+	monitor *services.TransmitterMonitor,
+	staleAfter time.Duration,
+	now func() time.Time,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		status := monitor.Status()
+
+		since := status.StartedAt
+		response := monitorStatusResponse{
+			Status:              "ok",
+			Transmitter:         status.Transmitter,
+			ConsecutiveFailures: status.ConsecutiveFailures,
+			LastError:           status.LastError,
+			Jobs:                []monitorJobStatus{},
+		}
+		if !status.LastCheck.IsZero() {
+			lastCheck := status.LastCheck.UTC()
+			response.LastCheck = &lastCheck
+			since = status.LastCheck
+		}
+		if result := status.LastResult; result != nil {
+			response.Health = result.Summary.HealthStatus().String()
+			for _, job := range result.Statuses {
+				response.Jobs = append(response.Jobs, monitorJobStatus{
+					JobID:    job.JobID,
+					Contract: job.ContractAddress,
+					Status:   job.Status,
+					Reason:   job.Reason,
+				})
+			}
+		}
+
+		elapsed := now().Sub(since)
+		response.SecondsSinceLastCheck = int64(elapsed / time.Second)
+
+		code := http.StatusOK
+		if elapsed > staleAfter {
+			response.Status = "stale"
+			code = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(response)
+	})
+}
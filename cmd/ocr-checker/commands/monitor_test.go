@@ -0,0 +1,268 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMonitorInterval(t *testing.T) {
+	from := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		interval string
+		next     time.Time
+	}{
+		{name: "bare duration", interval: "5m", next: from.Add(5 * time.Minute)},
+		{name: "every", interval: "@every 90s", next: from.Add(90 * time.Second)},
+		{name: "descriptor", interval: "@hourly", next: from.Add(time.Hour)},
+		{name: "cron expression", interval: "*/10 * * * *", next: from.Add(10 * time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := parseMonitorInterval(tt.interval)
+			require.NoError(t, err)
+			assert.Equal(t, tt.next, schedule.Next(from).UTC())
+		})
+	}
+
+	for _, invalid := range []string{"", "5 minutes", "-5m", "* * *", "@every"} {
+		t.Run("invalid "+invalid, func(t *testing.T) {
+			_, err := parseMonitorInterval(invalid)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "accepted formats")
+		})
+	}
+}
+
+func TestParseNoActiveStatus(t *testing.T) {
+	for value, want := range map[string]entities.HealthStatus{
+		"ok":       entities.HealthStatusOK,
+		"Warning":  entities.HealthStatusWarning,
+		"CRITICAL": entities.HealthStatusCritical,
+	} {
+		status, err := parseNoActiveStatus(value)
+		require.NoError(t, err)
+		assert.Equal(t, want, status)
+	}
+
+	_, err := parseNoActiveStatus("page")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--no-active-status")
+}
+
+func TestPrintNextRuns(t *testing.T) {
+	schedule, err := parseMonitorInterval("@every 5m")
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	printNextRuns(&out, schedule, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), 3)
+
+	assert.Equal(t, "Next scheduled checks:\n"+
+		"  2024-01-01T12:05:00Z\n"+
+		"  2024-01-01T12:10:00Z\n"+
+		"  2024-01-01T12:15:00Z\n", out.String())
+}
+
+func TestMonitorCommand_InvalidIntervalFailsFast(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// No expectations: the command must not run a check or log startup.
+	container := &config.Container{
+		Config:                   &config.Config{},
+		Logger:                   mocks.NewMockLogger(ctrl),
+		WatchTransmittersUseCase: mocks.NewMockWatchTransmittersUseCase(ctrl),
+	}
+
+	// Reserve a free port, then release it for the command.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	cmd := NewMonitorCommand(container)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{
+		"--interval", "every five minutes",
+		"--listen", addr,
+		"0xa000000000000000000000000000000000000000", "10",
+	})
+
+	err = cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid interval "every five minutes"`)
+	assert.Contains(t, err.Error(), "@every 5m")
+
+	// Nothing is listening on the address.
+	_, dialErr := net.DialTimeout("tcp", addr, 200*time.Millisecond)
+	assert.Error(t, dialErr)
+}
+
+func TestMonitorStatusHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	params := interfaces.WatchTransmittersParams{
+		TransmitterAddress: common.HexToAddress("0xa000000000000000000000000000000000000000"),
+		RoundsToCheck:      10,
+	}
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	result := &interfaces.WatchTransmittersResult{
+		Statuses: []entities.TransmitterStatus{{
+			JobID:           "job-1",
+			ContractAddress: contract,
+			Status:          entities.JobStatusStale,
+			Reason:          "last tx 26h ago exceeds 24h threshold",
+		}},
+		Summary: interfaces.TransmitterSummary{TotalJobs: 1, StaleJobs: 1},
+	}
+
+	watchUseCase := mocks.NewMockWatchTransmittersUseCase(ctrl)
+	watchUseCase.EXPECT().Execute(gomock.Any(), params).Return(result, nil)
+	watchUseCase.EXPECT().Execute(gomock.Any(), params).Return(nil, errors.New("db down"))
+	recorder := mocks.NewMockMetricsRecorder(ctrl)
+	recorder.EXPECT().RecordWatchResult(gomock.Any(), gomock.Any()).AnyTimes()
+	recorder.EXPECT().RecordCheckError(gomock.Any()).AnyTimes()
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	monitor := services.NewTransmitterMonitor(watchUseCase, recorder, nil, services.AlertMessageOptions{},
+		logger, params, services.MonitorOptions{})
+	_, err := monitor.Check(context.Background())
+	require.NoError(t, err)
+	_, err = monitor.Check(context.Background())
+	require.Error(t, err)
+	lastCheck := monitor.Status().LastCheck
+
+	get := func(now time.Time) (int, monitorStatusResponse) {
+		handler := newMonitorStatusHandler(monitor, 10*time.Minute, func() time.Time { return now })
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/status", nil))
+
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		var response monitorStatusResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return rec.Code, response
+	}
+
+	t.Run("fresh", func(t *testing.T) {
+		code, response := get(lastCheck.Add(90 * time.Second))
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "ok", response.Status)
+		assert.Equal(t, params.TransmitterAddress, response.Transmitter)
+		require.NotNil(t, response.LastCheck)
+		assert.True(t, lastCheck.Equal(*response.LastCheck))
+		assert.Equal(t, int64(90), response.SecondsSinceLastCheck)
+		assert.Equal(t, 1, response.ConsecutiveFailures)
+		assert.Equal(t, "db down", response.LastError)
+		assert.Equal(t, entities.HealthStatusWarning.String(), response.Health)
+		assert.Equal(t, []monitorJobStatus{{
+			JobID:    "job-1",
+			Contract: contract,
+			Status:   entities.JobStatusStale,
+			Reason:   "last tx 26h ago exceeds 24h threshold",
+		}}, response.Jobs)
+	})
+
+	t.Run("stalled scheduler", func(t *testing.T) {
+		code, response := get(lastCheck.Add(30 * time.Minute))
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, "stale", response.Status)
+		assert.Equal(t, int64(1800), response.SecondsSinceLastCheck)
+	})
+}
+
+func TestScheduleInterval(t *testing.T) {
+	schedule, err := parseMonitorInterval("*/10 * * * *")
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, scheduleInterval(schedule, time.Date(2024, 1, 1, 12, 3, 0, 0, time.UTC)))
+}
+
+func TestServerSupervisor(t *testing.T) {
+	newSupervisor := func(ctrl *gomock.Controller) (serverSupervisor, *mocks.MockLogger) {
+		logger := mocks.NewMockLogger(ctrl)
+		return serverSupervisor{
+			maxRestarts: 3,
+			backoff:     time.Millisecond,
+			maxBackoff:  time.Minute,
+			logger:      logger,
+		}, logger
+	}
+
+	t.Run("restarts a failed server", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		supervisor, logger := newSupervisor(ctrl)
+		logger.EXPECT().Warn("Metrics server failed, restarting", gomock.Any()).Times(2)
+
+		// The server dies twice, then runs until it is shut down.
+		calls := 0
+		serve := func() error {
+			calls++
+			if calls <= 2 {
+				return errors.New("accept tcp [::]:9090: use of closed network connection")
+			}
+			return http.ErrServerClosed
+		}
+
+		require.NoError(t, supervisor.run(context.Background(), serve))
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("gives up when it can't rebind", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		supervisor, logger := newSupervisor(ctrl)
+		logger.EXPECT().Warn("Metrics server failed, restarting", gomock.Any()).Times(3)
+		logger.EXPECT().Error("Metrics server failed, giving up", gomock.Any())
+
+		// Hold the port so every attempt to listen on it fails.
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer func() { _ = listener.Close() }()
+		server := &http.Server{Addr: listener.Addr().String(), ReadHeaderTimeout: time.Second}
+
+		err = supervisor.run(context.Background(), server.ListenAndServe)
+		assert.ErrorContains(t, err, "address already in use")
+	})
+
+	t.Run("stops on cancel", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		supervisor, logger := newSupervisor(ctrl)
+		supervisor.backoff = time.Hour
+		logger.EXPECT().Warn(gomock.Any(), gomock.Any())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		serve := func() error {
+			cancel()
+			return errors.New("listener closed")
+		}
+		// Canceled while serving: no restart and no error.
+		require.NoError(t, supervisor.run(ctx, serve))
+
+		// Canceled while waiting to restart.
+		ctx, cancel = context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+		require.NoError(t, supervisor.run(ctx, func() error { return errors.New("listener closed") }))
+	})
+}
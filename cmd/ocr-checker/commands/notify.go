@@ -0,0 +1,64 @@
+// Package commands provides CLI command implementations for the OCR checker tool.
+// It contains the fetch, parse, watch, and version commands with their associated flags and handlers.
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/infrastructure/config"
+	"github.com/spf13/cobra"
+)
+
+// NewNotifyTestCommand creates the notify-test command.
+func NewNotifyTestCommand(container *config.Container) *cobra.Command {
+	var incidents bool
+
+	cmd := &cobra.Command{
+		Use:   "notify-test",
+		Short: "Send a test message through every configured notifier",
+		Long: `Sends a benign "OCR Checker notification test" message through every
+configured notifier and reports whether each backend delivered it.
+
+PagerDuty is skipped unless --pagerduty is set, since any event there pages
+on-call. With it, a test incident is opened and resolved right away.`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if len(container.Notifiers) == 0 {
+				return fmt.Errorf("no notifiers configured")
+			}
+
+			// Create context.
+			ctx := context.Background()
+
+			results := services.CheckNotifiers(ctx, container.Notifiers, services.NotifierCheckOptions{
+				Incidents: incidents,
+			})
+
+			failed := 0
+			for _, result := range results {
+				if result.Skipped {
+					fmt.Printf("%-12s SKIPPED (use --pagerduty to open and resolve a test incident)\n", result.Name)
+					continue
+				}
+				if result.Err != nil {
+					failed++
+					fmt.Printf("%-12s FAILED: %v\n", result.Name, result.Err)
+					continue
+				}
+				fmt.Printf("%-12s OK\n", result.Name)
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d notifiers failed", failed, len(results))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&incidents, "pagerduty", false, "Also test PagerDuty by opening a test incident and resolving it")
+
+	return cmd
+}
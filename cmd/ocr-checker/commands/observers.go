@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+// observerOutput is the JSON shape of one observer index.
+type observerOutput struct {
+	Index       uint8           `json:"index"`
+	Transmitter common.Address  `json:"transmitter"`
+	Signer      *common.Address `json:"signer,omitempty"`
+}
+
+// observersOutput is the JSON shape of the observers command.
+type observersOutput struct {
+	Contract     common.Address   `json:"contract"`
+	BlockNumber  uint64           `json:"block_number"`
+	ConfigDigest string           `json:"config_digest"`
+	Observers    []observerOutput `json:"observers"`
+}
+
+// NewObserversCommand creates the observers command.
+func NewObserversCommand(container *config.Container) *cobra.Command {
+	var (
+		atBlock      uint64
+		outputFormat string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "observers [contract]",
+		Short: "Show which transmitter each observer index belongs to",
+		Long: `Reads the OCR2 configuration of a contract and lists its observer indices
+in order with the transmitter, and signer, at each index. The indices are the
+ones transmissions report in their observers field.
+
+Without --at-block the current config is shown. With --at-block the config in
+effect at that block is read from the contract, which does not expose signers,
+so only transmitters are listed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse arguments.
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+
+			if outputFormat != OutputFormatText && outputFormat != OutputFormatJSON {
+				return fmt.Errorf("invalid output format: %s (expected text or json)", outputFormat)
+			}
+
+			params := interfaces.ObserversParams{
+				ContractAddress: common.HexToAddress(args[0]),
+			}
+			if cmd.Flags().Changed("at-block") {
+				params.AtBlock = &atBlock
+			}
+
+			// Execute use case.
+			result, err := container.ObserversUseCase.Execute(context.Background(), params)
+			if err != nil {
+				reportValidationErrors(cmd, err, outputFormat)
+				return fmt.Errorf("failed to get observers: %w", err)
+			}
+
+			if outputFormat == OutputFormatJSON {
+				return displayObserversJSON(cmd.OutOrStdout(), result)
+			}
+			return displayObserversText(cmd.OutOrStdout(), result)
+		},
+	}
+
+	// Add flags.
+	cmd.Flags().Uint64Var(&atBlock, "at-block", 0, "Show the config in effect at this block (default: current)")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", OutputFormatText, "Output format (text, json)")
+
+	return cmd
+}
+
+// displayObserversText displays the observers as a table in index order.
+func displayObserversText(out io.Writer, result *interfaces.ObserversResult) error {
+	_, _ = fmt.Fprintf(out, "Contract: %s\n", result.ContractAddress.Hex())
+	_, _ = fmt.Fprintf(out, "Config digest: %x (block %d)\n\n", result.ConfigDigest, result.BlockNumber)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "Index\tTransmitter\tSigner")
+	_, _ = fmt.Fprintln(w, "-----\t-----------\t------")
+
+	for _, observer := range result.Observers {
+		signer := "-"
+		if observer.Signer != nil {
+			signer = observer.Signer.Hex()
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\n", observer.Index, observer.Transmitter.Hex(), signer)
+	}
+
+	return w.Flush()
+}
+
+// displayObserversJSON displays the observers in JSON format.
+func displayObserversJSON(out io.Writer, result *interfaces.ObserversResult) error {
+	output := observersOutput{
+		Contract:     result.ContractAddress,
+		BlockNumber:  result.BlockNumber,
+		ConfigDigest: fmt.Sprintf("%x", result.ConfigDigest),
+		Observers:    make([]observerOutput, 0, len(result.Observers)),
+	}
+	for _, observer := range result.Observers {
+		output.Observers = append(output.Observers, observerOutput{
+			Index:       observer.Index,
+			Transmitter: observer.Transmitter,
+			Signer:      observer.Signer,
+		})
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
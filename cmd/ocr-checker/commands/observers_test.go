@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"chainlink-ocr-checker/application/usecases"
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/infrastructure/config"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserversCommand(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	address := func(b byte) common.Address { return common.BytesToAddress([]byte{b}) }
+	transmitters := []common.Address{address(3), address(1), address(2)}
+	signers := []common.Address{address(0x13), address(0x11), address(0x12)}
+
+	run := func(t *testing.T, expect func(*mocks.MockOCR2AggregatorService), args ...string) *bytes.Buffer {
+		ctrl := gomock.NewController(t)
+		aggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+		expect(aggregator)
+		logger := mocks.NewMockLogger(ctrl)
+		logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+		cmd := NewObserversCommand(&config.Container{
+			ObserversUseCase: usecases.NewObserversUseCase(aggregator, logger),
+		})
+		var stdout bytes.Buffer
+		cmd.SetOut(&stdout)
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(append(args, contract.Hex()))
+		require.NoError(t, cmd.Execute())
+		return &stdout
+	}
+
+	current := func(aggregator *mocks.MockOCR2AggregatorService) {
+		aggregator.EXPECT().GetLatestConfigSet(gomock.Any(), contract).Return(&entities.ConfigSetEvent{
+			BlockNumber: 1234,
+			Config: entities.OCR2Config{
+				ConfigDigest: [32]byte{0xab},
+				Transmitters: transmitters,
+				Signers:      signers,
+			},
+		}, nil)
+	}
+
+	t.Run("text", func(t *testing.T) {
+		stdout := run(t, current)
+
+		// The rows follow the config's index order, not address order.
+		var rows []string
+		for _, line := range strings.Split(stdout.String(), "\n") {
+			if fields := strings.Fields(line); len(fields) == 3 && strings.HasPrefix(fields[1], "0x") {
+				rows = append(rows, strings.Join(fields, " "))
+			}
+		}
+		assert.Equal(t, []string{
+			"0 " + address(3).Hex() + " " + address(0x13).Hex(),
+			"1 " + address(1).Hex() + " " + address(0x11).Hex(),
+			"2 " + address(2).Hex() + " " + address(0x12).Hex(),
+		}, rows)
+		assert.Contains(t, stdout.String(), "(block 1234)")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		stdout := run(t, current, "-o", "json")
+
+		var output observersOutput
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &output))
+		require.Len(t, output.Observers, 3)
+		for i, observer := range output.Observers {
+			assert.Equal(t, uint8(i), observer.Index)
+			assert.Equal(t, transmitters[i], observer.Transmitter)
+			require.NotNil(t, observer.Signer)
+			assert.Equal(t, signers[i], *observer.Signer)
+		}
+	})
+
+	t.Run("at block", func(t *testing.T) {
+		stdout := run(t, func(aggregator *mocks.MockOCR2AggregatorService) {
+			aggregator.EXPECT().GetConfigFromBlock(gomock.Any(), contract, uint64(900)).Return(&entities.OCR2Config{
+				ConfigDigest: [32]byte{0xcd},
+				Transmitters: transmitters,
+			}, nil)
+		}, "--at-block", "900", "-o", "json")
+
+		var output observersOutput
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &output))
+		assert.Equal(t, uint64(900), output.BlockNumber)
+		require.Len(t, output.Observers, 3)
+		assert.Equal(t, address(3), output.Observers[0].Transmitter)
+		assert.Nil(t, output.Observers[0].Signer)
+		assert.NotContains(t, stdout.String(), `"signer"`)
+	})
+}
@@ -3,33 +3,55 @@
 package commands
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"strings"
+	"syscall"
 
 	"chainlink-ocr-checker/domain/interfaces"
 	"chainlink-ocr-checker/infrastructure/config"
 	"github.com/spf13/cobra"
 )
 
+// defaultParseBufferSize is the output buffer size of the parse command.
+const defaultParseBufferSize = 64 * 1024
+
 // NewParseCommand creates the parse command.
 func NewParseCommand(container *config.Container) *cobra.Command {
 	var (
 		outputFormat string
 		outputPath   string
+		fromRound    uint32
+		toRound      uint32
+		allAddresses bool
+		configDigest string
+		bufferSize   int
 	)
 	
 	cmd := &cobra.Command{
-		Use:   "parse [input_file] [group_by]",
+		Use:   "parse [input_file...] [group_by]",
 		Short: "Parse and analyze transmission data",
-		Long: `Parses transmission data from a YAML/JSON file and generates
-observer activity reports grouped by day, month, or round.`,
-		Args: cobra.ExactArgs(2),
-		RunE: func(_ *cobra.Command, args []string) error {
+		Long: `Parses transmission data from a YAML, JSON, JSON-lines, or protobuf
+(.protobuf/.pb) file and generates observer activity reports grouped by day,
+month, round, or epoch. --config-digest limits the report to one config era.
+--format html renders the activity and detected anomalies as a styled page.
+
+Several input files, or glob patterns such as 'results/*-2024-*.jsonl.gz', are
+analyzed together. Their transmissions are combined in block order, and where
+the files overlap each contract's aggregator round is counted once.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
 			// Parse arguments.
-			inputPath := args[0]
-			groupByStr := args[1]
+			inputPaths, err := expandParseInputs(args[:len(args)-1])
+			if err != nil {
+				return err
+			}
+			groupByStr := args[len(args)-1]
 			
 			// Map group by string to enum.
 			var groupBy interfaces.GroupByUnit
@@ -40,8 +62,19 @@ observer activity reports grouped by day, month, or round.`,
 				groupBy = interfaces.GroupByMonth
 			case "round":
 				groupBy = interfaces.GroupByRound
+			case "epoch":
+				groupBy = interfaces.GroupByEpoch
 			default:
-				return fmt.Errorf("invalid group by unit: %s (use day, month, or round)", groupByStr)
+				return fmt.Errorf("invalid group by unit: %s (use day, month, round, or epoch)", groupByStr)
+			}
+			
+			digest, err := parseConfigDigest(configDigest)
+			if err != nil {
+				return err
+			}
+			
+			if bufferSize < 0 {
+				return fmt.Errorf("--buffer-size must not be negative")
 			}
 			
 			// Map output format string to enum.
@@ -55,16 +88,20 @@ observer activity reports grouped by day, month, or round.`,
 				format = interfaces.OutputFormatText
 			case "yaml":
 				format = interfaces.OutputFormatYAML
+			case "html":
+				format = interfaces.OutputFormatHTML
 			default:
 				format = interfaces.OutputFormatText
 			}
 			
-			// Create context.
-			ctx := context.Background()
+			// Create context; an interrupt stops the analysis of a large file.
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
 			
 			// Determine output writer.
-			var outputWriter *os.File
-			if outputPath != "" {
+			toFile := outputPath != "" && outputPath != stdoutPath
+			var outputWriter io.Writer
+			if toFile {
 				cleanPath := filepath.Clean(outputPath)
 				file, err := os.Create(cleanPath) // #nosec G304 -- path is cleaned
 				if err != nil {
@@ -77,19 +114,36 @@ observer activity reports grouped by day, month, or round.`,
 				}()
 				outputWriter = file
 			} else {
-				outputWriter = os.Stdout
+				outputWriter = cmd.OutOrStdout()
+			}
+			
+			// Buffer the report so large outputs don't cost a write per row. The
+			// deferred flush runs before the file is closed, also on error paths.
+			var buffered *bufio.Writer
+			if bufferSize > 0 {
+				buffered = bufio.NewWriterSize(outputWriter, bufferSize)
+				outputWriter = buffered
+				defer func() { _ = buffered.Flush() }()
 			}
 			
 			// Execute use case.
 			params := interfaces.ParseTransmissionsParams{
-				InputPath:    inputPath,
-				OutputWriter: outputWriter,
-				GroupBy:      groupBy,
-				OutputFormat: format,
+				OutputWriter:     outputWriter,
+				GroupBy:          groupBy,
+				OutputFormat:     format,
+				StartRound:       fromRound,
+				EndRound:         toRound,
+				ShowAllAddresses: allAddresses,
+				ConfigDigest:     digest,
+			}
+			if len(inputPaths) == 1 {
+				params.InputPath = inputPaths[0]
+			} else {
+				params.InputPaths = inputPaths
 			}
 			
 			container.Logger.Info("Parsing transmissions",
-				"input", inputPath,
+				"input", inputPaths,
 				"groupBy", groupBy,
 				"format", format)
 			
@@ -97,10 +151,16 @@ observer activity reports grouped by day, month, or round.`,
 				return fmt.Errorf("failed to parse transmissions: %w", err)
 			}
 			
+			if buffered != nil {
+				if err := buffered.Flush(); err != nil {
+					return fmt.Errorf("failed to write output: %w", err)
+				}
+			}
+			
 			container.Logger.Info("Parsing completed")
 			
-			if outputPath != "" {
-				fmt.Printf("Results saved to: %s\n", outputPath)
+			if toFile {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Results saved to: %s\n", outputPath)
 			}
 			
 			return nil
@@ -108,8 +168,45 @@ observer activity reports grouped by day, month, or round.`,
 	}
 	
 	// Add flags.
-	cmd.Flags().StringVarP(&outputFormat, "format", "f", "text", "Output format (text, json, csv, yaml)")
-	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Output file path (default: stdout)")
+	cmd.Flags().StringVarP(&outputFormat, "format", "f", "text", "Output format (text, json, csv, yaml, html)")
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Output file path, or - for stdout (default: stdout)")
+	cmd.Flags().Uint32Var(&fromRound, "from-round", 0, "First round to parse (requires --to-round)")
+	cmd.Flags().Uint32Var(&toRound, "to-round", 0, "Last round to parse; indexed .jsonl files seek directly to the range")
+	cmd.Flags().BoolVar(&allAddresses, "all-addresses", false,
+		"List every address each observer index mapped to across config rotations (text output)")
+	cmd.Flags().StringVar(&configDigest, "config-digest", "",
+		"Parse only transmissions made under the config with this hex digest")
+	cmd.Flags().IntVar(&bufferSize, "buffer-size", defaultParseBufferSize,
+		"Output buffer size in bytes (0 writes every row directly)")
 	
 	return cmd
+}
+
+// expandParseInputs expands glob patterns among the input arguments, keeping
+// argument order and dropping repeated files. A pattern must match a file.
+func expandParseInputs(args []string) ([]string, error) {
+	seen := make(map[string]bool, len(args))
+	var paths []string
+	for _, arg := range args {
+		matches := []string{arg}
+		if strings.ContainsAny(arg, "*?[") {
+			var err error
+			matches, err = filepath.Glob(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid input pattern %s: %w", arg, err)
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("no input files match %s", arg)
+			}
+		}
+		
+		for _, path := range matches {
+			if !seen[path] {
+				seen[path] = true
+				paths = append(paths, path)
+			}
+		}
+	}
+	
+	return paths, nil
 }
\ No newline at end of file
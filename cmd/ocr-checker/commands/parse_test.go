@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRowWritingParseContainer returns a container whose parse use case writes
+// CSV-like rows to the output, one write per row, and then returns err.
+func newRowWritingParseContainer(t gomock.TestReporter, rows int, err error) *config.Container {
+	ctrl := gomock.NewController(t)
+	useCase := mocks.NewMockParseTransmissionsUseCase(ctrl)
+	useCase.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, params interfaces.ParseTransmissionsParams) error {
+			for i := 0; i < rows; i++ {
+				_, _ = fmt.Fprintf(params.OutputWriter, "%d,0x%040d,%d\n", i, i, i%31)
+			}
+			return err
+		}).AnyTimes()
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	return &config.Container{
+		Config:                    &config.Config{},
+		Logger:                    logger,
+		ParseTransmissionsUseCase: useCase,
+	}
+}
+
+func TestParseCommand_FlushesBufferedOutput(t *testing.T) {
+	const rows = 10000
+
+	for _, tt := range []struct {
+		name string
+		err  error
+	}{
+		{name: "success"},
+		{name: "use case error", err: errors.New("interrupted")},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "report.csv")
+			cmd := NewParseCommand(newRowWritingParseContainer(t, rows, tt.err))
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs([]string{"-f", "csv", "-o", path, "--buffer-size", "4096", "results.jsonl", "round"})
+
+			err := cmd.Execute()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			// Every row reached the file, including the ones still buffered
+			// when the use case returned.
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+			require.Len(t, lines, rows)
+			assert.Equal(t, fmt.Sprintf("%d,0x%040d,%d", rows-1, rows-1, (rows-1)%31), lines[rows-1])
+		})
+	}
+}
+
+func BenchmarkParseCommand_Output(b *testing.B) {
+	const rows = 100000
+
+	for _, bufferSize := range []int{0, defaultParseBufferSize} {
+		b.Run(fmt.Sprintf("buffer=%d", bufferSize), func(b *testing.B) {
+			container := newRowWritingParseContainer(b, rows, nil)
+			path := filepath.Join(b.TempDir(), "report.csv")
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cmd := NewParseCommand(container)
+				cmd.SetOut(&bytes.Buffer{})
+				cmd.SetArgs([]string{"-f", "csv", "-o", path, "--buffer-size", fmt.Sprint(bufferSize),
+					"results.jsonl", "round"})
+				if err := cmd.Execute(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCommand_MultipleInputs(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a-2024-01.json", "a-2024-02.json", "b-2024-01.json"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0o600))
+	}
+
+	run := func(args ...string) (interfaces.ParseTransmissionsParams, error) {
+		ctrl := gomock.NewController(t)
+		var params interfaces.ParseTransmissionsParams
+		useCase := mocks.NewMockParseTransmissionsUseCase(ctrl)
+		useCase.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, p interfaces.ParseTransmissionsParams) error {
+				params = p
+				return nil
+			}).AnyTimes()
+		logger := mocks.NewMockLogger(ctrl)
+		logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+		cmd := NewParseCommand(&config.Container{
+			Config:                    &config.Config{},
+			Logger:                    logger,
+			ParseTransmissionsUseCase: useCase,
+		})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(args)
+		return params, cmd.Execute()
+	}
+
+	t.Run("globs and files", func(t *testing.T) {
+		// The explicit file is already matched by the pattern and read once.
+		params, err := run(filepath.Join(dir, "b-2024-01.json"), filepath.Join(dir, "*-2024-01.json"), "month")
+		require.NoError(t, err)
+		assert.Empty(t, params.InputPath)
+		assert.Equal(t, []string{
+			filepath.Join(dir, "b-2024-01.json"),
+			filepath.Join(dir, "a-2024-01.json"),
+		}, params.InputPaths)
+	})
+
+	t.Run("single file", func(t *testing.T) {
+		params, err := run(filepath.Join(dir, "a-2024-02.json"), "month")
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, "a-2024-02.json"), params.InputPath)
+		assert.Empty(t, params.InputPaths)
+	})
+
+	t.Run("pattern without matches", func(t *testing.T) {
+		_, err := run(filepath.Join(dir, "*-2023-*.json"), "month")
+		assert.ErrorContains(t, err, "no input files match")
+	})
+}
@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"chainlink-ocr-checker/application/services"
+	"github.com/spf13/cobra"
+)
+
+// defaultPruneDir is the directory fetch writes to without --output.
+const defaultPruneDir = "results"
+
+// NewPruneCommand creates the prune command.
+func NewPruneCommand() *cobra.Command {
+	var (
+		dir       string
+		olderThan string
+		dryRun    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "prune --older-than <age>",
+		Short: "Delete fetch result files older than a retention period",
+		Long: `Deletes result files under --dir that were last modified longer ago than
+--older-than. Only files named like fetch output (<contract>-<start>_<end>.<format>,
+optionally with .gz and .idx) are touched, so unrelated files in the directory
+are kept. The age is a number of days such as 30d, or a duration such as 12h.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			age, err := parseRetention(olderThan)
+			if err != nil {
+				return fmt.Errorf("invalid --older-than: %w", err)
+			}
+
+			pruned, err := services.PruneResultFiles(dir, time.Now().Add(-age), dryRun)
+
+			// Report what was removed even when the walk stopped early.
+			out := cmd.OutOrStdout()
+			action := "Removed"
+			if dryRun {
+				action = "Would remove"
+			}
+			var size int64
+			for _, file := range pruned {
+				size += file.Size
+				_, _ = fmt.Fprintf(out, "%s %s\n", action, file.Path)
+			}
+			_, _ = fmt.Fprintf(out, "%s %d files (%d bytes)\n", action, len(pruned), size)
+
+			return err
+		},
+	}
+
+	// Add flags.
+	cmd.Flags().StringVar(&dir, "dir", defaultPruneDir, "Directory holding result files")
+	cmd.Flags().StringVar(&olderThan, "older-than", "", "Remove files older than this age (e.g. 30d, 12h)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "List the files that would be removed without removing them")
+	_ = cmd.MarkFlagRequired("older-than")
+
+	return cmd
+}
+
+// parseRetention parses a retention age given in days (30d) or as a Go duration.
+func parseRetention(value string) (time.Duration, error) {
+	var age time.Duration
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a number of days", value)
+		}
+		age = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		if age, err = time.ParseDuration(value); err != nil {
+			return 0, err
+		}
+	}
+
+	if age <= 0 {
+		return 0, fmt.Errorf("%q must be positive", value)
+	}
+	return age, nil
+}
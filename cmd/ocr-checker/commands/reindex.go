@@ -0,0 +1,69 @@
+// Package commands provides CLI command implementations for the OCR checker tool.
+// It contains the fetch, parse, watch, and version commands with their associated flags and handlers.
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+// NewReindexCommand creates the reindex command.
+func NewReindexCommand(container *config.Container) *cobra.Command {
+	var outputPath string
+
+	cmd := &cobra.Command{
+		Use:   "reindex [file] [contract]",
+		Short: "Re-derive observer indices for previously fetched data",
+		Long: `Re-derives the observer index of every transmission in a previously
+fetched result file by looking up the OCR2 configuration that was active
+on chain, then rewrites the file with the corrected indices.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse arguments.
+			inputPath := args[0]
+			if !common.IsHexAddress(args[1]) {
+				return fmt.Errorf("invalid contract address: %s", args[1])
+			}
+			contractAddr := common.HexToAddress(args[1])
+
+			// Create context.
+			ctx := context.Background()
+
+			// Execute use case.
+			params := interfaces.ReindexTransmissionsParams{
+				InputPath:       inputPath,
+				OutputPath:      outputPath,
+				ContractAddress: contractAddr,
+			}
+
+			result, err := container.ReindexTransmissionsUseCase.Execute(ctx, params)
+			if err != nil {
+				reportValidationErrors(cmd, err, OutputFormatText)
+				return fmt.Errorf("failed to reindex transmissions: %w", err)
+			}
+
+			if outputPath == "" {
+				outputPath = inputPath
+			}
+
+			// Print summary.
+			fmt.Printf("Reindexed %d transmissions for contract %s\n",
+				result.TotalTransmissions, contractAddr.Hex())
+			fmt.Printf("Updated: %d\n", result.UpdatedCount)
+			fmt.Printf("Unresolved: %d\n", result.UnresolvedCount)
+			fmt.Printf("Results saved to: %s\n", outputPath)
+
+			return nil
+		},
+	}
+
+	// Add flags.
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Output file path (default: overwrite input)")
+
+	return cmd
+}
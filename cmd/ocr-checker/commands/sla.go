@@ -0,0 +1,139 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+// slaTimeLayouts are the accepted formats of the --from and --to flags.
+var slaTimeLayouts = []string{time.RFC3339, "2006-01-02"}
+
+// slaOutput is the JSON shape of the sla command.
+type slaOutput struct {
+	Contract           common.Address `json:"contract"`
+	Transmitter        common.Address `json:"transmitter"`
+	From               time.Time      `json:"from"`
+	To                 time.Time      `json:"to"`
+	ParticipatedRounds int            `json:"participated_rounds"`
+	ExpectedRounds     int            `json:"expected_rounds"`
+	TotalRounds        int            `json:"total_rounds"`
+	Percentage         float64        `json:"percentage"`
+}
+
+// NewSLACommand creates the sla command.
+func NewSLACommand(container *config.Container) *cobra.Command {
+	var (
+		from         string
+		to           string
+		outputFormat string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "sla [contract] [transmitter]",
+		Short: "Compute a transmitter's round participation over a time range",
+		Long: `Fetches the transmissions of an OCR2 contract between --from and --to and
+reports the share of rounds the transmitter contributed an observation to.
+Rounds run under configs that do not include the transmitter are excluded
+from the expected rounds. Times are RFC3339 or YYYY-MM-DD (UTC); without
+--to the range runs to now.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse arguments.
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+			if !common.IsHexAddress(args[1]) {
+				return fmt.Errorf("invalid transmitter address: %s", args[1])
+			}
+
+			if outputFormat != OutputFormatText && outputFormat != OutputFormatJSON {
+				return fmt.Errorf("invalid output format: %s (expected text or json)", outputFormat)
+			}
+
+			params := interfaces.SLAParams{
+				ContractAddress:    common.HexToAddress(args[0]),
+				TransmitterAddress: common.HexToAddress(args[1]),
+			}
+
+			var err error
+			if params.From, err = parseSLATime(from); err != nil {
+				return fmt.Errorf("invalid --from: %w", err)
+			}
+			if to != "" {
+				if params.To, err = parseSLATime(to); err != nil {
+					return fmt.Errorf("invalid --to: %w", err)
+				}
+			}
+
+			// Execute use case.
+			result, err := container.SLAUseCase.Execute(context.Background(), params)
+			if err != nil {
+				reportValidationErrors(cmd, err, outputFormat)
+				return fmt.Errorf("failed to compute SLA: %w", err)
+			}
+
+			if outputFormat == OutputFormatJSON {
+				return displaySLAJSON(cmd.OutOrStdout(), result)
+			}
+			displaySLAText(cmd.OutOrStdout(), result)
+			return nil
+		},
+	}
+
+	// Add flags.
+	cmd.Flags().StringVar(&from, "from", "", "Start of the range (RFC3339 or YYYY-MM-DD)")
+	cmd.Flags().StringVar(&to, "to", "", "End of the range (RFC3339 or YYYY-MM-DD, default: now)")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", OutputFormatText, "Output format (text, json)")
+	_ = cmd.MarkFlagRequired("from")
+
+	return cmd
+}
+
+// parseSLATime parses a time in one of slaTimeLayouts.
+func parseSLATime(value string) (time.Time, error) {
+	for _, layout := range slaTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not RFC3339 or YYYY-MM-DD", value)
+}
+
+// displaySLAText displays the SLA result in text format.
+func displaySLAText(out io.Writer, result *interfaces.SLAResult) {
+	_, _ = fmt.Fprintf(out, "Contract: %s\n", result.ContractAddress.Hex())
+	_, _ = fmt.Fprintf(out, "Transmitter: %s\n", result.TransmitterAddress.Hex())
+	_, _ = fmt.Fprintf(out, "Range: %s - %s\n",
+		result.From.UTC().Format(time.RFC3339), result.To.UTC().Format(time.RFC3339))
+	_, _ = fmt.Fprintf(out, "Participation: %.2f%% (%d/%d rounds)\n",
+		result.Percentage, result.ParticipatedRounds, result.ExpectedRounds)
+	if excluded := result.TotalRounds - result.ExpectedRounds; excluded > 0 {
+		_, _ = fmt.Fprintf(out, "Excluded: %d rounds under configs without the transmitter\n", excluded)
+	}
+}
+
+// displaySLAJSON displays the SLA result in JSON format.
+func displaySLAJSON(out io.Writer, result *interfaces.SLAResult) error {
+	output := slaOutput{
+		Contract:           result.ContractAddress,
+		Transmitter:        result.TransmitterAddress,
+		From:               result.From.UTC(),
+		To:                 result.To.UTC(),
+		ParticipatedRounds: result.ParticipatedRounds,
+		ExpectedRounds:     result.ExpectedRounds,
+		TotalRounds:        result.TotalRounds,
+		Percentage:         result.Percentage,
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
@@ -0,0 +1,64 @@
+// Package commands provides CLI command implementations for the OCR checker tool.
+// It contains the fetch, parse, watch, and version commands with their associated flags and handlers.
+package commands
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"chainlink-ocr-checker/domain/errors"
+	"github.com/spf13/cobra"
+)
+
+// validationErrorOutput is the JSON form of a validation error.
+type validationErrorOutput struct {
+	Error  string              `json:"error"`
+	Fields map[string][]string `json:"fields"`
+}
+
+// printValidationErrors writes the per-field messages of a validation error.
+// It reports whether err was a validation error.
+func printValidationErrors(out io.Writer, err error, format string) bool {
+	var validationErr *errors.ValidationError
+	if !stderrors.As(err, &validationErr) {
+		return false
+	}
+
+	if format == OutputFormatJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(validationErrorOutput{
+			Error:  validationErr.Error(),
+			Fields: validationErr.Fields,
+		})
+		return true
+	}
+
+	fields := make([]string, 0, len(validationErr.Fields))
+	for field := range validationErr.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	_, _ = fmt.Fprintln(out, "Validation errors:")
+	for _, field := range fields {
+		for _, message := range validationErr.Fields[field] {
+			_, _ = fmt.Fprintf(out, "  %s: %s\n", field, message)
+		}
+	}
+
+	return true
+}
+
+// reportValidationErrors prints validation details for a command failure.
+// JSON goes to stdout so it can be parsed; text goes to stderr.
+func reportValidationErrors(cmd *cobra.Command, err error, format string) {
+	out := cmd.ErrOrStderr()
+	if format == OutputFormatJSON {
+		out = cmd.OutOrStdout()
+	}
+	printValidationErrors(out, err, format)
+}
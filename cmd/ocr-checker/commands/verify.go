@@ -0,0 +1,150 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+// exitCodeVerifyMismatch is returned by verify when the file differs from the chain.
+const exitCodeVerifyMismatch = 1
+
+// verifyOutput is the JSON shape of the verify command.
+type verifyOutput struct {
+	Contract   common.Address   `json:"contract"`
+	StartRound uint32           `json:"start_round"`
+	EndRound   uint32           `json:"end_round"`
+	Checked    int              `json:"checked"`
+	Passed     bool             `json:"passed"`
+	Mismatches []verifyMismatch `json:"mismatches"`
+}
+
+// verifyMismatch is one mismatch in the JSON output of the verify command.
+type verifyMismatch struct {
+	Epoch       uint32 `json:"epoch"`
+	Round       uint8  `json:"round"`
+	BlockNumber uint64 `json:"block_number"`
+	Field       string `json:"field"`
+	File        string `json:"file,omitempty"`
+	Chain       string `json:"chain,omitempty"`
+}
+
+// NewVerifyCommand creates the verify command.
+func NewVerifyCommand(container *config.Container) *cobra.Command {
+	var (
+		outputFormat string
+		sampleSize   int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "verify [file]",
+		Short: "Verify a saved result file against the chain",
+		Long: `Re-fetches the round range of a result file written by fetch and compares
+it with the file transmission by transmission: transmitter, observers, answer,
+and block. Transmissions found on only one side are reported too.
+
+With --sample N only N transmissions spread over the file are compared, and
+only their blocks are fetched. The exit code is 0 when the file matches, 1 on
+mismatches, and 3 when the verification could not run.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Mismatches are reported through the exit code, not as a usage error.
+			cmd.SilenceUsage = true
+
+			if outputFormat != OutputFormatText && outputFormat != OutputFormatJSON {
+				return &ExitError{
+					Code: exitCodeUnknown,
+					Err:  fmt.Errorf("invalid output format: %s (expected text or json)", outputFormat),
+				}
+			}
+
+			// Execute use case.
+			result, err := container.VerifyTransmissionsUseCase.Execute(context.Background(),
+				interfaces.VerifyTransmissionsParams{
+					InputPath:  args[0],
+					SampleSize: sampleSize,
+				})
+			if err != nil {
+				reportValidationErrors(cmd, err, outputFormat)
+				return &ExitError{Code: exitCodeUnknown, Err: fmt.Errorf("failed to verify file: %w", err)}
+			}
+
+			if outputFormat == OutputFormatJSON {
+				err = displayVerifyJSON(cmd.OutOrStdout(), result)
+			} else {
+				displayVerifyText(cmd.OutOrStdout(), result)
+			}
+			if err != nil {
+				return &ExitError{Code: exitCodeUnknown, Err: err}
+			}
+
+			if !result.Passed() {
+				return &ExitError{
+					Code: exitCodeVerifyMismatch,
+					Err:  fmt.Errorf("file does not match chain: %d mismatches", len(result.Mismatches)),
+				}
+			}
+
+			return nil
+		},
+	}
+
+	// Add flags.
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", OutputFormatText, "Output format (text, json)")
+	cmd.Flags().IntVar(&sampleSize, "sample", 0, "Compare only this many transmissions spread over the file (0 compares all)")
+
+	return cmd
+}
+
+// displayVerifyText displays the verification result in text format.
+func displayVerifyText(out io.Writer, result *interfaces.VerifyTransmissionsResult) {
+	_, _ = fmt.Fprintf(out, "Contract: %s\n", result.ContractAddress.Hex())
+	_, _ = fmt.Fprintf(out, "Rounds: %d-%d\n", result.StartRound, result.EndRound)
+	_, _ = fmt.Fprintf(out, "Checked transmissions: %d\n", result.CheckedTransmissions)
+
+	for _, m := range result.Mismatches {
+		_, _ = fmt.Fprintf(out, "  epoch %d round %d (block %d): %s", m.Epoch, m.Round, m.BlockNumber, m.Field)
+		if m.File != "" || m.Chain != "" {
+			_, _ = fmt.Fprintf(out, ": file %s, chain %s", m.File, m.Chain)
+		}
+		_, _ = fmt.Fprintln(out)
+	}
+
+	if result.Passed() {
+		_, _ = fmt.Fprintf(out, "File matches chain\n")
+	} else {
+		_, _ = fmt.Fprintf(out, "%d mismatches\n", len(result.Mismatches))
+	}
+}
+
+// displayVerifyJSON displays the verification result in JSON format.
+func displayVerifyJSON(out io.Writer, result *interfaces.VerifyTransmissionsResult) error {
+	output := verifyOutput{
+		Contract:   result.ContractAddress,
+		StartRound: result.StartRound,
+		EndRound:   result.EndRound,
+		Checked:    result.CheckedTransmissions,
+		Passed:     result.Passed(),
+		Mismatches: make([]verifyMismatch, 0, len(result.Mismatches)),
+	}
+	for _, m := range result.Mismatches {
+		output.Mismatches = append(output.Mismatches, verifyMismatch{
+			Epoch:       m.Epoch,
+			Round:       m.Round,
+			BlockNumber: m.BlockNumber,
+			Field:       m.Field,
+			File:        m.File,
+			Chain:       m.Chain,
+		})
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
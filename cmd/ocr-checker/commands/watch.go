@@ -5,10 +5,14 @@ package commands
 import (
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
-	"os"
+	"io"
+	"io/fs"
 	"text/tabwriter"
+	"time"
 
+	"chainlink-ocr-checker/application/services"
 	"chainlink-ocr-checker/domain/entities"
 	"chainlink-ocr-checker/domain/interfaces"
 	"chainlink-ocr-checker/infrastructure/config"
@@ -21,36 +25,99 @@ func NewWatchCommand(container *config.Container) *cobra.Command {
 	var (
 		outputFormat string
 		daysToIgnore int
+		emailTo        []string
+		slackWebhook   string
+		slackUsername  string
+		slackIcon      string
+		includeHealthy bool
+		concurrency    int
+		stateFile      string
+		notifierNames  []string
+		noActive       string
 	)
 	
 	cmd := &cobra.Command{
 		Use:   "watch [transmitter] [rounds_to_check] [days_to_ignore]",
 		Short: "Watch transmitter activity across OCR2 jobs",
 		Long: `Monitors transmitter participation across all associated OCR2 jobs.
-Checks recent rounds for activity and reports job status (Found, Stale, Missing, etc.).`,
-		Args: cobra.RangeArgs(2, 3),
-		RunE: func(_ *cobra.Command, args []string) error {
+Checks recent rounds for activity and reports job status (Found, Stale, Missing, etc.).
+The transmitter may be omitted when default_transmitter is set in the config.
+
+With --state-file the per-job statuses are saved after each run, and the next run
+outputs and alerts only the jobs whose status changed since then (e.g. Found to
+Stale, or back). Without changes no alert is sent. The first run, with no state
+file yet, reports every job as new.
+
+With --no-active-status critical, jobs that are not active on the node raise the
+alert like missing ones, explained as an absent transmitter.`,
+		Args: cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
 			// Check if database is configured.
 			if container.WatchTransmittersUseCase == nil {
 				return fmt.Errorf("database configuration required for watch command")
 			}
 			
+			noActiveStatus, err := parseNoActiveStatus(noActive)
+			if err != nil {
+				return err
+			}
+			
 			// Parse arguments.
-			transmitterAddr := common.HexToAddress(args[0])
+			transmitterAddr, args, err := resolveTransmitterArgs(args, container.Config.DefaultTransmitter)
+			if err != nil {
+				return err
+			}
 			
-			roundsToCheck, err := parseInt(args[1])
+			roundsToCheck, err := parseInt(args[0])
 			if err != nil {
 				return fmt.Errorf("invalid rounds to check: %w", err)
 			}
 			
 			// Days to ignore is optional.
-			if len(args) > 2 {
-				daysToIgnore, err = parseInt(args[2])
+			if len(args) > 1 {
+				daysToIgnore, err = parseInt(args[1])
 				if err != nil {
 					return fmt.Errorf("invalid days to ignore: %w", err)
 				}
 			}
 			
+			// Load the previous run's statuses; a missing file is a first run.
+			var previous *services.WatchState
+			if stateFile != "" {
+				previous, err = loadWatchState(stateFile, transmitterAddr)
+				if err != nil {
+					return err
+				}
+			}
+			
+			// Build notifiers up front so bad settings fail fast.
+			var notifiers []interfaces.Notifier
+			if len(emailTo) > 0 {
+				emailNotifier, err := container.NewEmailNotifier(emailTo)
+				if err != nil {
+					return fmt.Errorf("failed to create email notifier: %w", err)
+				}
+				notifiers = append(notifiers, emailNotifier)
+			}
+			if slackWebhook != "" {
+				slackNotifier, err := container.NewSlackNotifier(config.SlackConfig{
+					WebhookURL: slackWebhook,
+					Username:   slackUsername,
+					IconEmoji:  slackIcon,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to create slack notifier: %w", err)
+				}
+				notifiers = append(notifiers, slackNotifier)
+			}
+			if len(notifierNames) > 0 {
+				selected, err := container.SelectAlertNotifier(notifierNames)
+				if err != nil {
+					return fmt.Errorf("invalid --notifier: %w", err)
+				}
+				notifiers = append(notifiers, selected)
+			}
+			
 			// Create context.
 			ctx := context.Background()
 			
@@ -59,6 +126,7 @@ Checks recent rounds for activity and reports job status (Found, Stale, Missing,
 				TransmitterAddress: transmitterAddr,
 				RoundsToCheck:      roundsToCheck,
 				DaysToIgnore:       daysToIgnore,
+				Concurrency:        concurrency,
 			}
 			
 			container.Logger.Info("Watching transmitter",
@@ -68,72 +136,270 @@ Checks recent rounds for activity and reports job status (Found, Stale, Missing,
 			
 			result, err := container.WatchTransmittersUseCase.Execute(ctx, params)
 			if err != nil {
+				reportValidationErrors(cmd, err, outputFormat)
 				return fmt.Errorf("failed to watch transmitter: %w", err)
 			}
 			
+			var changes []services.StatusChange
+			if previous != nil {
+				changes = previous.Changes(result)
+			}
+			
+			// Send alerts; with a state file only when a status changed.
+			if len(notifiers) > 0 && (previous == nil || len(changes) > 0) {
+				opts := services.AlertMessageOptions{
+					IncludeHealthy:       includeHealthy,
+					HealthScorePrecision: container.Config.HealthScorePrecision,
+					ChainID:              container.Config.ChainID,
+					NoActiveStatus:       noActiveStatus,
+				}
+				notification := services.BuildAlertMessage(transmitterAddr, result, opts)
+				if previous != nil {
+					notification = services.BuildStatusChangeMessage(transmitterAddr, result, changes, opts)
+				}
+				for _, notifier := range notifiers {
+					if err := notifier.Notify(ctx, notification); err != nil {
+						return fmt.Errorf("failed to send %s notification: %w", notifier.Name(), err)
+					}
+				}
+			}
+			
+			// Save the statuses once alerts are out, so a failed alert is retried next run.
+			if previous != nil {
+				if err := services.WriteWatchState(stateFile, services.NewWatchState(transmitterAddr, result)); err != nil {
+					return err
+				}
+			}
+			
 			// Display results.
+			out := cmd.OutOrStdout()
+			if previous != nil {
+				if outputFormat == OutputFormatJSON {
+					return displayWatchChangesJSON(out, result, changes)
+				}
+				return displayWatchChangesTable(out, result, changes, container.Config.HealthScorePrecision)
+			}
 			if outputFormat == OutputFormatJSON {
-				return displayWatchResultsJSON(result)
+				return displayWatchResultsJSON(out, result)
 			}
-			return displayWatchResultsTable(result)
+			return displayWatchResultsTable(out, result, container.Config.HealthScorePrecision)
 		},
 	}
 	
 	// Add flags.
 	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "Output format (table, json)")
 	cmd.Flags().IntVarP(&daysToIgnore, "days", "d", 0, "Days to ignore for stale detection")
+	cmd.Flags().StringSliceVar(&emailTo, "email-to", nil, "Email the watch summary to these recipients (requires [smtp] configuration)")
+	cmd.Flags().StringVar(&slackWebhook, "slack-webhook", "", "Post the watch summary to this Slack incoming webhook")
+	cmd.Flags().StringVar(&slackUsername, "slack-username", "", "Slack display name (default from [slack] username, \"OCR Monitor\")")
+	cmd.Flags().StringVar(&slackIcon, "slack-icon", "", "Slack icon emoji (default from [slack] icon_emoji, \":robot_face:\")")
+	cmd.Flags().IntVar(&concurrency, "concurrency", interfaces.DefaultWatchConcurrency, "Number of jobs checked at once")
+	cmd.Flags().BoolVar(&includeHealthy, "include-healthy", false, "List healthy (found) jobs in notification details")
+	cmd.Flags().StringVar(&stateFile, "state-file", "",
+		"Save job statuses here and report only the jobs whose status changed since the previous run")
+	cmd.Flags().StringSliceVar(&notifierNames, "notifier", nil,
+		"Also alert through these configured notifiers (email, slack, pagerduty)")
+	cmd.Flags().StringVar(&noActive, "no-active-status", "ok",
+		"Health status jobs that are not active on the node raise alerts to (ok, warning, critical)")
 	
 	return cmd
 }
 
 // displayWatchResultsTable displays watch results in table format.
-func displayWatchResultsTable(result *interfaces.WatchTransmittersResult) error {
-	// Print summary.
-	fmt.Printf("\nTransmitter Watch Summary\n")
-	fmt.Printf("========================\n")
-	fmt.Printf("Total Jobs: %d\n", result.Summary.TotalJobs)
-	fmt.Printf("Found: %d\n", result.Summary.FoundJobs)
-	fmt.Printf("Stale: %d\n", result.Summary.StaleJobs)
-	fmt.Printf("Missing: %d\n", result.Summary.MissingJobs)
-	fmt.Printf("No Active: %d\n", result.Summary.NoActiveJobs)
-	fmt.Printf("Error: %d\n", result.Summary.ErrorJobs)
-	fmt.Printf("\n")
+func displayWatchResultsTable(out io.Writer, result *interfaces.WatchTransmittersResult, precision int) error {
+	displayWatchSummary(out, result, precision)
 	
 	// Print detailed status table.
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintln(w, "Status\tJob ID\tContract\tLast Round\tLast Seen")
-	_, _ = fmt.Fprintln(w, "------\t------\t--------\t----------\t---------")
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "Status\tJob ID\tContract\tLast Round\tLast Seen\tObserved\tReason")
+	_, _ = fmt.Fprintln(w, "------\t------\t--------\t----------\t---------\t--------\t------")
 	
 	for _, status := range result.Statuses {
-		lastSeen := "Never"
-		if !status.LastTimestamp.IsZero() {
-			lastSeen = status.LastTimestamp.Format("2006-01-02 15:04:05")
-		}
-		
-		statusStr := string(status.Status)
-		if status.Status == entities.JobStatusError && status.Error != nil {
-			statusStr = fmt.Sprintf("%s (%v)", status.Status, status.Error)
-		}
-		
-		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n",
-			statusStr,
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+			formatWatchStatus(status),
 			truncate(status.JobID, 20),
 			truncate(status.ContractAddress.Hex(), 20),
 			status.LastRound,
-			lastSeen,
+			formatLastSeen(status),
+			formatParticipation(status),
+			status.Reason,
 		)
 	}
 	
 	return w.Flush()
 }
 
+// displayWatchChangesTable displays the watch summary and the jobs whose status
+// changed since the previous run.
+func displayWatchChangesTable(
+	out io.Writer,
+	result *interfaces.WatchTransmittersResult,
+	changes []services.StatusChange,
+	precision int,
+) error {
+	displayWatchSummary(out, result, precision)
+	
+	if len(changes) == 0 {
+		_, _ = fmt.Fprintf(out, "No status changes since the previous run\n")
+		return nil
+	}
+	
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "Previous\tStatus\tJob ID\tContract\tLast Round\tLast Seen\tReason")
+	_, _ = fmt.Fprintln(w, "--------\t------\t------\t--------\t----------\t---------\t------")
+	
+	for _, change := range changes {
+		status := change.Status
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			services.FormatPreviousStatus(change.Previous),
+			formatWatchStatus(status),
+			truncate(status.JobID, 20),
+			truncate(status.ContractAddress.Hex(), 20),
+			status.LastRound,
+			formatLastSeen(status),
+			status.Reason,
+		)
+	}
+	
+	return w.Flush()
+}
+
+// displayWatchSummary prints the job counts and health score of a watch result.
+func displayWatchSummary(out io.Writer, result *interfaces.WatchTransmittersResult, precision int) {
+	_, _ = fmt.Fprintf(out, "\nTransmitter Watch Summary\n")
+	_, _ = fmt.Fprintf(out, "========================\n")
+	_, _ = fmt.Fprintf(out, "Total Jobs: %d\n", result.Summary.TotalJobs)
+	_, _ = fmt.Fprintf(out, "Found: %d\n", result.Summary.FoundJobs)
+	_, _ = fmt.Fprintf(out, "Stale: %d\n", result.Summary.StaleJobs)
+	_, _ = fmt.Fprintf(out, "Missing: %d\n", result.Summary.MissingJobs)
+	_, _ = fmt.Fprintf(out, "No Active: %d\n", result.Summary.NoActiveJobs)
+	_, _ = fmt.Fprintf(out, "Error: %d\n", result.Summary.ErrorJobs)
+	_, _ = fmt.Fprintf(out, "Health Score: %s\n", services.FormatHealthScore(result.Summary.HealthScore, precision))
+	_, _ = fmt.Fprintf(out, "\n")
+}
+
+// formatWatchStatus formats a job's status with its error, if any.
+func formatWatchStatus(status entities.TransmitterStatus) string {
+	if status.Status == entities.JobStatusError && status.Error != nil {
+		return fmt.Sprintf("%s (%v)", status.Status, status.Error)
+	}
+	return string(status.Status)
+}
+
+// formatLastSeen formats the time of a job's last transmission, or "Never".
+func formatLastSeen(status entities.TransmitterStatus) string {
+	if status.LastTimestamp.IsZero() {
+		return "Never"
+	}
+	return status.LastTimestamp.Format("2006-01-02 15:04:05")
+}
+
+// formatParticipation formats a job's observed over expected rounds, e.g.
+// "4/5 (80%)", or "-" when no rounds were expected.
+func formatParticipation(status entities.TransmitterStatus) string {
+	if status.ExpectedRounds == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d/%d (%.0f%%)", status.ObservedRounds, status.ExpectedRounds, status.Participation*100)
+}
+
 // displayWatchResultsJSON displays watch results in JSON format.
-func displayWatchResultsJSON(result *interfaces.WatchTransmittersResult) error {
-	encoder := json.NewEncoder(os.Stdout)
+func displayWatchResultsJSON(out io.Writer, result *interfaces.WatchTransmittersResult) error {
+	encoder := json.NewEncoder(out)
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(result)
 }
 
+// watchChangeOutput is the JSON shape of a job whose status changed.
+// An empty previous status means the previous run did not check the job.
+type watchChangeOutput struct {
+	JobID          string             `json:"job_id"`
+	Contract       common.Address     `json:"contract"`
+	PreviousStatus entities.JobStatus `json:"previous_status"`
+	Status         entities.JobStatus `json:"status"`
+	Reason         string             `json:"reason,omitempty"`
+	LastRound      uint32             `json:"last_round"`
+	LastTimestamp  time.Time          `json:"last_timestamp"`
+}
+
+// watchChangesOutput is the JSON shape of the watch command with --state-file.
+type watchChangesOutput struct {
+	Summary interfaces.TransmitterSummary `json:"summary"`
+	Changes []watchChangeOutput           `json:"changes"`
+}
+
+// displayWatchChangesJSON displays the watch summary and status changes in JSON format.
+func displayWatchChangesJSON(
+	out io.Writer,
+	result *interfaces.WatchTransmittersResult,
+	changes []services.StatusChange,
+) error {
+	output := watchChangesOutput{
+		Summary: result.Summary,
+		Changes: make([]watchChangeOutput, 0, len(changes)),
+	}
+	for _, change := range changes {
+		output.Changes = append(output.Changes, watchChangeOutput{
+			JobID:          change.Status.JobID,
+			Contract:       change.Status.ContractAddress,
+			PreviousStatus: change.Previous,
+			Status:         change.Status.Status,
+			Reason:         change.Status.Reason,
+			LastRound:      change.Status.LastRound,
+			LastTimestamp:  change.Status.LastTimestamp,
+		})
+	}
+	
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
+
+// loadWatchState reads the statuses saved by the previous watch run of the
+// transmitter. A missing file returns an empty state, as on the first run.
+func loadWatchState(path string, transmitter common.Address) (*services.WatchState, error) {
+	state, err := services.ReadWatchState(path)
+	if stderrors.Is(err, fs.ErrNotExist) {
+		return &services.WatchState{Transmitter: transmitter}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	
+	if state.Transmitter != transmitter {
+		return nil, fmt.Errorf("state file %s is for transmitter %s, not %s",
+			path, state.Transmitter.Hex(), transmitter.Hex())
+	}
+	
+	return &state, nil
+}
+
+// resolveTransmitterArgs takes the transmitter from the first argument when it
+// is an address, or from the configured default otherwise, and returns the
+// remaining [rounds_to_check] [days_to_ignore] arguments.
+func resolveTransmitterArgs(args []string, defaultTransmitter string) (common.Address, []string, error) {
+	var transmitter string
+	switch {
+	case len(args) > 0 && common.IsHexAddress(args[0]):
+		transmitter, args = args[0], args[1:]
+	case defaultTransmitter != "":
+		transmitter = defaultTransmitter
+	case len(args) > 1:
+		return common.Address{}, nil, fmt.Errorf("invalid transmitter address: %s", args[0])
+	default:
+		return common.Address{}, nil, fmt.Errorf("transmitter address required: pass it as the first argument or set default_transmitter in the config")
+	}
+
+	if len(args) == 0 {
+		return common.Address{}, nil, fmt.Errorf("rounds to check required")
+	}
+	if len(args) > 2 {
+		return common.Address{}, nil, fmt.Errorf("too many arguments: expected [transmitter] [rounds_to_check] [days_to_ignore]")
+	}
+
+	return common.HexToAddress(transmitter), args, nil
+}
+
 // parseInt parses a string to int.
 func parseInt(s string) (int, error) {
 	var v int
@@ -0,0 +1,307 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"chainlink-ocr-checker/application/services"
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/config"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisplayWatchResults_HealthScore(t *testing.T) {
+	result := &interfaces.WatchTransmittersResult{
+		Summary: interfaces.TransmitterSummary{
+			TotalJobs:   3,
+			FoundJobs:   2,
+			MissingJobs: 1,
+			HealthScore: 200.0 / 3,
+		},
+	}
+
+	t.Run("json carries full precision", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, displayWatchResultsJSON(&out, result))
+
+		var decoded interfaces.WatchTransmittersResult
+		require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+		assert.Equal(t, 200.0/3, decoded.Summary.HealthScore)
+	})
+
+	t.Run("text uses configured precision", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, displayWatchResultsTable(&out, result, 2))
+		assert.Contains(t, out.String(), "Health Score: 66.67%\n")
+
+		out.Reset()
+		require.NoError(t, displayWatchResultsTable(&out, result, 0))
+		assert.Contains(t, out.String(), "Health Score: 67%\n")
+	})
+}
+
+func TestDisplayWatchResults_Participation(t *testing.T) {
+	result := &interfaces.WatchTransmittersResult{
+		Statuses: []entities.TransmitterStatus{
+			{Status: entities.JobStatusFound, JobID: "counted", ExpectedRounds: 5, ObservedRounds: 3, Participation: 0.6},
+			{Status: entities.JobStatusFound, JobID: "uncounted"},
+		},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, displayWatchResultsTable(&out, result, 1))
+	lines := strings.Split(out.String(), "\n")
+	var counted, uncounted string
+	for _, line := range lines {
+		switch {
+		case strings.Contains(line, "uncounted"):
+			uncounted = line
+		case strings.Contains(line, "counted"):
+			counted = line
+		}
+	}
+	assert.Contains(t, counted, "3/5 (60%)")
+	assert.Regexp(t, `\s-\s`, uncounted)
+
+	out.Reset()
+	require.NoError(t, displayWatchResultsJSON(&out, result))
+	var decoded interfaces.WatchTransmittersResult
+	require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	assert.Equal(t, 5, decoded.Statuses[0].ExpectedRounds)
+	assert.Equal(t, 3, decoded.Statuses[0].ObservedRounds)
+	assert.Equal(t, 0.6, decoded.Statuses[0].Participation)
+}
+
+func TestWatchCommand_ValidationErrors(t *testing.T) {
+	newCommand := func(t *testing.T) (*cobra.Command, *bytes.Buffer, *bytes.Buffer) {
+		ctrl := gomock.NewController(t)
+
+		validationErr := &errors.ValidationError{}
+		validationErr.AddFieldError("rounds_to_check", "rounds to check must not exceed 100")
+
+		useCase := mocks.NewMockWatchTransmittersUseCase(ctrl)
+		useCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, validationErr)
+
+		logger := mocks.NewMockLogger(ctrl)
+		logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+		container := &config.Container{
+			Config:                   &config.Config{},
+			Logger:                   logger,
+			WatchTransmittersUseCase: useCase,
+		}
+
+		cmd := NewWatchCommand(container)
+		var stdout, stderr bytes.Buffer
+		cmd.SetOut(&stdout)
+		cmd.SetErr(&stderr)
+		return cmd, &stdout, &stderr
+	}
+
+	transmitter := "0xa000000000000000000000000000000000000000"
+
+	t.Run("text", func(t *testing.T) {
+		cmd, _, stderr := newCommand(t)
+		cmd.SetArgs([]string{transmitter, "500"})
+
+		require.Error(t, cmd.Execute())
+		assert.Contains(t, stderr.String(), "rounds_to_check: rounds to check must not exceed 100")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		cmd, stdout, _ := newCommand(t)
+		cmd.SetArgs([]string{"--output", "json", transmitter, "500"})
+
+		require.Error(t, cmd.Execute())
+
+		var decoded validationErrorOutput
+		require.NoError(t, json.NewDecoder(stdout).Decode(&decoded))
+		assert.Equal(t, []string{"rounds to check must not exceed 100"}, decoded.Fields["rounds_to_check"])
+	})
+}
+
+func TestWatchCommand_DefaultTransmitter(t *testing.T) {
+	defaultTransmitter := "0xb000000000000000000000000000000000000000"
+
+	run := func(t *testing.T, cfg *config.Config, args ...string) (*interfaces.WatchTransmittersParams, error) {
+		ctrl := gomock.NewController(t)
+
+		var params *interfaces.WatchTransmittersParams
+		useCase := mocks.NewMockWatchTransmittersUseCase(ctrl)
+		useCase.EXPECT().Execute(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p interfaces.WatchTransmittersParams) (*interfaces.WatchTransmittersResult, error) {
+				params = &p
+				return &interfaces.WatchTransmittersResult{}, nil
+			}).AnyTimes()
+
+		logger := mocks.NewMockLogger(ctrl)
+		logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+		cmd := NewWatchCommand(&config.Container{
+			Config:                   cfg,
+			Logger:                   logger,
+			WatchTransmittersUseCase: useCase,
+		})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(append([]string{"--output", "json"}, args...))
+
+		return params, cmd.Execute()
+	}
+
+	t.Run("config default used when argument omitted", func(t *testing.T) {
+		params, err := run(t, &config.Config{DefaultTransmitter: defaultTransmitter}, "10", "2")
+		require.NoError(t, err)
+		require.NotNil(t, params)
+		assert.Equal(t, common.HexToAddress(defaultTransmitter), params.TransmitterAddress)
+		assert.Equal(t, 10, params.RoundsToCheck)
+		assert.Equal(t, 2, params.DaysToIgnore)
+	})
+
+	t.Run("argument overrides config default", func(t *testing.T) {
+		params, err := run(t, &config.Config{DefaultTransmitter: defaultTransmitter},
+			"0xa000000000000000000000000000000000000000", "10")
+		require.NoError(t, err)
+		require.NotNil(t, params)
+		assert.Equal(t, common.HexToAddress("0xa000000000000000000000000000000000000000"), params.TransmitterAddress)
+	})
+
+	t.Run("neither source provides a transmitter", func(t *testing.T) {
+		params, err := run(t, &config.Config{}, "10")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "default_transmitter")
+		assert.Nil(t, params)
+	})
+
+	t.Run("invalid transmitter without default", func(t *testing.T) {
+		_, err := run(t, &config.Config{}, "0xnothex", "10")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid transmitter address: 0xnothex")
+	})
+}
+
+func TestWatchCommand_StateFile(t *testing.T) {
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000000")
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	statePath := filepath.Join(t.TempDir(), "watch-state.json")
+
+	// The previous run found every job healthy.
+	require.NoError(t, services.WriteWatchState(statePath, services.WatchState{
+		Transmitter: transmitter,
+		Jobs: map[string]entities.JobStatus{
+			"job-1": entities.JobStatusFound,
+			"job-2": entities.JobStatusFound,
+			"job-3": entities.JobStatusFound,
+		},
+	}))
+
+	var posted []string
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		posted = append(posted, string(body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer webhook.Close()
+
+	ctrl := gomock.NewController(t)
+	useCase := mocks.NewMockWatchTransmittersUseCase(ctrl)
+	useCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&interfaces.WatchTransmittersResult{
+		Statuses: []entities.TransmitterStatus{
+			{JobID: "job-1", ContractAddress: contract, Status: entities.JobStatusFound},
+			{JobID: "job-2", ContractAddress: contract, Status: entities.JobStatusStale, Reason: "last tx 26h ago"},
+			{JobID: "job-3", ContractAddress: contract, Status: entities.JobStatusFound},
+		},
+		Summary: interfaces.TransmitterSummary{TotalJobs: 3, FoundJobs: 2, StaleJobs: 1},
+	}, nil)
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	cmd := NewWatchCommand(&config.Container{
+		Config:                   &config.Config{},
+		Logger:                   logger,
+		WatchTransmittersUseCase: useCase,
+	})
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--state-file", statePath, "--slack-webhook", webhook.URL, "-o", "json",
+		transmitter.Hex(), "10"})
+	require.NoError(t, cmd.Execute())
+
+	// Only the job that went stale is reported and alerted.
+	var output watchChangesOutput
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &output))
+	require.Len(t, output.Changes, 1)
+	assert.Equal(t, "job-2", output.Changes[0].JobID)
+	assert.Equal(t, entities.JobStatusFound, output.Changes[0].PreviousStatus)
+	assert.Equal(t, entities.JobStatusStale, output.Changes[0].Status)
+	assert.Equal(t, 3, output.Summary.TotalJobs)
+
+	require.Len(t, posted, 1)
+	assert.Contains(t, posted[0], "was Found: [Stale] job job-2")
+	assert.NotContains(t, posted[0], "job-1")
+
+	// The current statuses are saved for the next run.
+	state, err := services.ReadWatchState(statePath)
+	require.NoError(t, err)
+	assert.Equal(t, entities.JobStatusStale, state.Jobs["job-2"])
+}
+
+func TestWatchCommand_Notifier(t *testing.T) {
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000000")
+
+	run := func(t *testing.T, names string, expect func(*mocks.MockNotifier)) error {
+		ctrl := gomock.NewController(t)
+		useCase := mocks.NewMockWatchTransmittersUseCase(ctrl)
+		useCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&interfaces.WatchTransmittersResult{
+			Summary: interfaces.TransmitterSummary{TotalJobs: 1, MissingJobs: 1},
+		}, nil).AnyTimes()
+		logger := mocks.NewMockLogger(ctrl)
+		logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+		pagerDuty := mocks.NewMockNotifier(ctrl)
+		pagerDuty.EXPECT().Name().Return("pagerduty").AnyTimes()
+		expect(pagerDuty)
+
+		cmd := NewWatchCommand(&config.Container{
+			Config:                   &config.Config{ChainID: 137},
+			Logger:                   logger,
+			WatchTransmittersUseCase: useCase,
+			Notifiers:                []interfaces.Notifier{pagerDuty},
+		})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs([]string{"--notifier", names, "-o", "json", transmitter.Hex(), "10"})
+		return cmd.Execute()
+	}
+
+	t.Run("configured notifier", func(t *testing.T) {
+		require.NoError(t, run(t, "pagerduty", func(pagerDuty *mocks.MockNotifier) {
+			pagerDuty.EXPECT().Notify(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, notification interfaces.Notification) error {
+					assert.Equal(t, "ocr-checker/137/"+transmitter.Hex(), notification.DedupKey)
+					return nil
+				})
+		}))
+	})
+
+	t.Run("unconfigured notifier", func(t *testing.T) {
+		err := run(t, "slack", func(*mocks.MockNotifier) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `notifier "slack" is not configured`)
+	})
+}
@@ -3,12 +3,15 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"chainlink-ocr-checker/cmd/ocr-checker/commands"
 	"chainlink-ocr-checker/infrastructure/config"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 func main() {
@@ -26,17 +29,39 @@ and protocol performance across different blockchain networks.`,
 	}
 	
 	// Global flags.
-	var configPath string
+	var configPath, profile, configJSON string
+	var skipChainIDCheck bool
 	rootCmd.PersistentFlags().StringVarP(&configPath, "config", "c", "", "config file path")
+	rootCmd.PersistentFlags().StringVar(&profile, "profile", "",
+		"config profile merged over the base config, e.g. prod for [profiles.prod] or config.prod.toml")
+	rootCmd.PersistentFlags().StringVar(&configJSON, "config-json", "",
+		"inline JSON config merged over the config file (default $OCR_CONFIG_JSON)")
+	rootCmd.PersistentFlags().BoolVar(&skipChainIDCheck, "skip-chain-id-check", false,
+		"warn instead of failing when the RPC's chain ID differs from chain_id, e.g. for a local fork")
+	
+	// Configuration is loaded before cobra parses flags, so read the config
+	// flags ahead of it; everything else is left to cobra.
+	configFlags := pflag.NewFlagSet("config", pflag.ContinueOnError)
+	configFlags.ParseErrorsWhitelist.UnknownFlags = true
+	configFlags.SetOutput(io.Discard)
+	configFlags.Usage = func() {}
+	configFlags.AddFlag(rootCmd.PersistentFlags().Lookup("config"))
+	configFlags.AddFlag(rootCmd.PersistentFlags().Lookup("profile"))
+	configFlags.AddFlag(rootCmd.PersistentFlags().Lookup("config-json"))
+	configFlags.AddFlag(rootCmd.PersistentFlags().Lookup("skip-chain-id-check"))
+	_ = configFlags.Parse(os.Args[1:])
 	
 	// Load configuration.
-	cfg, err := config.LoadConfig(configPath)
+	cfg, err := config.LoadConfigWithJSON(configPath, profile, configJSON)
 	if err != nil {
 		// For some commands, config might not be required.
 		cfg = &config.Config{
 			LogLevel: "info",
 		}
 	}
+	if skipChainIDCheck {
+		cfg.SkipChainIDCheck = true
+	}
 	
 	// Create dependency container.
 	container, err := config.NewContainer(cfg)
@@ -50,16 +75,52 @@ and protocol performance across different blockchain networks.`,
 		}
 	}()
 	
+	// The limiter is shared by every RPC client, so the flag applies even
+	// though the clients were dialed before flags are parsed.
+	var rpcRPS float64
+	rootCmd.PersistentFlags().Float64Var(&rpcRPS, "rpc-rps", cfg.RPCRPS,
+		"maximum RPC requests per second across all commands and workers (0 for unlimited)")
+	// Confirmations default to the chain registry's value for the chain ID.
+	var confirmations uint64
+	rootCmd.PersistentFlags().Uint64Var(&confirmations, "confirmations", container.Confirmations.Get(),
+		"blocks a block must be buried under before it is read as head (defaults to the chain's value)")
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
+		if rpcRPS < 0 {
+			return fmt.Errorf("--rpc-rps must not be negative")
+		}
+		container.RPCLimiter.SetRate(rpcRPS)
+		if cmd.Flags().Changed("confirmations") {
+			container.Confirmations.Set(confirmations)
+		}
+		return nil
+	}
+	
 	// Add commands.
 	rootCmd.AddCommand(
 		commands.NewFetchCommand(container),
 		commands.NewWatchCommand(container),
+		commands.NewMonitorCommand(container),
+		commands.NewCheckCommand(container),
 		commands.NewParseCommand(container),
+		commands.NewReindexCommand(container),
+		commands.NewInfoCommand(container),
+		commands.NewConfigsCommand(container),
+		commands.NewConfigDiffCommand(container),
+		commands.NewObserversCommand(container),
+		commands.NewVerifyCommand(container),
+		commands.NewArchiveCommand(container),
+		commands.NewSLACommand(container),
+		commands.NewPruneCommand(),
+		commands.NewNotifyTestCommand(container),
 		commands.NewVersionCommand(),
 	)
 	
 	// Execute.
 	if err := rootCmd.Execute(); err != nil {
+		var exitErr *commands.ExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.Code
+		}
 		return 1
 	}
 	
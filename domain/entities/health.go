@@ -0,0 +1,42 @@
+package entities
+
+// HealthStatus represents the overall health of a checked target.
+// Higher values are worse so statuses can be rolled up by taking the maximum.
+type HealthStatus int
+
+// HealthStatus constants.
+const (
+	HealthStatusOK HealthStatus = iota
+	HealthStatusWarning
+	HealthStatusCritical
+)
+
+// String returns the human-readable name of the status.
+func (s HealthStatus) String() string {
+	switch s {
+	case HealthStatusOK:
+		return "OK"
+	case HealthStatusWarning:
+		return "WARNING"
+	default:
+		return "CRITICAL"
+	}
+}
+
+// ExitCode returns the process exit code for the status, following the
+// Nagios plugin convention: 0 for OK, 1 for WARNING and 2 for CRITICAL.
+func (s HealthStatus) ExitCode() int {
+	return int(s)
+}
+
+// WorstHealthStatus rolls up per-target statuses into the single worst status.
+// An empty set is OK.
+func WorstHealthStatus(statuses ...HealthStatus) HealthStatus {
+	worst := HealthStatusOK
+	for _, status := range statuses {
+		if status > worst {
+			worst = status
+		}
+	}
+	return worst
+}
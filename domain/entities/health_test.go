@@ -0,0 +1,39 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorstHealthStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []HealthStatus
+		expected HealthStatus
+	}{
+		{name: "no targets", expected: HealthStatusOK},
+		{name: "all ok", statuses: []HealthStatus{HealthStatusOK, HealthStatusOK}, expected: HealthStatusOK},
+		{
+			name:     "warning wins over ok",
+			statuses: []HealthStatus{HealthStatusOK, HealthStatusWarning, HealthStatusOK},
+			expected: HealthStatusWarning,
+		},
+		{
+			name:     "any critical is critical",
+			statuses: []HealthStatus{HealthStatusWarning, HealthStatusOK, HealthStatusCritical, HealthStatusWarning},
+			expected: HealthStatusCritical,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := WorstHealthStatus(tt.statuses...)
+			assert.Equal(t, tt.expected, status)
+			assert.Equal(t, int(tt.expected), status.ExitCode())
+		})
+	}
+
+	assert.Equal(t, "CRITICAL", HealthStatusCritical.String())
+	assert.Equal(t, 2, HealthStatusCritical.ExitCode())
+}
@@ -3,7 +3,10 @@
 package entities
 
 import (
+	"bytes"
+	"encoding/json"
 	"math/big"
+	"sort"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -15,30 +18,162 @@ type Transmission struct {
 	ConfigDigest      [32]byte
 	Epoch             uint32
 	Round             uint8
+	// AggregatorRoundID is the contract's monotonic round counter. It is
+	// zero for transmissions read from files written before it was captured.
+	AggregatorRoundID uint32
 	LatestAnswer      *big.Int
+	// JuelsPerFeeCoin is the LINK/native price the report was billed at. It is
+	// nil, and omitted from saved results, when the source did not carry it.
+	JuelsPerFeeCoin   *big.Int `json:",omitempty" yaml:",omitempty"`
 	LatestTimestamp   uint32
 	TransmitterIndex  uint8
 	TransmitterAddress common.Address
 	ObserverIndex     uint8
+	// ObserverCount is the number of observations in the report.
+	ObserverCount     uint8
+	// Observers holds the oracle indices whose observations made the report.
+	// It is empty for transmissions read from files written before it was captured.
+	Observers         []uint8
+	// MetQuorum reports whether ObserverCount reached 2F+1. It is only set
+	// when the fault tolerance F of the transmission's config is known.
+	MetQuorum         bool
 	BlockNumber       uint64
 	BlockTimestamp    time.Time
 }
 
+// PackedRound returns the epoch and round packed as epoch<<8 | round, the
+// round number fetch ranges and round indexes use.
+func (t Transmission) PackedRound() uint32 {
+	return t.Epoch<<8 | uint32(t.Round)
+}
+
+// transmissionFields has the fields of Transmission without its marshal methods.
+type transmissionFields Transmission
+
+// MarshalJSON adds PackedRound to the encoded fields so saved results carry
+// epoch, round, packed round, and aggregator round ID side by side. It is
+// ignored when decoding.
+func (t Transmission) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		transmissionFields
+		PackedRound uint32
+	}{transmissionFields(t), t.PackedRound()})
+}
+
+// MarshalYAML adds PackedRound to the encoded fields, as MarshalJSON does.
+func (t Transmission) MarshalYAML() (interface{}, error) {
+	return struct {
+		transmissionFields `yaml:",inline"`
+		PackedRound        uint32
+	}{transmissionFields(t), t.PackedRound()}, nil
+}
+
+// HasObserver reports whether the oracle at index contributed an observation.
+func (t *Transmission) HasObserver(index uint8) bool {
+	return bytes.IndexByte(t.Observers, index) >= 0
+}
+
 // TransmissionResult represents aggregated transmission data.
 type TransmissionResult struct {
 	ContractAddress common.Address
 	StartRound      uint32
 	EndRound        uint32
 	Transmissions   []Transmission
+
+	// DistinctTransmitters summarizes which transmitters participated.
+	DistinctTransmitters []TransmitterCount
+}
+
+// TransmitterCount represents the number of transmissions made by a transmitter.
+type TransmitterCount struct {
+	Address common.Address
+	Count   int
+}
+
+// CountTransmitters returns the distinct transmitters in the result, ordered by
+// descending transmission count and then by address.
+func (r *TransmissionResult) CountTransmitters() []TransmitterCount {
+	counts := make(map[common.Address]int)
+	for _, tx := range r.Transmissions {
+		counts[tx.TransmitterAddress]++
+	}
+
+	transmitters := make([]TransmitterCount, 0, len(counts))
+	for address, count := range counts {
+		transmitters = append(transmitters, TransmitterCount{Address: address, Count: count})
+	}
+
+	sort.Slice(transmitters, func(i, j int) bool {
+		if transmitters[i].Count != transmitters[j].Count {
+			return transmitters[i].Count > transmitters[j].Count
+		}
+		return bytes.Compare(transmitters[i].Address[:], transmitters[j].Address[:]) < 0
+	})
+
+	return transmitters
+}
+
+// QuorumSize returns the number of observations a report needs for fault tolerance f.
+func QuorumSize(f uint8) int {
+	return 2*int(f) + 1
+}
+
+// TagQuorum sets MetQuorum on each transmission whose config digest has a known
+// fault tolerance. Transmissions under other configs are left untagged.
+func (r *TransmissionResult) TagQuorum(faultTolerance map[[32]byte]uint8) {
+	for i := range r.Transmissions {
+		tx := &r.Transmissions[i]
+		if f, ok := faultTolerance[tx.ConfigDigest]; ok {
+			tx.MetQuorum = int(tx.ObserverCount) >= QuorumSize(f)
+		}
+	}
+}
+
+// FilterConfigDigest keeps only the transmissions made under the config with
+// digest. The distinct transmitter summary is recounted.
+func (r *TransmissionResult) FilterConfigDigest(digest [32]byte) {
+	kept := r.Transmissions[:0]
+	for _, tx := range r.Transmissions {
+		if tx.ConfigDigest == digest {
+			kept = append(kept, tx)
+		}
+	}
+	r.Transmissions = kept
+	r.DistinctTransmitters = r.CountTransmitters()
+}
+
+// CountObservers returns the number of transmissions per observer index.
+func (r *TransmissionResult) CountObservers() map[uint8]int {
+	counts := make(map[uint8]int)
+	for _, tx := range r.Transmissions {
+		counts[tx.ObserverIndex]++
+	}
+
+	return counts
+}
+
+// CountConfigDigests returns the number of distinct config digests among the transmissions.
+func (r *TransmissionResult) CountConfigDigests() int {
+	digests := make(map[[32]byte]bool)
+	for _, tx := range r.Transmissions {
+		digests[tx.ConfigDigest] = true
+	}
+
+	return len(digests)
 }
 
 // ObserverActivity represents observer participation statistics.
 type ObserverActivity struct {
 	ObserverIndex uint8
+	// Address is the first address seen for the index.
 	Address       common.Address
+	// Addresses lists every address the index mapped to, in order of first
+	// appearance. It has more than one entry when a config rotation moved the index.
+	Addresses     []common.Address
 	TotalCount    int
 	DailyCount    map[string]int
 	MonthlyCount  map[string]int
+	EpochCount    map[uint32]int
 }
 
 // TransmitterStatus represents the current status of a transmitter.
@@ -50,6 +185,22 @@ type TransmitterStatus struct {
 	LastTimestamp   time.Time
 	Status          JobStatus
 	Error           error
+	// Reason explains the classification, e.g. "last tx 26h ago exceeds 24h threshold".
+	Reason string
+
+	// ObserverCounts holds transmissions per observer index in the checked window.
+	ObserverCounts map[uint8]int
+
+	// ConfigDigests is the number of distinct configs the checked window's transmissions were made under.
+	ConfigDigests int
+
+	// ExpectedRounds counts the window's reports made under a config that
+	// includes the transmitter, and ObservedRounds those its oracle observed or
+	// transmitted. Participation is ObservedRounds over ExpectedRounds, or zero
+	// when no rounds were expected.
+	ExpectedRounds int
+	ObservedRounds int
+	Participation  float64
 }
 
 // JobStatus represents the status of an OCR job.
@@ -75,6 +226,114 @@ type OCR2Config struct {
 	Encoded            []byte
 }
 
+// ExpectedOCR2Config is the configuration a contract is expected to run,
+// as kept for change management. Nil fields are not compared.
+type ExpectedOCR2Config struct {
+	Transmitters []common.Address
+	Signers      []common.Address
+	F            *uint8
+}
+
+// ConfigSetEvent represents a ConfigSet event emitted by an OCR2 aggregator.
+// Config.Threshold holds the event's fault tolerance F.
+type ConfigSetEvent struct {
+	BlockNumber uint64
+	LogIndex    uint
+	ConfigCount uint64
+	Config      OCR2Config
+}
+
+// SortConfigSetEvents orders events by block, then by position within the block.
+func SortConfigSetEvents(events []ConfigSetEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].BlockNumber != events[j].BlockNumber {
+			return events[i].BlockNumber < events[j].BlockNumber
+		}
+		return events[i].LogIndex < events[j].LogIndex
+	})
+}
+
+// UnknownObserverIndex marks a transmission whose observer index could not be resolved.
+const UnknownObserverIndex uint8 = 255
+
+// TransmitterIndex returns the position of a transmitter within the config.
+// Positions at or beyond UnknownObserverIndex cannot be represented and are not matched.
+func (c *OCR2Config) TransmitterIndex(address common.Address) (uint8, bool) {
+	for i, transmitter := range c.Transmitters {
+		if i >= int(UnknownObserverIndex) {
+			break
+		}
+		if transmitter == address {
+			return uint8(i), true // #nosec G115 -- range check ensures fit in uint8
+		}
+	}
+
+	return UnknownObserverIndex, false
+}
+
+// DuplicateTransmitter is a transmitter listed at more than one position of a config.
+type DuplicateTransmitter struct {
+	Address common.Address
+	Indices []int
+}
+
+// DuplicateTransmitters returns the transmitters a config lists more than once,
+// in the order of their first position. Such a config is malformed:
+// TransmitterIndex always maps the address to its first position, so the
+// oracles at the later positions are never credited.
+func (c *OCR2Config) DuplicateTransmitters() []DuplicateTransmitter {
+	positions := make(map[common.Address][]int, len(c.Transmitters))
+	var order []common.Address
+	for i, transmitter := range c.Transmitters {
+		if _, ok := positions[transmitter]; !ok {
+			order = append(order, transmitter)
+		}
+		positions[transmitter] = append(positions[transmitter], i)
+	}
+
+	var duplicates []DuplicateTransmitter
+	for _, transmitter := range order {
+		if indices := positions[transmitter]; len(indices) > 1 {
+			duplicates = append(duplicates, DuplicateTransmitter{Address: transmitter, Indices: indices})
+		}
+	}
+	return duplicates
+}
+
+// TransmitterIndexChange records a config that moved a transmitter to another
+// position. UnknownObserverIndex on either side means the transmitter was not
+// in that config.
+type TransmitterIndexChange struct {
+	BlockNumber  uint64
+	ConfigCount  uint64
+	ConfigDigest [32]byte
+	OldIndex     uint8
+	NewIndex     uint8
+}
+
+// TransmitterIndexChanges reports each config in events, which must be in
+// block order, that puts the transmitter at a different index than the config
+// before it. The first event only sets the starting index.
+func TransmitterIndexChanges(events []ConfigSetEvent, transmitter common.Address) []TransmitterIndexChange {
+	var changes []TransmitterIndexChange
+	for i := 1; i < len(events); i++ {
+		oldIndex, _ := events[i-1].Config.TransmitterIndex(transmitter)
+		newIndex, _ := events[i].Config.TransmitterIndex(transmitter)
+		if oldIndex == newIndex {
+			continue
+		}
+		changes = append(changes, TransmitterIndexChange{
+			BlockNumber:  events[i].BlockNumber,
+			ConfigCount:  events[i].ConfigCount,
+			ConfigDigest: events[i].Config.ConfigDigest,
+			OldIndex:     oldIndex,
+			NewIndex:     newIndex,
+		})
+	}
+
+	return changes
+}
+
 // BlockRange represents a range of blocks.
 type BlockRange struct {
 	StartBlock uint64
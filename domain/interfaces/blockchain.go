@@ -33,6 +33,15 @@ type Block struct {
 	Hash      common.Hash
 }
 
+// TransmissionTailHandler receives transmissions from a live tail.
+type TransmissionTailHandler interface {
+	// OnTransmission is called once for each newly mined transmission.
+	OnTransmission(transmission entities.Transmission)
+
+	// OnRemoved is called when a previously delivered transmission is reorged out.
+	OnRemoved(transmission entities.Transmission)
+}
+
 // OCR2AggregatorService handles OCR2 aggregator contract interactions.
 type OCR2AggregatorService interface {
 	// GetLatestRound returns the latest round data.
@@ -46,6 +55,7 @@ type OCR2AggregatorService interface {
 		ctx context.Context,
 		contractAddress common.Address,
 		startBlock, endBlock uint64,
+		opts FetchOptions,
 	) ([]entities.Transmission, error)
 
 	// GetConfig returns the current OCR2 configuration.
@@ -57,15 +67,27 @@ type OCR2AggregatorService interface {
 		contractAddress common.Address,
 		blockNumber uint64,
 	) (*entities.OCR2Config, error)
+
+	// GetConfigHistory returns the ConfigSet events for a block range in block order.
+	GetConfigHistory(
+		ctx context.Context,
+		contractAddress common.Address,
+		startBlock, endBlock uint64,
+	) ([]entities.ConfigSetEvent, error)
+
+	// GetLatestConfigSet returns the ConfigSet event of the current configuration.
+	GetLatestConfigSet(ctx context.Context, contractAddress common.Address) (*entities.ConfigSetEvent, error)
 }
 
-// TransmissionFetcher handles fetching transmission data.
-type TransmissionFetcher interface {
+// TransmissionSource reads transmissions from one backing store, such as the
+// node's event logs or an indexing subgraph.
+type TransmissionSource interface {
 	// FetchByRounds fetches transmissions for a range of rounds.
 	FetchByRounds(
 		ctx context.Context,
 		contractAddress common.Address,
 		startRound, endRound uint32,
+		opts FetchOptions,
 	) (*entities.TransmissionResult, error)
 
 	// FetchByBlocks fetches transmissions for a range of blocks.
@@ -73,6 +95,7 @@ type TransmissionFetcher interface {
 		ctx context.Context,
 		contractAddress common.Address,
 		startBlock, endBlock uint64,
+		opts FetchOptions,
 	) (*entities.TransmissionResult, error)
 
 	// FetchByTimeRange fetches transmissions for a time range.
@@ -80,9 +103,40 @@ type TransmissionFetcher interface {
 		ctx context.Context,
 		contractAddress common.Address,
 		startTime, endTime time.Time,
+		opts FetchOptions,
 	) (*entities.TransmissionResult, error)
 }
 
+// TransmissionFetcher handles fetching transmission data from the configured
+// TransmissionSource.
+type TransmissionFetcher interface {
+	TransmissionSource
+}
+
+// FetchOptions controls optional work performed while fetching transmissions.
+type FetchOptions struct {
+	// SkipTimestamps leaves BlockTimestamp zero instead of looking up every block.
+	SkipTimestamps bool
+
+	// RawLogs reads events with eth_getLogs and decodes them directly instead of
+	// going through the generated contract binding.
+	RawLogs bool
+
+	// Transmitter keeps only transmissions sent by this address when set. The
+	// transmitter is not an indexed event field, so it is matched after decoding
+	// but before block timestamp and observer index lookups.
+	Transmitter common.Address
+
+	// ConfigDigest keeps only transmissions made under this config when set.
+	// Like Transmitter, it is matched before any per-event lookups.
+	ConfigDigest [32]byte
+
+	// MaxBlockRange bounds the blocks FetchByRounds scans for the rounds to
+	// the last MaxBlockRange blocks before head; zero scans from genesis.
+	// Sources that look rounds up directly, like the subgraph, ignore it.
+	MaxBlockRange uint64
+}
+
 // TransmissionWatcher monitors transmissions in real-time.
 type TransmissionWatcher interface {
 	// WatchTransmissions monitors transmissions for multiple contracts.
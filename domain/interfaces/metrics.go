@@ -0,0 +1,28 @@
+// Package interfaces defines contracts and interfaces for the OCR checker domain layer.
+// It contains interfaces for blockchain operations, repositories, use cases, and logging.
+package interfaces
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MetricsRecorder records monitoring metrics from watch checks.
+type MetricsRecorder interface {
+	// RecordWatchResult records job statuses and observer participation for a check.
+	RecordWatchResult(transmitter common.Address, result *WatchTransmittersResult)
+
+	// RecordCheckError records a check that failed before producing a result.
+	RecordCheckError(transmitter common.Address)
+
+	// RecordCheckPanic records a check that panicked and was recovered.
+	RecordCheckPanic(transmitter common.Address)
+}
+
+// NotifierMetrics records the delivery of notifications.
+type NotifierMetrics interface {
+	// RecordNotifierSend records one send to a notifier backend, how long it
+	// took, and the error it failed with, if any.
+	RecordNotifierSend(backend string, duration time.Duration, err error)
+}
@@ -0,0 +1,41 @@
+// Package interfaces defines contracts and interfaces for the OCR checker domain layer.
+// It contains interfaces for blockchain operations, repositories, use cases, and logging.
+package interfaces
+
+import "context"
+
+// Notifier delivers notifications to an external channel.
+type Notifier interface {
+	// Name returns the name of the notifier backend.
+	Name() string
+
+	// Notify sends the notification.
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// Notification represents a backend-agnostic alert message.
+type Notification struct {
+	Title    string
+	Severity NotificationSeverity
+	Summary  string
+	Details  []string
+
+	// DedupKey identifies what the notification is about, such as a
+	// transmitter on a chain, so backends that group alerts can merge repeats
+	// into one incident. Empty falls back to the title.
+	DedupKey string
+
+	// Fields carries structured context, such as the transmitter and the IDs
+	// of failing jobs, for backends that attach it to the alert.
+	Fields map[string]any
+}
+
+// NotificationSeverity represents the urgency of a notification.
+type NotificationSeverity string
+
+// NotificationSeverity constants.
+const (
+	NotificationSeverityInfo     NotificationSeverity = "info"
+	NotificationSeverityWarning  NotificationSeverity = "warning"
+	NotificationSeverityCritical NotificationSeverity = "critical"
+)
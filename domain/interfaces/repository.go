@@ -25,6 +25,10 @@ type JobRepository interface {
 
 	// FindActiveJobs returns all active jobs.
 	FindActiveJobs(ctx context.Context) ([]entities.Job, error)
+
+	// FindActiveJobsInBatches iterates over active jobs in batches of batchSize,
+	// calling fn for each batch. Iteration stops at the first error returned by fn.
+	FindActiveJobsInBatches(ctx context.Context, batchSize int, fn func(batch []entities.Job) error) error
 }
 
 // TransmissionRepository handles transmission data persistence.
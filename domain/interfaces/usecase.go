@@ -5,6 +5,7 @@ package interfaces
 import (
 	"context"
 	"io"
+	"time"
 
 	"chainlink-ocr-checker/domain/entities"
 	"github.com/ethereum/go-ethereum/common"
@@ -22,12 +23,38 @@ type FetchTransmissionsParams struct {
 	StartRound      uint32
 	EndRound        uint32
 	OutputFormat    OutputFormat
+	SkipTimestamps  bool
+
+	// RawLogs, Transmitter, and ConfigDigest are passed through to FetchOptions.
+	RawLogs      bool
+	Transmitter  common.Address
+	ConfigDigest [32]byte
+
+	// ByBlocks fetches the blocks StartBlock through EndBlock instead of a
+	// round range; StartRound and EndRound must then be zero.
+	ByBlocks   bool
+	StartBlock uint64
+	EndBlock   uint64
+
+	// MaxBlockRange bounds the blocks a round range is looked for in to the
+	// last MaxBlockRange blocks; zero scans from genesis.
+	MaxBlockRange uint64
 }
 
 // WatchTransmittersUseCase handles the business logic for watching transmitters.
 type WatchTransmittersUseCase interface {
 	// Execute watches transmitter activity.
 	Execute(ctx context.Context, params WatchTransmittersParams) (*WatchTransmittersResult, error)
+
+	// ExecuteMany watches several transmitters, fetching each contract's
+	// transmissions once for all of them. Results follow the order of
+	// params.TransmitterAddresses.
+	ExecuteMany(ctx context.Context, params WatchManyTransmittersParams) ([]TransmitterWatchResult, error)
+
+	// ExecuteAll watches every transmitter with active jobs, loading the jobs
+	// in batches so large node databases are processed incrementally. Results
+	// are ordered by transmitter address.
+	ExecuteAll(ctx context.Context, params WatchAllTransmittersParams) ([]TransmitterWatchResult, error)
 }
 
 // WatchTransmittersParams represents parameters for watching transmitters.
@@ -35,8 +62,77 @@ type WatchTransmittersParams struct {
 	TransmitterAddress common.Address
 	RoundsToCheck      int
 	DaysToIgnore       int
+
+	// Concurrency bounds how many jobs are checked at once.
+	// Zero uses DefaultWatchConcurrency.
+	Concurrency int
+
+	// ContractErrors decides what happens to a contract whose RPC lookups
+	// fail. Empty uses ContractErrorFail.
+	ContractErrors ContractErrorPolicy
 }
 
+// WatchManyTransmittersParams represents parameters for watching several transmitters together.
+type WatchManyTransmittersParams struct {
+	TransmitterAddresses []common.Address
+	RoundsToCheck        int
+	DaysToIgnore         int
+
+	// Concurrency bounds how many contracts are fetched at once.
+	// Zero uses DefaultWatchConcurrency.
+	Concurrency int
+
+	// ContractErrors decides what happens to a contract whose RPC lookups
+	// fail. Empty uses ContractErrorFail.
+	ContractErrors ContractErrorPolicy
+}
+
+// WatchAllTransmittersParams represents parameters for watching every transmitter with active jobs.
+type WatchAllTransmittersParams struct {
+	RoundsToCheck int
+	DaysToIgnore  int
+
+	// BatchSize is how many active jobs are loaded and checked at a time.
+	// Zero uses DefaultJobBatchSize.
+	BatchSize int
+
+	// Concurrency bounds how many contracts of a batch are fetched at once.
+	// Zero uses DefaultWatchConcurrency.
+	Concurrency int
+
+	// ContractErrors decides what happens to a contract whose RPC lookups
+	// fail. Empty uses ContractErrorFail.
+	ContractErrors ContractErrorPolicy
+}
+
+// TransmitterWatchResult is the watch result of one transmitter of a grouped watch.
+// Err is set instead of Result when the transmitter's jobs could not be loaded.
+type TransmitterWatchResult struct {
+	TransmitterAddress common.Address
+	Result             *WatchTransmittersResult
+	Err                error
+}
+
+// DefaultWatchConcurrency is the number of jobs a watch checks at once by default.
+const DefaultWatchConcurrency = 4
+
+// DefaultJobBatchSize is the number of active jobs a watch of every
+// transmitter loads at a time by default.
+const DefaultJobBatchSize = 500
+
+// ContractErrorPolicy decides how a watch handles a contract whose latest round
+// or transmissions cannot be fetched.
+type ContractErrorPolicy string
+
+// ContractErrorPolicy constants.
+const (
+	// ContractErrorFail reports the contract's jobs as JobStatusError.
+	ContractErrorFail ContractErrorPolicy = "error"
+	// ContractErrorRetry fetches the contract again after a short delay and
+	// reports its jobs as JobStatusError only if that fails too.
+	ContractErrorRetry ContractErrorPolicy = "retry"
+)
+
 // WatchTransmittersResult represents the result of watching transmitters.
 type WatchTransmittersResult struct {
 	Statuses []entities.TransmitterStatus
@@ -51,6 +147,262 @@ type TransmitterSummary struct {
 	MissingJobs  int
 	NoActiveJobs int
 	ErrorJobs    int
+
+	// HealthScore is the percentage of jobs in the Found state.
+	HealthScore float64
+}
+
+// HealthStatus derives the overall status of a watch summary.
+// Missing or errored jobs are critical and stale jobs are a warning.
+func (s TransmitterSummary) HealthStatus() entities.HealthStatus {
+	switch {
+	case s.MissingJobs > 0 || s.ErrorJobs > 0:
+		return entities.HealthStatusCritical
+	case s.StaleJobs > 0:
+		return entities.HealthStatusWarning
+	default:
+		return entities.HealthStatusOK
+	}
+}
+
+// ContractInfoUseCase summarizes round activity for a contract over a block window.
+type ContractInfoUseCase interface {
+	// Execute computes round statistics for the requested block window.
+	Execute(ctx context.Context, params ContractInfoParams) (*ContractInfoResult, error)
+}
+
+// ContractInfoParams represents parameters for contract info.
+// FromBlock and ToBlock override the head-relative window of Blocks;
+// Since starts the window at the block mined that long ago instead.
+type ContractInfoParams struct {
+	ContractAddress common.Address
+	Blocks          uint64
+	Since           time.Duration
+	FromBlock       *uint64
+	ToBlock         *uint64
+}
+
+// ContractInfoResult represents round statistics for a block window.
+// FirstRound and LastRound are packed as epoch<<8 | round; the aggregator
+// round IDs belong to the same transmissions.
+type ContractInfoResult struct {
+	ContractAddress        common.Address
+	StartBlock             uint64
+	EndBlock               uint64
+	TransmissionCount      int
+	FirstRound             uint32
+	LastRound              uint32
+	FirstAggregatorRoundID uint32
+	LastAggregatorRoundID  uint32
+	DistinctTransmitters   []entities.TransmitterCount
+}
+
+// ConfigHistoryUseCase lists the configurations set on a contract over a block range.
+type ConfigHistoryUseCase interface {
+	// Execute returns the ConfigSet events for the requested block range in block order.
+	Execute(ctx context.Context, params ConfigHistoryParams) (*ConfigHistoryResult, error)
+}
+
+// ConfigHistoryParams represents parameters for config history.
+// A nil ToBlock runs the range to head. When Transmitter is set, its index
+// is tracked across the configs of the range.
+type ConfigHistoryParams struct {
+	ContractAddress common.Address
+	FromBlock       uint64
+	ToBlock         *uint64
+	Transmitter     *common.Address
+}
+
+// ConfigHistoryResult represents the configurations set within a block range.
+// IndexChanges lists the configs that moved the tracked transmitter.
+type ConfigHistoryResult struct {
+	ContractAddress common.Address
+	StartBlock      uint64
+	EndBlock        uint64
+	Configs         []entities.ConfigSetEvent
+	Transmitter     *common.Address
+	IndexChanges    []entities.TransmitterIndexChange
+}
+
+// ConfigDiffUseCase compares the current configuration of a contract with an expected one.
+type ConfigDiffUseCase interface {
+	// Execute reads the current configuration and reports how it differs from the expected one.
+	Execute(ctx context.Context, params ConfigDiffParams) (*ConfigDiffResult, error)
+}
+
+// ConfigDiffParams represents parameters for a config diff.
+type ConfigDiffParams struct {
+	ContractAddress common.Address
+	Expected        entities.ExpectedOCR2Config
+}
+
+// ConfigDiffResult represents the differences between the current and expected configuration.
+// Added addresses are on chain but not expected; removed addresses are expected but not on chain.
+type ConfigDiffResult struct {
+	ContractAddress     common.Address
+	BlockNumber         uint64
+	ConfigDigest        [32]byte
+	AddedTransmitters   []common.Address
+	RemovedTransmitters []common.Address
+	AddedSigners        []common.Address
+	RemovedSigners      []common.Address
+	ExpectedF           *uint8
+	ActualF             uint8
+}
+
+// FChanged reports whether F differs from the expected value, when one is set.
+func (r *ConfigDiffResult) FChanged() bool {
+	return r.ExpectedF != nil && *r.ExpectedF != r.ActualF
+}
+
+// HasDrift reports whether the current configuration differs from the expected one.
+func (r *ConfigDiffResult) HasDrift() bool {
+	return len(r.AddedTransmitters) > 0 || len(r.RemovedTransmitters) > 0 ||
+		len(r.AddedSigners) > 0 || len(r.RemovedSigners) > 0 || r.FChanged()
+}
+
+// ObserversUseCase maps the observer indices of a contract's config to its transmitters.
+type ObserversUseCase interface {
+	// Execute reads the config and lists its transmitters in index order.
+	Execute(ctx context.Context, params ObserversParams) (*ObserversResult, error)
+}
+
+// ObserversParams represents parameters for listing a contract's observers.
+// A nil AtBlock reads the current config.
+type ObserversParams struct {
+	ContractAddress common.Address
+	AtBlock         *uint64
+}
+
+// ObserversResult represents the observers of a config in index order.
+// BlockNumber is the block the config was set in for the current config, or
+// the requested block with AtBlock.
+type ObserversResult struct {
+	ContractAddress common.Address
+	BlockNumber     uint64
+	ConfigDigest    [32]byte
+	Observers       []ObserverEntry
+}
+
+// ObserverEntry is one observer index of a config. Signer is nil when the
+// config was read without its signers.
+type ObserverEntry struct {
+	Index       uint8
+	Transmitter common.Address
+	Signer      *common.Address
+}
+
+// SLAUseCase computes how often a transmitter participated in a contract's rounds.
+type SLAUseCase interface {
+	// Execute counts the rounds in the time range the transmitter observed.
+	Execute(ctx context.Context, params SLAParams) (*SLAResult, error)
+}
+
+// SLAParams represents parameters for an SLA calculation.
+type SLAParams struct {
+	ContractAddress    common.Address
+	TransmitterAddress common.Address
+	From               time.Time
+	To                 time.Time
+}
+
+// SLAResult represents a transmitter's participation over a time range.
+// Rounds run under configs that do not include the transmitter are counted
+// in TotalRounds but not in ExpectedRounds.
+type SLAResult struct {
+	ContractAddress    common.Address
+	TransmitterAddress common.Address
+	From               time.Time
+	To                 time.Time
+	ParticipatedRounds int
+	ExpectedRounds     int
+	TotalRounds        int
+	// Percentage is ParticipatedRounds over ExpectedRounds, or zero without expected rounds.
+	Percentage float64
+}
+
+// VerifyTransmissionsUseCase checks a saved result file against the chain.
+type VerifyTransmissionsUseCase interface {
+	// Execute re-fetches the file's rounds and compares them with the file.
+	Execute(ctx context.Context, params VerifyTransmissionsParams) (*VerifyTransmissionsResult, error)
+}
+
+// VerifyTransmissionsParams represents parameters for verifying a result file.
+type VerifyTransmissionsParams struct {
+	InputPath string
+
+	// SampleSize limits the comparison to this many transmissions spread
+	// evenly over the file. Zero compares the whole round range.
+	SampleSize int
+}
+
+// VerifyTransmissionsResult represents the outcome of verifying a result file.
+type VerifyTransmissionsResult struct {
+	ContractAddress common.Address
+	StartRound      uint32
+	EndRound        uint32
+	// CheckedTransmissions is the number of transmissions of the file compared.
+	CheckedTransmissions int
+	Mismatches           []TransmissionMismatch
+}
+
+// Passed reports whether the file matched the chain.
+func (r *VerifyTransmissionsResult) Passed() bool {
+	return len(r.Mismatches) == 0
+}
+
+// TransmissionMismatch is a difference between a saved transmission and the chain.
+type TransmissionMismatch struct {
+	Epoch       uint32
+	Round       uint8
+	BlockNumber uint64
+	// Field is the compared value that differs, or MismatchMissingOnChain
+	// and MismatchMissingInFile for a transmission found on one side only.
+	Field string
+	File  string
+	Chain string
+}
+
+// TransmissionMismatch fields for transmissions found on one side only.
+const (
+	MismatchMissingOnChain = "missing on chain"
+	MismatchMissingInFile  = "missing in file"
+)
+
+// ArchiveTransmissionsUseCase archives a contract's transmission history.
+type ArchiveTransmissionsUseCase interface {
+	// Execute archives transmissions from the start block, or the checkpoint,
+	// up to head, checkpointing after every chunk.
+	Execute(ctx context.Context, params ArchiveTransmissionsParams) (*ArchiveTransmissionsResult, error)
+}
+
+// ArchiveTransmissionsParams represents parameters for archiving transmissions.
+// OutputPath is a JSON-lines file, gzip-compressed when it ends in .gz; empty
+// keeps the checkpoint's path, or picks a default for a new archive. When
+// StatePath holds a checkpoint of the same archive, the run resumes from it.
+type ArchiveTransmissionsParams struct {
+	ContractAddress common.Address
+	FromBlock       uint64
+	OutputPath      string
+	StatePath       string
+	SkipTimestamps  bool
+
+	// ChunkSize is the number of blocks fetched and checkpointed at once.
+	// Zero uses the default.
+	ChunkSize uint64
+}
+
+// ArchiveTransmissionsResult represents the outcome of an archive run.
+// Transmissions counts the whole archive, including earlier runs.
+type ArchiveTransmissionsResult struct {
+	ContractAddress common.Address
+	OutputPath      string
+	StartBlock      uint64
+	EndBlock        uint64
+	Resumed         bool
+	ResumeBlock     uint64
+	ChunksFetched   int
+	Transmissions   int
 }
 
 // ParseTransmissionsUseCase handles parsing transmission data.
@@ -63,8 +415,46 @@ type ParseTransmissionsUseCase interface {
 type ParseTransmissionsParams struct {
 	InputPath    string
 	OutputWriter io.Writer
+
+	// InputPaths, when set, are read instead of InputPath and analyzed
+	// together. Their transmissions are combined, keeping one per contract
+	// and aggregator round ID where the files overlap.
+	InputPaths []string
+
 	GroupBy      GroupByUnit
 	OutputFormat OutputFormat
+
+	// ShowAllAddresses lists every address an observer index mapped to in
+	// text output, instead of only the first one.
+	ShowAllAddresses bool
+
+	// StartRound and EndRound limit parsing to a round range when EndRound is set.
+	// Indexed JSON-lines files are read by seeking to the range.
+	StartRound uint32
+	EndRound   uint32
+
+	// ConfigDigest limits parsing to transmissions under one config when set.
+	ConfigDigest [32]byte
+}
+
+// ReindexTransmissionsUseCase handles re-deriving observer indices for saved transmission data.
+type ReindexTransmissionsUseCase interface {
+	// Execute re-derives observer indices and rewrites the transmission file.
+	Execute(ctx context.Context, params ReindexTransmissionsParams) (*ReindexTransmissionsResult, error)
+}
+
+// ReindexTransmissionsParams represents parameters for reindexing transmissions.
+type ReindexTransmissionsParams struct {
+	InputPath       string
+	OutputPath      string
+	ContractAddress common.Address
+}
+
+// ReindexTransmissionsResult represents the result of reindexing transmissions.
+type ReindexTransmissionsResult struct {
+	TotalTransmissions int
+	UpdatedCount       int
+	UnresolvedCount    int
 }
 
 // GroupByUnit represents the unit for grouping data.
@@ -75,6 +465,7 @@ const (
 	GroupByDay   GroupByUnit = "day"
 	GroupByMonth GroupByUnit = "month"
 	GroupByRound GroupByUnit = "round"
+	GroupByEpoch GroupByUnit = "epoch"
 )
 
 // OutputFormat represents the output format.
@@ -82,22 +473,27 @@ type OutputFormat string
 
 // OutputFormat constants.
 const (
-	OutputFormatJSON OutputFormat = "json"
-	OutputFormatYAML OutputFormat = "yaml"
-	OutputFormatText OutputFormat = "text"
-	OutputFormatCSV  OutputFormat = "csv"
+	OutputFormatJSON     OutputFormat = "json"
+	OutputFormatJSONL    OutputFormat = "jsonl"
+	OutputFormatYAML     OutputFormat = "yaml"
+	OutputFormatText     OutputFormat = "text"
+	OutputFormatCSV      OutputFormat = "csv"
+	OutputFormatProtobuf OutputFormat = "protobuf"
+	OutputFormatHTML     OutputFormat = "html"
 )
 
 // TransmissionAnalyzer analyzes transmission patterns.
 type TransmissionAnalyzer interface {
 	// AnalyzeObserverActivity analyzes observer participation.
-	AnalyzeObserverActivity(transmissions []entities.Transmission) ([]entities.ObserverActivity, error)
+	// It stops with the context's error when ctx is cancelled.
+	AnalyzeObserverActivity(ctx context.Context, transmissions []entities.Transmission) ([]entities.ObserverActivity, error)
 
 	// DetectAnomalies detects anomalies in transmission patterns.
-	DetectAnomalies(transmissions []entities.Transmission) ([]TransmissionAnomaly, error)
+	// It stops with the context's error when ctx is cancelled.
+	DetectAnomalies(ctx context.Context, transmissions []entities.Transmission) ([]TransmissionAnomaly, error)
 
 	// GenerateReport generates a comprehensive report.
-	GenerateReport(transmissions []entities.Transmission, format OutputFormat) ([]byte, error)
+	GenerateReport(ctx context.Context, transmissions []entities.Transmission, format OutputFormat) ([]byte, error)
 }
 
 // TransmissionAnomaly represents an anomaly in transmission patterns.
@@ -118,6 +514,22 @@ const (
 	AnomalyTypeDuplicateRound   AnomalyType = "duplicate_round"
 	AnomalyTypeInactiveObserver AnomalyType = "inactive_observer"
 	AnomalyTypeHighLatency      AnomalyType = "high_latency"
+
+	// AnomalyTypeDeviationWithoutUpdate flags an answer that moved past the deviation
+	// threshold but was only transmitted after the allowed delay.
+	AnomalyTypeDeviationWithoutUpdate AnomalyType = "deviation_without_update"
+
+	// AnomalyTypeAnswerOutOfBounds flags an answer outside the sane range
+	// configured for its contract.
+	AnomalyTypeAnswerOutOfBounds AnomalyType = "answer_out_of_bounds"
+
+	// AnomalyTypeDuplicateTransmitter flags a config that lists the same
+	// transmitter address at more than one position.
+	AnomalyTypeDuplicateTransmitter AnomalyType = "duplicate_transmitter"
+
+	// AnomalyTypeEpochReset marks where the epoch went backwards in chain
+	// order, as it does after a config change. Rounds are checked per era.
+	AnomalyTypeEpochReset AnomalyType = "epoch_reset"
 )
 
 // AnomalySeverity represents the severity of an anomaly.
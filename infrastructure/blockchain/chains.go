@@ -0,0 +1,49 @@
+package blockchain
+
+import "sync/atomic"
+
+// chainConfirmations is the chain registry of reorg safety margins: the number
+// of blocks a block must be buried under before it is treated as final.
+var chainConfirmations = map[int64]uint64{
+	1:        12, // Ethereum
+	10:       10, // Optimism
+	56:       15, // BNB Smart Chain
+	100:      12, // Gnosis
+	137:      64, // Polygon
+	250:      5,  // Fantom
+	8453:     10, // Base
+	42161:    20, // Arbitrum One
+	43114:    1,  // Avalanche C-Chain
+	59144:    10, // Linea
+	11155111: 12, // Sepolia
+	80002:    64, // Polygon Amoy
+}
+
+// ChainConfirmations returns the registry's confirmations for a chain.
+// Unknown chains need none.
+func ChainConfirmations(chainID int64) uint64 {
+	return chainConfirmations[chainID]
+}
+
+// Confirmations holds the number of confirmations a client waits for before
+// reporting a block as head. It can change after dialing.
+type Confirmations struct {
+	n atomic.Uint64
+}
+
+// NewConfirmations creates confirmations set to the registry's value for chainID.
+func NewConfirmations(chainID int64) *Confirmations {
+	c := &Confirmations{}
+	c.Set(ChainConfirmations(chainID))
+	return c
+}
+
+// Set overrides the number of confirmations.
+func (c *Confirmations) Set(n uint64) {
+	c.n.Store(n)
+}
+
+// Get returns the number of confirmations.
+func (c *Confirmations) Get() uint64 {
+	return c.n.Load()
+}
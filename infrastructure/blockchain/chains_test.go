@@ -0,0 +1,127 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHeadRPCServer serves eth_chainId and eth_blockNumber for a chain at head.
+func newHeadRPCServer(t *testing.T, chainID int64, head uint64) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var result string
+		switch req.Method {
+		case "eth_chainId":
+			result = fmt.Sprintf("0x%x", chainID)
+		case "eth_blockNumber":
+			result = fmt.Sprintf("0x%x", head)
+		default:
+			http.Error(w, "unexpected method "+req.Method, http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%q}`, req.ID, result)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestEthereumClient_GetBlockNumber_ChainConfirmations(t *testing.T) {
+	const head = 1000
+	polygonConfirmations := ChainConfirmations(137)
+	require.NotZero(t, polygonConfirmations)
+
+	t.Run("registry value for the chain", func(t *testing.T) {
+		server := newHeadRPCServer(t, 137, head)
+
+		client, err := NewEthereumClient(server.URL, 137)
+		require.NoError(t, err)
+		defer func() { _ = client.Close() }()
+
+		blockNumber, err := client.GetBlockNumber(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, head-polygonConfirmations, blockNumber)
+	})
+
+	t.Run("override after dialing", func(t *testing.T) {
+		server := newHeadRPCServer(t, 137, head)
+
+		confirmations := NewConfirmations(137)
+		client, err := NewEthereumClientWithTLS(server.URL, 137, TLSOptions{}, nil, confirmations, ChainIDCheck{})
+		require.NoError(t, err)
+		defer func() { _ = client.Close() }()
+
+		confirmations.Set(3)
+		blockNumber, err := client.GetBlockNumber(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(head-3), blockNumber)
+	})
+
+	t.Run("unknown chain needs none", func(t *testing.T) {
+		server := newHeadRPCServer(t, 999999, head)
+
+		client, err := NewEthereumClient(server.URL, 999999)
+		require.NoError(t, err)
+		defer func() { _ = client.Close() }()
+
+		blockNumber, err := client.GetBlockNumber(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(head), blockNumber)
+	})
+
+	t.Run("head below confirmations", func(t *testing.T) {
+		server := newHeadRPCServer(t, 137, polygonConfirmations-1)
+
+		client, err := NewEthereumClient(server.URL, 137)
+		require.NoError(t, err)
+		defer func() { _ = client.Close() }()
+
+		blockNumber, err := client.GetBlockNumber(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, blockNumber)
+	})
+}
+
+func TestNewEthereumClient_ChainIDCheck(t *testing.T) {
+	// A local fork of Polygon run under anvil's default chain ID.
+	server := newHeadRPCServer(t, 31337, 1000)
+
+	_, err := NewEthereumClient(server.URL, 137)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "chain ID mismatch: expected 137, got 31337")
+
+	ctrl := gomock.NewController(t)
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Warn("RPC chain ID does not match the configured chain ID, continuing",
+		"expected", int64(137), "actual", int64(31337))
+
+	client, err := NewEthereumClientWithTLS(server.URL, 137, TLSOptions{}, nil, nil,
+		ChainIDCheck{Skip: true, Logger: logger})
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	// The configured chain still sets the confirmations.
+	blockNumber, err := client.GetBlockNumber(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1000-ChainConfirmations(137), blockNumber)
+}
@@ -15,13 +15,38 @@ import (
 
 // ethereumClient implements the BlockchainClient interface.
 type ethereumClient struct {
-	client  *ethclient.Client
-	chainID int64
+	client        *ethclient.Client
+	chainID       int64
+	confirmations *Confirmations
+}
+
+// ChainIDCheck configures how the RPC's chain ID is verified against the
+// configured one. The zero value fails on a mismatch.
+type ChainIDCheck struct {
+	// Skip logs a mismatch as a warning to Logger instead of failing, for
+	// forked or local nodes run under a different chain ID and proxies that
+	// report another one.
+	Skip   bool
+	Logger interfaces.Logger
 }
 
 // NewEthereumClient creates a new Ethereum client.
 func NewEthereumClient(rpcURL string, chainID int64) (interfaces.BlockchainClient, error) {
-	client, err := ethclient.Dial(rpcURL)
+	return NewEthereumClientWithTLS(rpcURL, chainID, TLSOptions{}, nil, nil, ChainIDCheck{})
+}
+
+// NewEthereumClientWithTLS creates a new Ethereum client that connects with the TLS options.
+// Requests wait for limiter when it is set. The head the client reports trails
+// the chain by confirmations; nil uses the chain registry's value for chainID.
+func NewEthereumClientWithTLS(
+	rpcURL string,
+	chainID int64,
+	tlsOpts TLSOptions,
+	limiter *RPCRateLimiter,
+	confirmations *Confirmations,
+	chainIDCheck ChainIDCheck,
+) (interfaces.BlockchainClient, error) {
+	client, err := DialRPC(context.Background(), rpcURL, tlsOpts, limiter)
 	if err != nil {
 		return nil, &errors.BlockchainError{
 			Operation: "Dial",
@@ -30,13 +55,29 @@ func NewEthereumClient(rpcURL string, chainID int64) (interfaces.BlockchainClien
 		}
 	}
 
+	blockchainClient, err := NewEthereumClientFromRPC(client, chainID, confirmations, chainIDCheck)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	return blockchainClient, nil
+}
+
+// NewEthereumClientFromRPC wraps an already connected client, such as one
+// dialed with DialRPCWithTransport. The chain ID is verified as on dial, and
+// the client is left open when it does not match.
+func NewEthereumClientFromRPC(
+	client *ethclient.Client,
+	chainID int64,
+	confirmations *Confirmations,
+	chainIDCheck ChainIDCheck,
+) (interfaces.BlockchainClient, error) {
 	// Verify chain ID.
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	networkID, err := client.ChainID(ctx)
 	if err != nil {
-		client.Close()
 		return nil, &errors.BlockchainError{
 			Operation: "ChainID",
 			ChainID:   chainID,
@@ -45,21 +86,33 @@ func NewEthereumClient(rpcURL string, chainID int64) (interfaces.BlockchainClien
 	}
 
 	if networkID.Int64() != chainID {
-		client.Close()
-		return nil, &errors.BlockchainError{
-			Operation: "ChainID",
-			ChainID:   chainID,
-			Err:       fmt.Errorf("chain ID mismatch: expected %d, got %d", chainID, networkID.Int64()),
+		if !chainIDCheck.Skip {
+			return nil, &errors.BlockchainError{
+				Operation: "ChainID",
+				ChainID:   chainID,
+				Err:       fmt.Errorf("chain ID mismatch: expected %d, got %d", chainID, networkID.Int64()),
+			}
 		}
+
+		// The configured chain ID still selects chain settings such as confirmations.
+		chainIDCheck.Logger.Warn("RPC chain ID does not match the configured chain ID, continuing",
+			"expected", chainID,
+			"actual", networkID.Int64())
+	}
+
+	if confirmations == nil {
+		confirmations = NewConfirmations(chainID)
 	}
 
 	return &ethereumClient{
-		client:  client,
-		chainID: chainID,
+		client:        client,
+		chainID:       chainID,
+		confirmations: confirmations,
 	}, nil
 }
 
-// GetBlockNumber returns the current block number.
+// GetBlockNumber returns the latest block with the configured number of
+// confirmations, so callers never treat a block that may still reorg as head.
 func (c *ethereumClient) GetBlockNumber(ctx context.Context) (uint64, error) {
 	blockNumber, err := c.client.BlockNumber(ctx)
 	if err != nil {
@@ -71,12 +124,17 @@ func (c *ethereumClient) GetBlockNumber(ctx context.Context) (uint64, error) {
 		}
 	}
 
-	return blockNumber, nil
+	confirmations := c.confirmations.Get()
+	if blockNumber < confirmations {
+		return 0, nil
+	}
+	return blockNumber - confirmations, nil
 }
 
-// GetBlockByNumber returns block information by block number.
+// GetBlockByNumber returns block information by block number. Only the header
+// is fetched; the block body is not needed for any of the returned fields.
 func (c *ethereumClient) GetBlockByNumber(ctx context.Context, number *big.Int) (*interfaces.Block, error) {
-	block, err := c.client.BlockByNumber(ctx, number)
+	header, err := c.client.HeaderByNumber(ctx, number)
 	if err != nil {
 		return nil, &errors.BlockchainError{
 			Operation:   "GetBlockByNumber",
@@ -87,16 +145,17 @@ func (c *ethereumClient) GetBlockByNumber(ctx context.Context, number *big.Int)
 	}
 
 	return &interfaces.Block{
-		Number:    block.NumberU64(),
-		Timestamp: time.Unix(int64(block.Time()), 0), // #nosec G115 -- block timestamp is always valid
-		Hash:      block.Hash(),
+		Number:    header.Number.Uint64(),
+		Timestamp: time.Unix(int64(header.Time), 0), // #nosec G115 -- block timestamp is always valid
+		Hash:      header.Hash(),
 	}, nil
 }
 
 // GetBlockByTimestamp returns the block number closest to the given timestamp.
+// The search reads headers only, which is far cheaper than full blocks on busy chains.
 func (c *ethereumClient) GetBlockByTimestamp(ctx context.Context, targetTime time.Time) (uint64, error) {
-	// Get current block.
-	currentBlock, err := c.client.BlockByNumber(ctx, nil)
+	// Get current block header.
+	currentHeader, err := c.client.HeaderByNumber(ctx, nil)
 	if err != nil {
 		return 0, &errors.BlockchainError{
 			Operation: "GetBlockByTimestamp.CurrentBlock",
@@ -108,7 +167,7 @@ func (c *ethereumClient) GetBlockByTimestamp(ctx context.Context, targetTime tim
 	// Binary search for the target block.
 	targetTimestamp := targetTime.Unix()
 	low := uint64(0)
-	high := currentBlock.NumberU64()
+	high := currentHeader.Number.Uint64()
 
 	// Estimate average block time (adjust based on chain).
 	avgBlockTime := int64(12) // Ethereum mainnet average
@@ -117,7 +176,7 @@ func (c *ethereumClient) GetBlockByTimestamp(ctx context.Context, targetTime tim
 	}
 
 	// Initial estimate.
-	currentTime := int64(currentBlock.Time()) // #nosec G115 -- block timestamp is always valid
+	currentTime := int64(currentHeader.Time) // #nosec G115 -- block timestamp is always valid
 	timeDiff := currentTime - targetTimestamp
 	blocksDiff := timeDiff / avgBlockTime
 
@@ -136,7 +195,7 @@ func (c *ethereumClient) GetBlockByTimestamp(ctx context.Context, targetTime tim
 			mid = (low + high) / 2
 		}
 
-		block, err := c.client.BlockByNumber(ctx, big.NewInt(int64(mid))) // #nosec G115 -- mid is always positive
+		header, err := c.client.HeaderByNumber(ctx, big.NewInt(int64(mid))) // #nosec G115 -- mid is always positive
 		if err != nil {
 			return 0, &errors.BlockchainError{
 				Operation:   "GetBlockByTimestamp.Search",
@@ -146,7 +205,7 @@ func (c *ethereumClient) GetBlockByTimestamp(ctx context.Context, targetTime tim
 			}
 		}
 
-		blockTime := int64(block.Time()) // #nosec G115 -- block timestamp is always valid
+		blockTime := int64(header.Time) // #nosec G115 -- block timestamp is always valid
 
 		switch {
 		case blockTime == targetTimestamp:
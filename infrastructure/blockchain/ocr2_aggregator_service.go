@@ -6,28 +6,51 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"chainlink-ocr-checker/domain/entities"
 	"chainlink-ocr-checker/domain/errors"
 	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	ocr2aggregator "github.com/smartcontractkit/libocr/gethwrappers2/accesscontrolledocr2aggregator"
 )
 
+// aggregatorBackend is the subset of the Ethereum client used by the aggregator service.
+// Block timestamps are read with HeaderByNumber, so block bodies are never downloaded.
+type aggregatorBackend interface {
+	bind.ContractBackend
+}
+
 // ocr2AggregatorService implements the OCR2AggregatorService interface.
+// Config reads go through batcher in one round-trip when it is set.
 type ocr2AggregatorService struct {
-	client  *ethclient.Client
+	client  aggregatorBackend
+	batcher rpcBatcher
 	chainID int64
+	logger  interfaces.Logger
+
+	// warnedDigests holds the digests of configs already warned about, so a
+	// config read once per transmission is only reported once.
+	warnedDigests sync.Map
 }
 
 // NewOCR2AggregatorService creates a new OCR2 aggregator service.
-func NewOCR2AggregatorService(client *ethclient.Client, chainID int64) interfaces.OCR2AggregatorService {
+func NewOCR2AggregatorService(
+	client *ethclient.Client,
+	chainID int64,
+	logger interfaces.Logger,
+) interfaces.OCR2AggregatorService {
 	return &ocr2AggregatorService{
 		client:  client,
+		batcher: client.Client(),
 		chainID: chainID,
+		logger:  logger,
 	}
 }
 
@@ -45,37 +68,21 @@ func (s *ocr2AggregatorService) GetLatestRound(
 		}
 	}
 
-	roundData, err := aggregator.LatestAnswer(&bind.CallOpts{Context: ctx})
-	if err != nil {
-		return nil, &errors.BlockchainError{
-			Operation: "GetLatestRound.LatestAnswer",
-			ChainID:   s.chainID,
-			Err:       err,
-		}
-	}
-
-	latestRound, err := aggregator.LatestRound(&bind.CallOpts{Context: ctx})
-	if err != nil {
-		return nil, &errors.BlockchainError{
-			Operation: "GetLatestRound.LatestRound",
-			ChainID:   s.chainID,
-			Err:       err,
-		}
-	}
-
-	timestamp, err := aggregator.LatestTimestamp(&bind.CallOpts{Context: ctx})
+	// Read round ID, answer and timestamp in one call so they describe the same
+	// round even while a new report is being transmitted.
+	roundData, err := aggregator.LatestRoundData(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		return nil, &errors.BlockchainError{
-			Operation: "GetLatestRound.LatestTimestamp",
+			Operation: "GetLatestRound.LatestRoundData",
 			ChainID:   s.chainID,
 			Err:       err,
 		}
 	}
 
 	return &entities.Round{
-		RoundID:   uint32(latestRound.Uint64()), // #nosec G115 -- round ID fits in uint32
-		Answer:    roundData,
-		Timestamp: uint32(timestamp.Uint64()), // #nosec G115 -- timestamp fits in uint32
+		RoundID:   uint32(roundData.RoundId.Uint64()), // #nosec G115 -- round ID fits in uint32
+		Answer:    roundData.Answer,
+		Timestamp: uint32(roundData.UpdatedAt.Uint64()), // #nosec G115 -- timestamp fits in uint32
 	}, nil
 }
 
@@ -99,7 +106,71 @@ func (s *ocr2AggregatorService) GetTransmissions(
 	ctx context.Context,
 	contractAddress common.Address,
 	startBlock, endBlock uint64,
+	opts interfaces.FetchOptions,
 ) ([]entities.Transmission, error) {
+	var (
+		events []*ocr2aggregator.AccessControlledOCR2AggregatorNewTransmission
+		err    error
+	)
+	if opts.RawLogs {
+		events, err = s.filterTransmissionLogs(ctx, contractAddress, startBlock, endBlock)
+	} else {
+		events, err = s.filterTransmissionEvents(ctx, contractAddress, startBlock, endBlock)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	transmissions := make([]entities.Transmission, 0, len(events))
+
+	for _, event := range events {
+		// Drop other transmitters and configs before any per-event lookups.
+		if opts.Transmitter != (common.Address{}) && event.Transmitter != opts.Transmitter {
+			continue
+		}
+		if opts.ConfigDigest != ([32]byte{}) && event.ConfigDigest != opts.ConfigDigest {
+			continue
+		}
+
+		// Get block timestamp unless the caller opted out.
+		var blockTimestamp time.Time
+		if !opts.SkipTimestamps {
+			// #nosec G115 -- block number is valid
+			header, err := s.client.HeaderByNumber(ctx, big.NewInt(int64(event.Raw.BlockNumber)))
+			if err != nil {
+				return nil, &errors.BlockchainError{
+					Operation:   "GetTransmissions.HeaderByNumber",
+					ChainID:     s.chainID,
+					BlockNumber: event.Raw.BlockNumber,
+					Err:         err,
+				}
+			}
+			blockTimestamp = time.Unix(int64(header.Time), 0) // #nosec G115 -- block timestamp is valid
+		}
+
+		// Map transmitter index to observer index.
+		observerIndex, err := s.getObserverIndex(ctx, contractAddress, event.Transmitter, event.Raw.BlockNumber)
+		if err != nil {
+			// Log error but continue processing.
+			observerIndex = entities.UnknownObserverIndex
+		}
+
+		transmission := transmissionFromEvent(contractAddress, event)
+		transmission.ObserverIndex = observerIndex
+		transmission.BlockTimestamp = blockTimestamp
+
+		transmissions = append(transmissions, transmission)
+	}
+
+	return transmissions, nil
+}
+
+// filterTransmissionEvents reads NewTransmission events through the generated binding.
+func (s *ocr2AggregatorService) filterTransmissionEvents(
+	ctx context.Context,
+	contractAddress common.Address,
+	startBlock, endBlock uint64,
+) ([]*ocr2aggregator.AccessControlledOCR2AggregatorNewTransmission, error) {
 	aggregator, err := ocr2aggregator.NewAccessControlledOCR2Aggregator(contractAddress, s.client)
 	if err != nil {
 		return nil, &errors.BlockchainError{
@@ -129,62 +200,128 @@ func (s *ocr2AggregatorService) GetTransmissions(
 	}
 	defer func() { _ = iter.Close() }()
 
-	var transmissions []entities.Transmission
-
+	var events []*ocr2aggregator.AccessControlledOCR2AggregatorNewTransmission
 	for iter.Next() {
-		event := iter.Event
+		events = append(events, iter.Event)
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, &errors.BlockchainError{
+			Operation:   "GetTransmissions.Iterator",
+			ChainID:     s.chainID,
+			BlockNumber: startBlock,
+			Err:         err,
+		}
+	}
 
-		// Get block information.
-		// #nosec G115 -- block number is valid
-		block, err := s.client.BlockByNumber(ctx, big.NewInt(int64(event.Raw.BlockNumber)))
+	return events, nil
+}
+
+// filterTransmissionLogs reads NewTransmission logs with one eth_getLogs call
+// filtered by contract address and event topic, and decodes them against the ABI.
+func (s *ocr2AggregatorService) filterTransmissionLogs(
+	ctx context.Context,
+	contractAddress common.Address,
+	startBlock, endBlock uint64,
+) ([]*ocr2aggregator.AccessControlledOCR2AggregatorNewTransmission, error) {
+	parsed, err := aggregatorABI()
+	if err != nil {
+		return nil, &errors.BlockchainError{
+			Operation:   "GetTransmissions.ABI",
+			ChainID:     s.chainID,
+			BlockNumber: startBlock,
+			Err:         err,
+		}
+	}
+
+	logs, err := s.client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(startBlock),
+		ToBlock:   new(big.Int).SetUint64(endBlock),
+		Addresses: []common.Address{contractAddress},
+		Topics:    [][]common.Hash{{parsed.Events["NewTransmission"].ID}},
+	})
+	if err != nil {
+		return nil, &errors.BlockchainError{
+			Operation:   "GetTransmissions.FilterLogs",
+			ChainID:     s.chainID,
+			BlockNumber: startBlock,
+			Err:         err,
+		}
+	}
+
+	events := make([]*ocr2aggregator.AccessControlledOCR2AggregatorNewTransmission, 0, len(logs))
+	for _, log := range logs {
+		event, err := decodeNewTransmission(parsed, log)
 		if err != nil {
 			return nil, &errors.BlockchainError{
-				Operation:   "GetTransmissions.BlockByNumber",
+				Operation:   "GetTransmissions.DecodeLog",
 				ChainID:     s.chainID,
-				BlockNumber: event.Raw.BlockNumber,
+				BlockNumber: log.BlockNumber,
 				Err:         err,
 			}
 		}
+		events = append(events, event)
+	}
 
-		// Extract epoch and round from EpochAndRound.
-		epochAndRound := event.EpochAndRound.Uint64()
-		epoch := uint32(epochAndRound >> 8) // #nosec G115 -- epoch fits in uint32
-		round := uint8(epochAndRound & 0xFF) // #nosec G115 -- round is masked to 8 bits
-
-		// Map transmitter index to observer index.
-		observerIndex, err := s.getObserverIndex(ctx, contractAddress, event.Transmitter, event.Raw.BlockNumber)
-		if err != nil {
-			// Log error but continue processing.
-			observerIndex = 255 // Unknown
-		}
+	return events, nil
+}
 
-		transmission := entities.Transmission{
-			ContractAddress:    contractAddress,
-			ConfigDigest:       event.ConfigDigest,
-			Epoch:              epoch,
-			Round:              round,
-			LatestAnswer:       event.Answer,
-			LatestTimestamp:    event.ObservationsTimestamp,
-			TransmitterIndex:   uint8(event.Transmitter.Big().Uint64() % 256), // #nosec G115 -- modulo ensures fit in uint8
-			TransmitterAddress: event.Transmitter,
-			ObserverIndex:      observerIndex,
-			BlockNumber:        event.Raw.BlockNumber,
-			BlockTimestamp:     time.Unix(int64(block.Time()), 0), // #nosec G115 -- block timestamp is valid
-		}
+// decodeNewTransmission decodes a NewTransmission log into the binding's event type.
+func decodeNewTransmission(
+	parsed *abi.ABI,
+	log types.Log,
+) (*ocr2aggregator.AccessControlledOCR2AggregatorNewTransmission, error) {
+	definition := parsed.Events["NewTransmission"]
+	if len(log.Topics) == 0 || log.Topics[0] != definition.ID {
+		return nil, fmt.Errorf("log is not a NewTransmission event")
+	}
 
-		transmissions = append(transmissions, transmission)
+	event := new(ocr2aggregator.AccessControlledOCR2AggregatorNewTransmission)
+	if err := parsed.UnpackIntoInterface(event, "NewTransmission", log.Data); err != nil {
+		return nil, err
 	}
 
-	if err := iter.Error(); err != nil {
-		return nil, &errors.BlockchainError{
-			Operation:   "GetTransmissions.Iterator",
-			ChainID:     s.chainID,
-			BlockNumber: startBlock,
-			Err:         err,
+	var indexed abi.Arguments
+	for _, input := range definition.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
 		}
 	}
+	if err := abi.ParseTopics(event, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
 
-	return transmissions, nil
+	event.Raw = log
+	return event, nil
+}
+
+// transmissionFromEvent converts a NewTransmission event into a transmission.
+// The observer index and block timestamp are left for the caller to resolve.
+func transmissionFromEvent(
+	contractAddress common.Address,
+	event *ocr2aggregator.AccessControlledOCR2AggregatorNewTransmission,
+) entities.Transmission {
+	// Extract epoch and round from EpochAndRound.
+	epochAndRound := event.EpochAndRound.Uint64()
+	epoch := uint32(epochAndRound >> 8)  // #nosec G115 -- epoch fits in uint32
+	round := uint8(epochAndRound & 0xFF) // #nosec G115 -- round is masked to 8 bits
+
+	return entities.Transmission{
+		ContractAddress:    contractAddress,
+		ConfigDigest:       event.ConfigDigest,
+		Epoch:              epoch,
+		Round:              round,
+		AggregatorRoundID:  event.AggregatorRoundId,
+		LatestAnswer:       event.Answer,
+		JuelsPerFeeCoin:    event.JuelsPerFeeCoin,
+		LatestTimestamp:    event.ObservationsTimestamp,
+		TransmitterIndex:   uint8(event.Transmitter.Big().Uint64() % 256), // #nosec G115 -- modulo ensures fit in uint8
+		TransmitterAddress: event.Transmitter,
+		ObserverIndex:      entities.UnknownObserverIndex,
+		ObserverCount:      uint8(len(event.Observers)), // #nosec G115 -- at most 31 observers
+		Observers:          append([]uint8(nil), event.Observers...),
+		BlockNumber:        event.Raw.BlockNumber,
+	}
 }
 
 // GetConfig returns the current OCR2 configuration.
@@ -201,58 +338,156 @@ func (s *ocr2AggregatorService) GetConfigFromBlock(
 	contractAddress common.Address,
 	blockNumber uint64,
 ) (*entities.OCR2Config, error) {
+	var (
+		configDigest [32]byte
+		transmitters []common.Address
+		err          error
+	)
+	if s.batcher != nil {
+		configDigest, transmitters, err = s.readConfigBatched(ctx, contractAddress, blockNumber)
+	} else {
+		configDigest, transmitters, err = s.readConfig(ctx, contractAddress, blockNumber)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Create OCR2Config.
+	config := &entities.OCR2Config{
+		ConfigDigest: configDigest,
+		Transmitters: transmitters,
+		Threshold:    8, // Default threshold, actual value needs to be retrieved from contract
+	}
+
+	// Signers are not directly available in the standard OCR2 aggregator.
+
+	s.warnDuplicateTransmitters(contractAddress, config)
+
+	return config, nil
+}
+
+// warnDuplicateTransmitters logs, once per config digest, each transmitter the
+// config lists more than once.
+func (s *ocr2AggregatorService) warnDuplicateTransmitters(contractAddress common.Address, config *entities.OCR2Config) {
+	duplicates := config.DuplicateTransmitters()
+	if len(duplicates) == 0 {
+		return
+	}
+	if _, warned := s.warnedDigests.LoadOrStore(config.ConfigDigest, true); warned {
+		return
+	}
+
+	for _, duplicate := range duplicates {
+		s.logger.Warn("Duplicate transmitter in config",
+			"contract", contractAddress.Hex(),
+			"configDigest", fmt.Sprintf("%x", config.ConfigDigest),
+			"transmitter", duplicate.Address.Hex(),
+			"indices", duplicate.Indices,
+			"usedIndex", duplicate.Indices[0])
+	}
+}
+
+// GetConfigHistory returns the ConfigSet events for a block range in block order.
+func (s *ocr2AggregatorService) GetConfigHistory(
+	ctx context.Context,
+	contractAddress common.Address,
+	startBlock, endBlock uint64,
+) ([]entities.ConfigSetEvent, error) {
 	aggregator, err := ocr2aggregator.NewAccessControlledOCR2Aggregator(contractAddress, s.client)
 	if err != nil {
 		return nil, &errors.BlockchainError{
-			Operation:   "GetConfigFromBlock.NewAggregator",
+			Operation:   "GetConfigHistory.NewAggregator",
 			ChainID:     s.chainID,
-			BlockNumber: blockNumber,
+			BlockNumber: startBlock,
 			Err:         err,
 		}
 	}
 
-	var callOpts *bind.CallOpts
-	if blockNumber > 0 {
-		callOpts = &bind.CallOpts{
-			Context:     ctx,
-			BlockNumber: big.NewInt(int64(blockNumber)), // #nosec G115 -- block number is valid
+	iter, err := aggregator.FilterConfigSet(&bind.FilterOpts{
+		Start:   startBlock,
+		End:     &endBlock,
+		Context: ctx,
+	})
+	if err != nil {
+		return nil, &errors.BlockchainError{
+			Operation:   "GetConfigHistory.FilterConfigSet",
+			ChainID:     s.chainID,
+			BlockNumber: startBlock,
+			Err:         err,
 		}
-	} else {
-		callOpts = &bind.CallOpts{Context: ctx}
 	}
+	defer func() { _ = iter.Close() }()
 
-	// Get latest config details.
-	configDetails, err := aggregator.LatestConfigDetails(callOpts)
-	if err != nil {
+	var events []entities.ConfigSetEvent
+	for iter.Next() {
+		event := iter.Event
+		events = append(events, entities.ConfigSetEvent{
+			BlockNumber: event.Raw.BlockNumber,
+			LogIndex:    event.Raw.Index,
+			ConfigCount: event.ConfigCount,
+			Config: entities.OCR2Config{
+				ConfigDigest:         event.ConfigDigest,
+				Signers:              event.Signers,
+				Transmitters:         event.Transmitters,
+				Threshold:            event.F,
+				OnchainConfig:        event.OnchainConfig,
+				EncodedConfigVersion: event.OffchainConfigVersion,
+				Encoded:              event.OffchainConfig,
+			},
+		})
+	}
+
+	if err := iter.Error(); err != nil {
 		return nil, &errors.BlockchainError{
-			Operation:   "GetConfigFromBlock.LatestConfigDetails",
+			Operation:   "GetConfigHistory.Iterator",
 			ChainID:     s.chainID,
-			BlockNumber: blockNumber,
+			BlockNumber: startBlock,
 			Err:         err,
 		}
 	}
 
-	// Get transmitters.
-	transmitters, err := aggregator.GetTransmitters(callOpts)
+	entities.SortConfigSetEvents(events)
+
+	return events, nil
+}
+
+// GetLatestConfigSet returns the ConfigSet event of the contract's current configuration.
+func (s *ocr2AggregatorService) GetLatestConfigSet(
+	ctx context.Context,
+	contractAddress common.Address,
+) (*entities.ConfigSetEvent, error) {
+	aggregator, err := ocr2aggregator.NewAccessControlledOCR2Aggregator(contractAddress, s.client)
 	if err != nil {
 		return nil, &errors.BlockchainError{
-			Operation:   "GetConfigFromBlock.GetTransmitters",
-			ChainID:     s.chainID,
-			BlockNumber: blockNumber,
-			Err:         err,
+			Operation: "GetLatestConfigSet.NewAggregator",
+			ChainID:   s.chainID,
+			Err:       err,
 		}
 	}
 
-	// Create OCR2Config.
-	config := &entities.OCR2Config{
-		ConfigDigest: configDetails.ConfigDigest,
-		Transmitters: transmitters,
-		Threshold:    8, // Default threshold, actual value needs to be retrieved from contract
+	details, err := aggregator.LatestConfigDetails(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, &errors.BlockchainError{
+			Operation: "GetLatestConfigSet.LatestConfigDetails",
+			ChainID:   s.chainID,
+			Err:       err,
+		}
 	}
 
-	// Signers are not directly available in the standard OCR2 aggregator.
+	block := uint64(details.BlockNumber)
+	events, err := s.GetConfigHistory(ctx, contractAddress, block, block)
+	if err != nil {
+		return nil, err
+	}
 
-	return config, nil
+	for i := range events {
+		if events[i].Config.ConfigDigest == details.ConfigDigest {
+			return &events[i], nil
+		}
+	}
+
+	return nil, errors.NewDomainError(errors.ErrNotFound,
+		fmt.Sprintf("no ConfigSet event for the current config of %s in block %d", contractAddress.Hex(), block))
 }
 
 // getObserverIndex maps transmitter address to observer index.
@@ -267,10 +502,8 @@ func (s *ocr2AggregatorService) getObserverIndex(
 		return 0, err
 	}
 
-	for i, transmitter := range config.Transmitters {
-		if transmitter == transmitterAddr {
-			return uint8(i), nil // #nosec G115 -- range check ensures fit in uint8
-		}
+	if index, ok := config.TransmitterIndex(transmitterAddr); ok {
+		return index, nil
 	}
 
 	return 0, fmt.Errorf("transmitter %s not found in config", transmitterAddr.Hex())
@@ -0,0 +1,477 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang/mock/gomock"
+	ocr2aggregator "github.com/smartcontractkit/libocr/gethwrappers2/accesscontrolledocr2aggregator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAggregatorBackend serves canned NewTransmission logs and counts header and
+// full block lookups. Methods not overridden panic through the nil embedded backend.
+type fakeAggregatorBackend struct {
+	bind.ContractBackend
+
+	logs []types.Log
+
+	headerLookups  int
+	blockLookups   int
+	blockTimestamp uint64
+}
+
+func (b *fakeAggregatorBackend) FilterLogs(_ context.Context, _ ethereum.FilterQuery) ([]types.Log, error) {
+	return b.logs, nil
+}
+
+func (b *fakeAggregatorBackend) SubscribeFilterLogs(
+	_ context.Context,
+	_ ethereum.FilterQuery,
+	_ chan<- types.Log,
+) (ethereum.Subscription, error) {
+	return nil, errors.New("not supported")
+}
+
+func (b *fakeAggregatorBackend) CallContract(_ context.Context, _ ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	return nil, errors.New("execution reverted")
+}
+
+func (b *fakeAggregatorBackend) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	b.headerLookups++
+	return &types.Header{Number: number, Time: b.blockTimestamp}, nil
+}
+
+func (b *fakeAggregatorBackend) BlockByNumber(_ context.Context, number *big.Int) (*types.Block, error) {
+	b.blockLookups++
+	return types.NewBlockWithHeader(&types.Header{Number: number, Time: b.blockTimestamp}), nil
+}
+
+func newTransmissionLog(t testing.TB, contract, transmitter common.Address, blockNumber uint64) types.Log {
+	parsed, err := ocr2aggregator.AccessControlledOCR2AggregatorMetaData.GetAbi()
+	require.NoError(t, err)
+
+	event := parsed.Events["NewTransmission"]
+	data, err := event.Inputs.NonIndexed().Pack(
+		big.NewInt(100),           // answer
+		transmitter,               // transmitter
+		uint32(1700000000),        // observationsTimestamp
+		[]*big.Int{big.NewInt(1)}, // observations
+		[]byte{0},                 // observers
+		big.NewInt(4200000000),    // juelsPerFeeCoin
+		[32]byte{1},               // configDigest
+		big.NewInt(1<<8|2),        // epochAndRound
+	)
+	require.NoError(t, err)
+
+	return types.Log{
+		Address:     contract,
+		Topics:      []common.Hash{event.ID, common.BigToHash(big.NewInt(7))},
+		Data:        data,
+		BlockNumber: blockNumber,
+	}
+}
+
+func TestOCR2AggregatorService_GetTransmissions_Timestamps(t *testing.T) {
+	ctx := context.Background()
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000000")
+
+	newService := func() (*ocr2AggregatorService, *fakeAggregatorBackend) {
+		backend := &fakeAggregatorBackend{
+			logs: []types.Log{
+				newTransmissionLog(t, contract, transmitter, 10),
+				newTransmissionLog(t, contract, transmitter, 11),
+			},
+			blockTimestamp: 1700000123,
+		}
+		return &ocr2AggregatorService{client: backend, chainID: 1}, backend
+	}
+
+	t.Run("enriches block timestamps by default", func(t *testing.T) {
+		service, backend := newService()
+
+		transmissions, err := service.GetTransmissions(ctx, contract, 10, 11, interfaces.FetchOptions{})
+		require.NoError(t, err)
+		require.Len(t, transmissions, 2)
+		assert.Equal(t, 2, backend.headerLookups)
+		assert.Zero(t, backend.blockLookups, "timestamps must not download block bodies")
+		assert.Equal(t, time.Unix(1700000123, 0), transmissions[0].BlockTimestamp)
+	})
+
+	t.Run("skips block lookups when requested", func(t *testing.T) {
+		service, backend := newService()
+
+		transmissions, err := service.GetTransmissions(ctx, contract, 10, 11, interfaces.FetchOptions{
+			SkipTimestamps: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, transmissions, 2)
+		assert.Zero(t, backend.headerLookups)
+		assert.True(t, transmissions[0].BlockTimestamp.IsZero())
+		assert.Equal(t, uint64(10), transmissions[0].BlockNumber)
+		assert.Equal(t, uint64(11), transmissions[1].BlockNumber)
+		assert.Equal(t, uint32(1), transmissions[0].Epoch)
+		assert.Equal(t, uint8(2), transmissions[0].Round)
+		assert.Equal(t, uint32(7), transmissions[0].AggregatorRoundID)
+		assert.Equal(t, uint8(1), transmissions[0].ObserverCount)
+		assert.Equal(t, []uint8{0}, transmissions[0].Observers)
+	})
+}
+
+// roundDataBackend answers latestRoundData calls and records every aggregator method called.
+type roundDataBackend struct {
+	fakeAggregatorBackend
+
+	t       testing.TB
+	calls   []string
+	roundID int64
+	answer  int64
+	updated int64
+}
+
+func (b *roundDataBackend) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	parsed, err := ocr2aggregator.AccessControlledOCR2AggregatorMetaData.GetAbi()
+	require.NoError(b.t, err)
+
+	method, err := parsed.MethodById(call.Data[:4])
+	require.NoError(b.t, err)
+	b.calls = append(b.calls, method.Name)
+	if method.Name != "latestRoundData" {
+		return nil, errors.New("execution reverted")
+	}
+
+	return method.Outputs.Pack(
+		big.NewInt(b.roundID),   // roundId
+		big.NewInt(b.answer),    // answer
+		big.NewInt(b.updated-5), // startedAt
+		big.NewInt(b.updated),   // updatedAt
+		big.NewInt(b.roundID),   // answeredInRound
+	)
+}
+
+func TestOCR2AggregatorService_GetLatestRound_SingleCall(t *testing.T) {
+	backend := &roundDataBackend{t: t, roundID: 42, answer: -1234, updated: 1700000000}
+	service := &ocr2AggregatorService{client: backend, chainID: 1}
+
+	round, err := service.GetLatestRound(context.Background(), common.HexToAddress("0x1000000000000000000000000000000000000001"))
+	require.NoError(t, err)
+
+	// Round, answer and timestamp must come from one atomic latestRoundData call.
+	assert.Equal(t, []string{"latestRoundData"}, backend.calls)
+	assert.Equal(t, &entities.Round{
+		RoundID:   42,
+		Answer:    big.NewInt(-1234),
+		Timestamp: 1700000000,
+	}, round)
+}
+
+func newConfigSetLog(
+	t *testing.T,
+	contract common.Address,
+	blockNumber uint64,
+	configCount uint64,
+	f uint8,
+	transmitters []common.Address,
+) types.Log {
+	parsed, err := ocr2aggregator.AccessControlledOCR2AggregatorMetaData.GetAbi()
+	require.NoError(t, err)
+
+	signers := make([]common.Address, len(transmitters))
+	for i := range transmitters {
+		signers[i] = common.BigToAddress(big.NewInt(int64(i + 1)))
+	}
+
+	event := parsed.Events["ConfigSet"]
+	data, err := event.Inputs.NonIndexed().Pack(
+		uint32(0),                   // previousConfigBlockNumber
+		[32]byte{byte(configCount)}, // configDigest
+		configCount,                 // configCount
+		signers,                     // signers
+		transmitters,                // transmitters
+		f,                           // f
+		[]byte{},                    // onchainConfig
+		uint64(2),                   // offchainConfigVersion
+		[]byte{},                    // offchainConfig
+	)
+	require.NoError(t, err)
+
+	return types.Log{
+		Address:     contract,
+		Topics:      []common.Hash{event.ID},
+		Data:        data,
+		BlockNumber: blockNumber,
+	}
+}
+
+func TestOCR2AggregatorService_GetConfigHistory(t *testing.T) {
+	ctx := context.Background()
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	first := []common.Address{
+		common.HexToAddress("0xa000000000000000000000000000000000000001"),
+		common.HexToAddress("0xa000000000000000000000000000000000000002"),
+		common.HexToAddress("0xa000000000000000000000000000000000000003"),
+		common.HexToAddress("0xa000000000000000000000000000000000000004"),
+	}
+	second := append([]common.Address{common.HexToAddress("0xb000000000000000000000000000000000000001")}, first[1:]...)
+
+	// Logs arrive out of order; the service returns them by block.
+	backend := &fakeAggregatorBackend{
+		logs: []types.Log{
+			newConfigSetLog(t, contract, 300, 3, 1, second),
+			newConfigSetLog(t, contract, 100, 1, 1, first),
+			newConfigSetLog(t, contract, 200, 2, 1, first[:3]),
+		},
+	}
+	service := &ocr2AggregatorService{client: backend, chainID: 1}
+
+	events, err := service.GetConfigHistory(ctx, contract, 0, 1000)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	for i, blockNumber := range []uint64{100, 200, 300} {
+		assert.Equal(t, blockNumber, events[i].BlockNumber)
+		assert.Equal(t, uint64(i+1), events[i].ConfigCount)
+		assert.Equal(t, [32]byte{byte(i + 1)}, events[i].Config.ConfigDigest)
+		assert.Equal(t, uint8(1), events[i].Config.Threshold)
+	}
+	assert.Equal(t, first, events[0].Config.Transmitters)
+	assert.Equal(t, first[:3], events[1].Config.Transmitters)
+	assert.Equal(t, second, events[2].Config.Transmitters)
+	assert.Len(t, events[2].Config.Signers, 4)
+
+	// No header lookups are needed for config history.
+	assert.Zero(t, backend.headerLookups)
+}
+
+// fakeBatcher answers batched eth_calls from canned method outputs and counts round-trips.
+type fakeBatcher struct {
+	t       *testing.T
+	outputs map[string][]byte
+
+	batches int
+	calls   int
+}
+
+func (b *fakeBatcher) BatchCallContext(_ context.Context, batch []rpc.BatchElem) error {
+	b.batches++
+
+	parsed, err := ocr2aggregator.AccessControlledOCR2AggregatorMetaData.GetAbi()
+	require.NoError(b.t, err)
+
+	for i := range batch {
+		b.calls++
+		require.Equal(b.t, "eth_call", batch[i].Method)
+		require.Len(b.t, batch[i].Args, 2)
+		assert.Equal(b.t, "0x64", batch[i].Args[1])
+
+		call, ok := batch[i].Args[0].(map[string]interface{})
+		require.True(b.t, ok)
+		method, err := parsed.MethodById(call["input"].(hexutil.Bytes))
+		require.NoError(b.t, err)
+
+		*batch[i].Result.(*hexutil.Bytes) = b.outputs[method.Name]
+	}
+
+	return nil
+}
+
+func TestOCR2AggregatorService_GetConfigFromBlock_Batched(t *testing.T) {
+	ctx := context.Background()
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	transmitters := []common.Address{
+		common.HexToAddress("0xa000000000000000000000000000000000000001"),
+		common.HexToAddress("0xa000000000000000000000000000000000000002"),
+	}
+
+	parsed, err := ocr2aggregator.AccessControlledOCR2AggregatorMetaData.GetAbi()
+	require.NoError(t, err)
+	details, err := parsed.Methods["latestConfigDetails"].Outputs.Pack(uint32(3), uint32(90), [32]byte{9})
+	require.NoError(t, err)
+	transmitterOutput, err := parsed.Methods["getTransmitters"].Outputs.Pack(transmitters)
+	require.NoError(t, err)
+
+	batcher := &fakeBatcher{
+		t: t,
+		outputs: map[string][]byte{
+			"latestConfigDetails": details,
+			"getTransmitters":     transmitterOutput,
+		},
+	}
+	// The backend rejects direct calls, so any unbatched read fails the test.
+	service := &ocr2AggregatorService{client: &fakeAggregatorBackend{}, batcher: batcher, chainID: 1}
+
+	config, err := service.GetConfigFromBlock(ctx, contract, 100)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{9}, config.ConfigDigest)
+	assert.Equal(t, transmitters, config.Transmitters)
+
+	assert.Equal(t, 1, batcher.batches, "config reads should share one round-trip")
+	assert.Equal(t, 2, batcher.calls)
+
+	index, ok := config.TransmitterIndex(transmitters[1])
+	require.True(t, ok)
+	assert.Equal(t, uint8(1), index)
+}
+
+func TestOCR2AggregatorService_GetConfigFromBlock_DuplicateTransmitters(t *testing.T) {
+	ctx := context.Background()
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	duplicated := common.HexToAddress("0xa000000000000000000000000000000000000001")
+	transmitters := []common.Address{
+		duplicated,
+		common.HexToAddress("0xa000000000000000000000000000000000000002"),
+		duplicated,
+	}
+
+	parsed, err := ocr2aggregator.AccessControlledOCR2AggregatorMetaData.GetAbi()
+	require.NoError(t, err)
+	details, err := parsed.Methods["latestConfigDetails"].Outputs.Pack(uint32(3), uint32(90), [32]byte{9})
+	require.NoError(t, err)
+	transmitterOutput, err := parsed.Methods["getTransmitters"].Outputs.Pack(transmitters)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	logger := mocks.NewMockLogger(ctrl)
+	// Warned once per digest, however often the config is read.
+	logger.EXPECT().Warn("Duplicate transmitter in config",
+		"contract", contract.Hex(),
+		"configDigest", fmt.Sprintf("%x", [32]byte{9}),
+		"transmitter", duplicated.Hex(),
+		"indices", []int{0, 2},
+		"usedIndex", 0).Times(1)
+
+	service := &ocr2AggregatorService{
+		client: &fakeAggregatorBackend{},
+		batcher: &fakeBatcher{
+			t: t,
+			outputs: map[string][]byte{
+				"latestConfigDetails": details,
+				"getTransmitters":     transmitterOutput,
+			},
+		},
+		chainID: 1,
+		logger:  logger,
+	}
+
+	for i := 0; i < 2; i++ {
+		config, err := service.GetConfigFromBlock(ctx, contract, 100)
+		require.NoError(t, err)
+		assert.Equal(t, transmitters, config.Transmitters)
+
+		// The duplicate always maps to its first position.
+		index, ok := config.TransmitterIndex(duplicated)
+		require.True(t, ok)
+		assert.Equal(t, uint8(0), index)
+	}
+}
+
+func TestOCR2AggregatorService_GetTransmissions_RawLogs(t *testing.T) {
+	ctx := context.Background()
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	first := common.HexToAddress("0xa000000000000000000000000000000000000001")
+	second := common.HexToAddress("0xa000000000000000000000000000000000000002")
+
+	backend := &fakeAggregatorBackend{
+		logs: []types.Log{
+			newTransmissionLog(t, contract, first, 10),
+			newTransmissionLog(t, contract, second, 11),
+			newTransmissionLog(t, contract, first, 12),
+		},
+		blockTimestamp: 1700000123,
+	}
+	service := &ocr2AggregatorService{client: backend, chainID: 1}
+
+	bound, err := service.GetTransmissions(ctx, contract, 10, 12, interfaces.FetchOptions{})
+	require.NoError(t, err)
+	require.Len(t, bound, 3)
+
+	raw, err := service.GetTransmissions(ctx, contract, 10, 12, interfaces.FetchOptions{RawLogs: true})
+	require.NoError(t, err)
+	assert.Equal(t, bound, raw)
+
+	// Other transmitters are dropped before block lookups.
+	backend.headerLookups = 0
+	filtered, err := service.GetTransmissions(ctx, contract, 10, 12, interfaces.FetchOptions{
+		RawLogs:     true,
+		Transmitter: first,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []entities.Transmission{bound[0], bound[2]}, filtered)
+	assert.Equal(t, 2, backend.headerLookups)
+}
+
+func TestOCR2AggregatorService_GetTransmissions_ConfigDigest(t *testing.T) {
+	ctx := context.Background()
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000001")
+
+	// Every canned log is under config digest {1}.
+	backend := &fakeAggregatorBackend{
+		logs: []types.Log{
+			newTransmissionLog(t, contract, transmitter, 10),
+			newTransmissionLog(t, contract, transmitter, 11),
+		},
+	}
+	service := &ocr2AggregatorService{client: backend, chainID: 1}
+
+	matching, err := service.GetTransmissions(ctx, contract, 10, 11, interfaces.FetchOptions{
+		ConfigDigest: [32]byte{1},
+	})
+	require.NoError(t, err)
+	require.Len(t, matching, 2)
+	for _, tx := range matching {
+		assert.Equal(t, [32]byte{1}, tx.ConfigDigest)
+	}
+
+	// Other configs are dropped before block lookups.
+	backend.headerLookups = 0
+	other, err := service.GetTransmissions(ctx, contract, 10, 11, interfaces.FetchOptions{
+		ConfigDigest: [32]byte{2},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, other)
+	assert.Zero(t, backend.headerLookups)
+}
+
+func BenchmarkOCR2AggregatorService_GetTransmissions(b *testing.B) {
+	ctx := context.Background()
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000000")
+
+	logs := make([]types.Log, 1000)
+	for i := range logs {
+		logs[i] = newTransmissionLog(b, contract, transmitter, uint64(i))
+	}
+	service := &ocr2AggregatorService{client: &fakeAggregatorBackend{logs: logs}, chainID: 1}
+
+	for _, bc := range []struct {
+		name string
+		opts interfaces.FetchOptions
+	}{
+		{name: "binding", opts: interfaces.FetchOptions{SkipTimestamps: true}},
+		{name: "raw-logs", opts: interfaces.FetchOptions{SkipTimestamps: true, RawLogs: true}},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := service.GetTransmissions(ctx, contract, 0, 999, bc.opts); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
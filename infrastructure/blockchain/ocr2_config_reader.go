@@ -0,0 +1,156 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"chainlink-ocr-checker/domain/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	ocr2aggregator "github.com/smartcontractkit/libocr/gethwrappers2/accesscontrolledocr2aggregator"
+)
+
+// rpcBatcher sends several JSON-RPC requests in one round-trip.
+type rpcBatcher interface {
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// Aggregator methods read to build a config.
+const (
+	methodLatestConfigDetails = "latestConfigDetails"
+	methodGetTransmitters     = "getTransmitters"
+)
+
+// aggregatorABI returns the parsed OCR2 aggregator ABI.
+var aggregatorABI = sync.OnceValues(func() (*abi.ABI, error) {
+	return ocr2aggregator.AccessControlledOCR2AggregatorMetaData.GetAbi()
+})
+
+// readConfig reads the config digest and transmitters with one call per method.
+func (s *ocr2AggregatorService) readConfig(
+	ctx context.Context,
+	contractAddress common.Address,
+	blockNumber uint64,
+) ([32]byte, []common.Address, error) {
+	aggregator, err := ocr2aggregator.NewAccessControlledOCR2Aggregator(contractAddress, s.client)
+	if err != nil {
+		return [32]byte{}, nil, &errors.BlockchainError{
+			Operation:   "GetConfigFromBlock.NewAggregator",
+			ChainID:     s.chainID,
+			BlockNumber: blockNumber,
+			Err:         err,
+		}
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx}
+	if blockNumber > 0 {
+		callOpts.BlockNumber = new(big.Int).SetUint64(blockNumber)
+	}
+
+	configDetails, err := aggregator.LatestConfigDetails(callOpts)
+	if err != nil {
+		return [32]byte{}, nil, &errors.BlockchainError{
+			Operation:   "GetConfigFromBlock.LatestConfigDetails",
+			ChainID:     s.chainID,
+			BlockNumber: blockNumber,
+			Err:         err,
+		}
+	}
+
+	transmitters, err := aggregator.GetTransmitters(callOpts)
+	if err != nil {
+		return [32]byte{}, nil, &errors.BlockchainError{
+			Operation:   "GetConfigFromBlock.GetTransmitters",
+			ChainID:     s.chainID,
+			BlockNumber: blockNumber,
+			Err:         err,
+		}
+	}
+
+	return configDetails.ConfigDigest, transmitters, nil
+}
+
+// readConfigBatched reads the config digest and transmitters in a single batch of eth_calls.
+func (s *ocr2AggregatorService) readConfigBatched(
+	ctx context.Context,
+	contractAddress common.Address,
+	blockNumber uint64,
+) ([32]byte, []common.Address, error) {
+	fail := func(operation string, err error) ([32]byte, []common.Address, error) {
+		return [32]byte{}, nil, &errors.BlockchainError{
+			Operation:   "GetConfigFromBlock." + operation,
+			ChainID:     s.chainID,
+			BlockNumber: blockNumber,
+			Err:         err,
+		}
+	}
+
+	parsed, err := aggregatorABI()
+	if err != nil {
+		return fail("ABI", err)
+	}
+
+	block := "latest"
+	if blockNumber > 0 {
+		block = hexutil.EncodeUint64(blockNumber)
+	}
+
+	methods := []string{methodLatestConfigDetails, methodGetTransmitters}
+	results := make([]hexutil.Bytes, len(methods))
+	batch := make([]rpc.BatchElem, len(methods))
+	for i, method := range methods {
+		input, err := parsed.Pack(method)
+		if err != nil {
+			return fail("Pack", fmt.Errorf("%s: %w", method, err))
+		}
+		batch[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args: []interface{}{
+				map[string]interface{}{"to": contractAddress, "input": hexutil.Bytes(input)},
+				block,
+			},
+			Result: &results[i],
+		}
+	}
+
+	if err := s.batcher.BatchCallContext(ctx, batch); err != nil {
+		return fail("BatchCall", err)
+	}
+
+	unpacked := make([][]interface{}, len(methods))
+	for i, method := range methods {
+		if batch[i].Error != nil {
+			return fail(method, batch[i].Error)
+		}
+		values, err := parsed.Unpack(method, results[i])
+		if err != nil {
+			return fail(method, err)
+		}
+		unpacked[i] = values
+	}
+
+	// latestConfigDetails returns (configCount, blockNumber, configDigest).
+	details := unpacked[0]
+	if len(details) != 3 {
+		return fail(methodLatestConfigDetails, fmt.Errorf("unexpected output length %d", len(details)))
+	}
+	configDigest, ok := details[2].([32]byte)
+	if !ok {
+		return fail(methodLatestConfigDetails, fmt.Errorf("unexpected config digest type %T", details[2]))
+	}
+
+	if len(unpacked[1]) != 1 {
+		return fail(methodGetTransmitters, fmt.Errorf("unexpected output length %d", len(unpacked[1])))
+	}
+	transmitters, ok := unpacked[1][0].([]common.Address)
+	if !ok {
+		return fail(methodGetTransmitters, fmt.Errorf("unexpected transmitters type %T", unpacked[1][0]))
+	}
+
+	return configDigest, transmitters, nil
+}
@@ -0,0 +1,124 @@
+package blockchain
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+)
+
+// TLSOptions points at PEM files used to reach an RPC endpoint behind a private CA.
+// CertFile and KeyFile are only needed when the endpoint requires a client certificate.
+type TLSOptions struct {
+	CAFile   string
+	CertFile string
+	KeyFile  string
+}
+
+// Enabled reports whether any TLS option is set.
+func (o TLSOptions) Enabled() bool {
+	return o.CAFile != "" || o.CertFile != "" || o.KeyFile != ""
+}
+
+// LoadTLSConfig builds a TLS configuration from the options.
+// The CA bundle is trusted in addition to the system roots.
+func LoadTLSConfig(opts TLSOptions) (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if opts.CAFile != "" {
+		pem, err := os.ReadFile(opts.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", opts.CAFile)
+		}
+		config.RootCAs = pool
+	}
+
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// NewHTTPClient returns an HTTP client whose transport uses the TLS options.
+func NewHTTPClient(opts TLSOptions) (*http.Client, error) {
+	tlsConfig, err := LoadTLSConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{Transport: transport}, nil
+}
+
+// DialRPC connects to an RPC endpoint. HTTP and websocket connections use the
+// TLS options when any are set; otherwise the default transports are used.
+// HTTP requests wait for limiter when it is set; websocket calls are not limited.
+func DialRPC(ctx context.Context, rpcURL string, opts TLSOptions, limiter *RPCRateLimiter) (*ethclient.Client, error) {
+	if !opts.Enabled() && limiter == nil {
+		return ethclient.DialContext(ctx, rpcURL)
+	}
+
+	httpClient, err := NewHTTPClient(opts)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := httpClient.Transport.(*http.Transport).TLSClientConfig
+	if limiter != nil {
+		httpClient.Transport = limiter.Transport(httpClient.Transport)
+	}
+
+	rpcClient, err := rpc.DialOptions(ctx, rpcURL,
+		rpc.WithHTTPClient(httpClient),
+		rpc.WithWebsocketDialer(websocket.Dialer{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return ethclient.NewClient(rpcClient), nil
+}
+
+// DialRPCWithTransport connects to an HTTP RPC endpoint through transport,
+// e.g. one serving recorded responses in tests. Requests wait for limiter
+// when it is set.
+func DialRPCWithTransport(
+	ctx context.Context,
+	rpcURL string,
+	transport http.RoundTripper,
+	limiter *RPCRateLimiter,
+) (*ethclient.Client, error) {
+	if limiter != nil {
+		transport = limiter.Transport(transport)
+	}
+
+	rpcClient, err := rpc.DialOptions(ctx, rpcURL, rpc.WithHTTPClient(&http.Client{Transport: transport}))
+	if err != nil {
+		return nil, err
+	}
+
+	return ethclient.NewClient(rpcClient), nil
+}
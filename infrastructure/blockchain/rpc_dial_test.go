@@ -0,0 +1,88 @@
+package blockchain
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTLSRPCServer serves eth_chainId for chain 1 over TLS with a self-signed
+// certificate, and returns the certificate's PEM files.
+func newTLSRPCServer(t *testing.T) (server *httptest.Server, certFile, keyFile string) {
+	t.Helper()
+
+	server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+	// Handshakes from clients without the CA fail by design.
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	certFile = filepath.Join(dir, "ca.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+
+	cert := server.TLS.Certificates[0]
+	key, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(certFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile,
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key}), 0o600))
+
+	return server, certFile, keyFile
+}
+
+func TestNewHTTPClient_UsesConfiguredCA(t *testing.T) {
+	server, caFile, keyFile := newTLSRPCServer(t)
+
+	client, err := NewHTTPClient(TLSOptions{CAFile: caFile, CertFile: caFile, KeyFile: keyFile})
+	require.NoError(t, err)
+
+	transport, ok := client.Transport.(*http.Transport)
+	require.True(t, ok)
+	require.NotNil(t, transport.TLSClientConfig)
+	require.NotNil(t, transport.TLSClientConfig.RootCAs)
+	assert.Len(t, transport.TLSClientConfig.Certificates, 1)
+
+	// The private CA is trusted by the configured client only.
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	_, err = http.Get(server.URL) // #nosec G107 -- test server
+	require.Error(t, err)
+}
+
+func TestNewEthereumClientWithTLS(t *testing.T) {
+	server, caFile, _ := newTLSRPCServer(t)
+
+	client, err := NewEthereumClientWithTLS(server.URL, 1, TLSOptions{CAFile: caFile}, nil, nil, ChainIDCheck{})
+	require.NoError(t, err)
+	_ = client.Close()
+
+	_, err = NewEthereumClient(server.URL, 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "certificate")
+}
+
+func TestLoadTLSConfig_InvalidBundle(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(path, []byte("not a certificate"), 0o600))
+
+	_, err := LoadTLSConfig(TLSOptions{CAFile: path})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no certificates found")
+}
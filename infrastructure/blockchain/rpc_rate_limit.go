@@ -0,0 +1,50 @@
+package blockchain
+
+import (
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// RPCRateLimiter caps the rate of outbound RPC requests across every client
+// dialed with it, independent of how many goroutines issue calls.
+type RPCRateLimiter struct {
+	limiter *rate.Limiter
+}
+
+// NewRPCRateLimiter creates a limiter allowing rps requests per second.
+// A non-positive rps leaves requests unlimited.
+func NewRPCRateLimiter(rps float64) *RPCRateLimiter {
+	l := &RPCRateLimiter{limiter: rate.NewLimiter(rate.Inf, 1)}
+	l.SetRate(rps)
+	return l
+}
+
+// SetRate changes the cap for clients already dialed with the limiter.
+// A non-positive rps removes the cap.
+func (l *RPCRateLimiter) SetRate(rps float64) {
+	if rps <= 0 {
+		l.limiter.SetLimit(rate.Inf)
+		return
+	}
+	l.limiter.SetLimit(rate.Limit(rps))
+}
+
+// Transport wraps next so every request waits for the limiter first.
+func (l *RPCRateLimiter) Transport(next http.RoundTripper) http.RoundTripper {
+	return &rateLimitedTransport{limiter: l.limiter, next: next}
+}
+
+// rateLimitedTransport delays requests to stay within a rate limit.
+type rateLimitedTransport struct {
+	limiter *rate.Limiter
+	next    http.RoundTripper
+}
+
+// RoundTrip waits for a token, or for the request context to end, and sends the request.
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
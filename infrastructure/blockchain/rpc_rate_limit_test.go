@@ -0,0 +1,102 @@
+package blockchain
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCRateLimiter_CapsBurst(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []time.Time
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		mu.Lock()
+		requests = append(requests, time.Now())
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	const (
+		rps   = 40
+		calls = 21
+	)
+	client, err := DialRPC(context.Background(), server.URL, TLSOptions{}, NewRPCRateLimiter(rps))
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	// Fire every call at once, far above the cap.
+	var wg sync.WaitGroup
+	wg.Add(calls)
+	for i := 0; i < calls; i++ {
+		go func() {
+			defer wg.Done()
+			_, err := client.ChainID(context.Background())
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, requests, calls)
+
+	first, last := requests[0], requests[0]
+	for _, at := range requests {
+		if at.Before(first) {
+			first = at
+		}
+		if at.After(last) {
+			last = at
+		}
+	}
+
+	// With a burst of one, n requests need at least (n-1)/rps seconds.
+	minElapsed := time.Duration(float64(calls-1) / rps * float64(time.Second))
+	assert.GreaterOrEqual(t, last.Sub(first), minElapsed-10*time.Millisecond)
+}
+
+func TestRPCRateLimiter_Unlimited(t *testing.T) {
+	limiter := NewRPCRateLimiter(0)
+	transport := limiter.Transport(roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}))
+
+	start := time.Now()
+	for i := 0; i < 1000; i++ {
+		req := httptest.NewRequest(http.MethodPost, "http://rpc", nil)
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	assert.Less(t, time.Since(start), time.Second)
+
+	// Raising the cap later applies to the existing transport.
+	limiter.SetRate(1)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req := httptest.NewRequest(http.MethodPost, "http://rpc", nil).WithContext(ctx)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	_, err = transport.RoundTrip(req)
+	assert.Error(t, err)
+}
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,227 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cannedTransport answers JSON-RPC requests, single or batched, from recorded
+// results. eth_call results are keyed by the 4-byte method selector, and
+// eth_getBlockByNumber is answered by headers when it is set, counting requests
+// for full blocks. Methods without a result get a JSON-RPC error.
+type cannedTransport struct {
+	results map[string]json.RawMessage
+	calls   map[string]hexutil.Bytes
+	headers func(tag string) *types.Header
+
+	headerRequests int
+	fullBlocks     int
+}
+
+type cannedRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+func (c *cannedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []byte
+	if bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")) {
+		var batch []cannedRequest
+		if err := json.Unmarshal(body, &batch); err != nil {
+			return nil, err
+		}
+		answers := make([]json.RawMessage, len(batch))
+		for i, r := range batch {
+			answers[i] = c.answer(r)
+		}
+		response, err = json.Marshal(answers)
+	} else {
+		var r cannedRequest
+		if err := json.Unmarshal(body, &r); err != nil {
+			return nil, err
+		}
+		response = c.answer(r)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(response)),
+		Request:    req,
+	}, nil
+}
+
+func (c *cannedTransport) answer(r cannedRequest) json.RawMessage {
+	result, ok := c.results[r.Method]
+	if r.Method == "eth_getBlockByNumber" && c.headers != nil && len(r.Params) == 2 {
+		var tag string
+		var fullTx bool
+		if json.Unmarshal(r.Params[0], &tag) == nil && json.Unmarshal(r.Params[1], &fullTx) == nil {
+			c.headerRequests++
+			if fullTx {
+				c.fullBlocks++
+			}
+			result, _ = json.Marshal(c.headers(tag))
+			ok = true
+		}
+	}
+	if r.Method == "eth_call" && len(r.Params) > 0 {
+		var call struct {
+			Input hexutil.Bytes `json:"input"`
+			Data  hexutil.Bytes `json:"data"`
+		}
+		if err := json.Unmarshal(r.Params[0], &call); err == nil {
+			input := call.Input
+			if len(input) == 0 {
+				input = call.Data
+			}
+			if len(input) >= 4 {
+				var out hexutil.Bytes
+				out, ok = c.calls[hexutil.Encode(input[:4])]
+				result, _ = json.Marshal(out)
+			}
+		}
+	}
+
+	if !ok {
+		return json.RawMessage(fmt.Sprintf(
+			`{"jsonrpc":"2.0","id":%s,"error":{"code":-32601,"message":"no recorded response for %s"}}`,
+			r.ID, r.Method))
+	}
+	return json.RawMessage(fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":%s}`, r.ID, result))
+}
+
+func TestCannedTransport_GetTransmissions(t *testing.T) {
+	ctx := context.Background()
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0xa000000000000000000000000000000000000001")
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000002")
+
+	parsed, err := aggregatorABI()
+	require.NoError(t, err)
+
+	// The recorded config lists the transmitter second, at observer index 1.
+	configDetails, err := parsed.Methods[methodLatestConfigDetails].Outputs.Pack(uint32(1), uint32(90), [32]byte{1})
+	require.NoError(t, err)
+	transmitters, err := parsed.Methods[methodGetTransmitters].Outputs.Pack([]common.Address{other, transmitter})
+	require.NoError(t, err)
+
+	logs, err := json.Marshal([]types.Log{newTransmissionLog(t, contract, transmitter, 100)})
+	require.NoError(t, err)
+
+	transport := &cannedTransport{
+		results: map[string]json.RawMessage{
+			"eth_chainId":     json.RawMessage(`"0x1"`),
+			"eth_blockNumber": json.RawMessage(`"0x3e8"`),
+			"eth_getLogs":     logs,
+		},
+		calls: map[string]hexutil.Bytes{
+			hexutil.Encode(parsed.Methods[methodLatestConfigDetails].ID): configDetails,
+			hexutil.Encode(parsed.Methods[methodGetTransmitters].ID):     transmitters,
+		},
+	}
+
+	client, err := DialRPCWithTransport(ctx, "http://rpc.invalid", transport, nil)
+	require.NoError(t, err)
+	defer client.Close()
+
+	blockchainClient, err := NewEthereumClientFromRPC(client, 1, nil, ChainIDCheck{})
+	require.NoError(t, err)
+	head, err := blockchainClient.GetBlockNumber(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000-ChainConfirmations(1)), head)
+
+	service := NewOCR2AggregatorService(client, 1, nil)
+	for _, rawLogs := range []bool{false, true} {
+		transmissions, err := service.GetTransmissions(ctx, contract, 100, 100, interfaces.FetchOptions{
+			SkipTimestamps: true,
+			RawLogs:        rawLogs,
+		})
+		require.NoError(t, err)
+		require.Len(t, transmissions, 1)
+
+		tx := transmissions[0]
+		assert.Equal(t, contract, tx.ContractAddress)
+		assert.Equal(t, [32]byte{1}, tx.ConfigDigest)
+		assert.Equal(t, uint32(1), tx.Epoch)
+		assert.Equal(t, uint8(2), tx.Round)
+		assert.Equal(t, uint32(7), tx.AggregatorRoundID)
+		assert.Equal(t, big.NewInt(100), tx.LatestAnswer)
+		assert.Equal(t, big.NewInt(4200000000), tx.JuelsPerFeeCoin)
+		assert.Equal(t, uint32(1700000000), tx.LatestTimestamp)
+		assert.Equal(t, transmitter, tx.TransmitterAddress)
+		assert.Equal(t, uint8(1), tx.ObserverIndex)
+		assert.Equal(t, []uint8{0}, tx.Observers)
+		assert.Equal(t, uint64(100), tx.BlockNumber)
+	}
+
+	// A chain ID mismatch is reported without dialing anything else.
+	_, err = NewEthereumClientFromRPC(client, 137, nil, ChainIDCheck{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "chain ID mismatch")
+}
+
+func TestCannedTransport_GetBlockByTimestamp(t *testing.T) {
+	ctx := context.Background()
+
+	// Blocks 0-1000, 10s apart, so the 12s mainnet estimate misses and the
+	// binary search has to run.
+	const head, genesisTime, blockTime = 1000, 1600000000, 10
+	transport := &cannedTransport{
+		results: map[string]json.RawMessage{"eth_chainId": json.RawMessage(`"0x1"`)},
+		headers: func(tag string) *types.Header {
+			number := uint64(head)
+			if tag != "latest" {
+				number = hexutil.MustDecodeUint64(tag)
+			}
+			return &types.Header{
+				Number:     new(big.Int).SetUint64(number),
+				Time:       genesisTime + blockTime*number,
+				Difficulty: big.NewInt(0),
+			}
+		},
+	}
+
+	client, err := DialRPCWithTransport(ctx, "http://rpc.invalid", transport, nil)
+	require.NoError(t, err)
+	defer client.Close()
+
+	blockchainClient, err := NewEthereumClientFromRPC(client, 1, nil, ChainIDCheck{})
+	require.NoError(t, err)
+
+	number, err := blockchainClient.GetBlockByTimestamp(ctx, time.Unix(genesisTime+blockTime*600, 0))
+	require.NoError(t, err)
+	assert.InDelta(t, 600, number, 1)
+
+	block, err := blockchainClient.GetBlockByNumber(ctx, big.NewInt(600))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(600), block.Number)
+	assert.Equal(t, time.Unix(genesisTime+blockTime*600, 0), block.Timestamp)
+
+	// Every lookup asked for the header only, never for transaction bodies.
+	assert.Greater(t, transport.headerRequests, 2)
+	assert.Zero(t, transport.fullBlocks)
+}
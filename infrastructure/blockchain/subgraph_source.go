@@ -0,0 +1,390 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// subgraphPageSize is the most entities a subgraph returns per query.
+	subgraphPageSize = 1000
+
+	// defaultSubgraphTimeout bounds each subgraph query when no client is given.
+	defaultSubgraphTimeout = 30 * time.Second
+)
+
+// subgraphTransmissionsQuery pages through NewTransmission entities by id; the
+// filter carries the contract and the round, block, or time range.
+const subgraphTransmissionsQuery = `query Transmissions($where: NewTransmission_filter!, $first: Int!) {
+  newTransmissions(where: $where, first: $first, orderBy: id, orderDirection: asc) {
+    id
+    configDigest
+    epochAndRound
+    aggregatorRoundId
+    answer
+    juelsPerFeeCoin
+    observationsTimestamp
+    transmitter
+    observers
+    blockNumber
+    blockTimestamp
+    logIndex
+  }
+}`
+
+// subgraphTransmission is a NewTransmission entity as the subgraph returns it:
+// BigInts as decimal strings and Bytes as 0x-prefixed hex.
+type subgraphTransmission struct {
+	ID                    string  `json:"id"`
+	ConfigDigest          string  `json:"configDigest"`
+	EpochAndRound         string  `json:"epochAndRound"`
+	AggregatorRoundID     string  `json:"aggregatorRoundId"`
+	Answer                string  `json:"answer"`
+	JuelsPerFeeCoin       *string `json:"juelsPerFeeCoin"`
+	ObservationsTimestamp string  `json:"observationsTimestamp"`
+	Transmitter           string  `json:"transmitter"`
+	Observers             string  `json:"observers"`
+	BlockNumber           string  `json:"blockNumber"`
+	BlockTimestamp        string  `json:"blockTimestamp"`
+	LogIndex              string  `json:"logIndex"`
+}
+
+// subgraphRequest is a GraphQL request body.
+type subgraphRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// subgraphResponse is a GraphQL response body.
+type subgraphResponse struct {
+	Data struct {
+		NewTransmissions []subgraphTransmission `json:"newTransmissions"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// subgraphSource implements the TransmissionSource interface over a subgraph
+// indexing the aggregator's NewTransmission events.
+type subgraphSource struct {
+	endpoint string
+	client   *http.Client
+	pageSize int
+}
+
+// NewSubgraphTransmissionSource creates a transmission source that queries the
+// subgraph GraphQL endpoint. A nil client uses one with a 30s timeout.
+//
+// The subgraph reports neither the config's fault tolerance nor its transmitter
+// list, so MetQuorum is false and ObserverIndex is unknown on its transmissions.
+func NewSubgraphTransmissionSource(endpoint string, client *http.Client) interfaces.TransmissionSource {
+	if client == nil {
+		client = &http.Client{Timeout: defaultSubgraphTimeout}
+	}
+
+	return &subgraphSource{
+		endpoint: endpoint,
+		client:   client,
+		pageSize: subgraphPageSize,
+	}
+}
+
+// FetchByRounds fetches transmissions for a range of rounds. Unlike the RPC
+// source, the subgraph filters by round directly instead of scanning from genesis.
+func (s *subgraphSource) FetchByRounds(
+	ctx context.Context,
+	contractAddress common.Address,
+	startRound, endRound uint32,
+	opts interfaces.FetchOptions,
+) (*entities.TransmissionResult, error) {
+	if startRound > endRound {
+		return nil, errors.NewDomainError(errors.ErrInvalidInput,
+			fmt.Sprintf("invalid round range: start=%d, end=%d", startRound, endRound))
+	}
+
+	where := subgraphFilter(contractAddress, opts)
+	where["epochAndRound_gte"] = strconv.FormatUint(uint64(startRound), 10)
+	where["epochAndRound_lte"] = strconv.FormatUint(uint64(endRound), 10)
+
+	transmissions, err := s.fetch(ctx, contractAddress, where)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.TransmissionResult{
+		ContractAddress: contractAddress,
+		StartRound:      startRound,
+		EndRound:        endRound,
+		Transmissions:   transmissions,
+	}, nil
+}
+
+// FetchByBlocks fetches transmissions for a range of blocks.
+func (s *subgraphSource) FetchByBlocks(
+	ctx context.Context,
+	contractAddress common.Address,
+	startBlock, endBlock uint64,
+	opts interfaces.FetchOptions,
+) (*entities.TransmissionResult, error) {
+	if startBlock > endBlock {
+		return nil, errors.NewDomainError(errors.ErrInvalidInput,
+			fmt.Sprintf("invalid block range: start=%d, end=%d", startBlock, endBlock))
+	}
+
+	where := subgraphFilter(contractAddress, opts)
+	where["blockNumber_gte"] = strconv.FormatUint(startBlock, 10)
+	where["blockNumber_lte"] = strconv.FormatUint(endBlock, 10)
+
+	transmissions, err := s.fetch(ctx, contractAddress, where)
+	if err != nil {
+		return nil, err
+	}
+	return resultWithRoundRange(contractAddress, transmissions), nil
+}
+
+// FetchByTimeRange fetches transmissions for a time range. The subgraph filters
+// on block timestamps, so no block lookups are needed.
+func (s *subgraphSource) FetchByTimeRange(
+	ctx context.Context,
+	contractAddress common.Address,
+	startTime, endTime time.Time,
+	opts interfaces.FetchOptions,
+) (*entities.TransmissionResult, error) {
+	if startTime.After(endTime) {
+		return nil, errors.NewDomainError(errors.ErrInvalidInput,
+			fmt.Sprintf("invalid time range: start=%v, end=%v", startTime, endTime))
+	}
+
+	where := subgraphFilter(contractAddress, opts)
+	where["blockTimestamp_gte"] = strconv.FormatInt(startTime.Unix(), 10)
+	where["blockTimestamp_lte"] = strconv.FormatInt(endTime.Unix(), 10)
+
+	transmissions, err := s.fetch(ctx, contractAddress, where)
+	if err != nil {
+		return nil, err
+	}
+	return resultWithRoundRange(contractAddress, transmissions), nil
+}
+
+// resultWithRoundRange builds a result whose round range spans its transmissions.
+func resultWithRoundRange(contractAddress common.Address, transmissions []entities.Transmission) *entities.TransmissionResult {
+	var startRound, endRound uint32
+	if len(transmissions) > 0 {
+		startRound = transmissions[0].PackedRound()
+		endRound = transmissions[len(transmissions)-1].PackedRound()
+	}
+
+	return &entities.TransmissionResult{
+		ContractAddress: contractAddress,
+		StartRound:      startRound,
+		EndRound:        endRound,
+		Transmissions:   transmissions,
+	}
+}
+
+// subgraphFilter returns the where filter shared by every query: the contract
+// and the transmitter and config digest options.
+func subgraphFilter(contractAddress common.Address, opts interfaces.FetchOptions) map[string]interface{} {
+	where := map[string]interface{}{
+		"contract": strings.ToLower(contractAddress.Hex()),
+	}
+	if opts.Transmitter != (common.Address{}) {
+		where["transmitter"] = strings.ToLower(opts.Transmitter.Hex())
+	}
+	if opts.ConfigDigest != ([32]byte{}) {
+		where["configDigest"] = hexutil.Encode(opts.ConfigDigest[:])
+	}
+	return where
+}
+
+// fetch pages through every transmission matching the filter and returns them
+// in chain order.
+func (s *subgraphSource) fetch(
+	ctx context.Context,
+	contractAddress common.Address,
+	where map[string]interface{},
+) ([]entities.Transmission, error) {
+	type ordered struct {
+		tx       entities.Transmission
+		logIndex uint64
+	}
+
+	var (
+		rows   []ordered
+		lastID string
+	)
+	for {
+		// Page by id: skip is capped by the subgraph and slows with depth.
+		where["id_gt"] = lastID
+		page, err := s.query(ctx, where)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entity := range page {
+			tx, logIndex, err := transmissionFromSubgraph(contractAddress, entity)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, ordered{tx: tx, logIndex: logIndex})
+		}
+
+		if len(page) < s.pageSize {
+			break
+		}
+		lastID = page[len(page)-1].ID
+	}
+
+	// Ids are event ids, not chain positions, so restore chain order.
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].tx.BlockNumber != rows[j].tx.BlockNumber {
+			return rows[i].tx.BlockNumber < rows[j].tx.BlockNumber
+		}
+		return rows[i].logIndex < rows[j].logIndex
+	})
+
+	transmissions := make([]entities.Transmission, len(rows))
+	for i, row := range rows {
+		transmissions[i] = row.tx
+	}
+	return transmissions, nil
+}
+
+// query runs one page of the transmissions query.
+func (s *subgraphSource) query(ctx context.Context, where map[string]interface{}) ([]subgraphTransmission, error) {
+	body, err := json.Marshal(subgraphRequest{
+		Query: subgraphTransmissionsQuery,
+		Variables: map[string]interface{}{
+			"where": where,
+			"first": s.pageSize,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode subgraph query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subgraph request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.NewDomainError(errors.ErrConnection, fmt.Sprintf("subgraph query failed: %v", err))
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, errors.NewDomainError(errors.ErrConnection,
+			fmt.Sprintf("subgraph returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(snippet))))
+	}
+
+	var decoded subgraphResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode subgraph response: %w", err)
+	}
+	if len(decoded.Errors) > 0 {
+		return nil, fmt.Errorf("subgraph query failed: %s", decoded.Errors[0].Message)
+	}
+
+	return decoded.Data.NewTransmissions, nil
+}
+
+// transmissionFromSubgraph maps a NewTransmission entity the way
+// transmissionFromEvent maps the event, and also returns its log index.
+func transmissionFromSubgraph(
+	contractAddress common.Address,
+	entity subgraphTransmission,
+) (entities.Transmission, uint64, error) {
+	fail := func(field string, err error) (entities.Transmission, uint64, error) {
+		return entities.Transmission{}, 0, fmt.Errorf("invalid %s in subgraph transmission %s: %w", field, entity.ID, err)
+	}
+
+	configDigest, err := hexutil.Decode(entity.ConfigDigest)
+	if err != nil {
+		return fail("configDigest", err)
+	}
+	if len(configDigest) != 32 {
+		return fail("configDigest", fmt.Errorf("got %d bytes, want 32", len(configDigest)))
+	}
+	epochAndRound, err := strconv.ParseUint(entity.EpochAndRound, 10, 40)
+	if err != nil {
+		return fail("epochAndRound", err)
+	}
+	aggregatorRoundID, err := strconv.ParseUint(entity.AggregatorRoundID, 10, 32)
+	if err != nil {
+		return fail("aggregatorRoundId", err)
+	}
+	answer, ok := new(big.Int).SetString(entity.Answer, 10)
+	if !ok {
+		return fail("answer", fmt.Errorf("not a decimal integer: %q", entity.Answer))
+	}
+	var juelsPerFeeCoin *big.Int
+	if entity.JuelsPerFeeCoin != nil {
+		juelsPerFeeCoin, ok = new(big.Int).SetString(*entity.JuelsPerFeeCoin, 10)
+		if !ok {
+			return fail("juelsPerFeeCoin", fmt.Errorf("not a decimal integer: %q", *entity.JuelsPerFeeCoin))
+		}
+	}
+	observationsTimestamp, err := strconv.ParseUint(entity.ObservationsTimestamp, 10, 32)
+	if err != nil {
+		return fail("observationsTimestamp", err)
+	}
+	if !common.IsHexAddress(entity.Transmitter) {
+		return fail("transmitter", fmt.Errorf("not an address: %q", entity.Transmitter))
+	}
+	transmitter := common.HexToAddress(entity.Transmitter)
+	observers, err := hexutil.Decode(entity.Observers)
+	if err != nil {
+		return fail("observers", err)
+	}
+	blockNumber, err := strconv.ParseUint(entity.BlockNumber, 10, 64)
+	if err != nil {
+		return fail("blockNumber", err)
+	}
+	blockTimestamp, err := strconv.ParseInt(entity.BlockTimestamp, 10, 64)
+	if err != nil {
+		return fail("blockTimestamp", err)
+	}
+	logIndex, err := strconv.ParseUint(entity.LogIndex, 10, 64)
+	if err != nil {
+		return fail("logIndex", err)
+	}
+
+	tx := entities.Transmission{
+		ContractAddress:    contractAddress,
+		Epoch:              uint32(epochAndRound >> 8),  // #nosec G115 -- epochAndRound is at most 40 bits
+		Round:              uint8(epochAndRound & 0xFF), // #nosec G115 -- round is masked to 8 bits
+		AggregatorRoundID:  uint32(aggregatorRoundID),   // #nosec G115 -- parsed as 32 bits
+		LatestAnswer:       answer,
+		JuelsPerFeeCoin:    juelsPerFeeCoin,
+		LatestTimestamp:    uint32(observationsTimestamp),           // #nosec G115 -- parsed as 32 bits
+		TransmitterIndex:   uint8(transmitter.Big().Uint64() % 256), // #nosec G115 -- modulo ensures fit in uint8
+		TransmitterAddress: transmitter,
+		ObserverIndex:      entities.UnknownObserverIndex,
+		ObserverCount:      uint8(len(observers)), // #nosec G115 -- at most 31 observers
+		Observers:          observers,
+		BlockNumber:        blockNumber,
+		BlockTimestamp:     time.Unix(blockTimestamp, 0),
+	}
+	copy(tx.ConfigDigest[:], configDigest)
+	return tx, logIndex, nil
+}
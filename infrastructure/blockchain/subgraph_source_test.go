@@ -0,0 +1,184 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// graphQLServer serves canned NewTransmission entities, filtering by id_gt and
+// paging by first like a subgraph, and records each query's filter.
+type graphQLServer struct {
+	mu       sync.Mutex
+	entities []map[string]interface{}
+	filters  []map[string]interface{}
+}
+
+func (g *graphQLServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Query     string `json:"query"`
+		Variables struct {
+			Where map[string]interface{} `json:"where"`
+			First int                    `json:"first"`
+		} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || !strings.Contains(req.Query, "newTransmissions") {
+		http.Error(w, "bad query", http.StatusBadRequest)
+		return
+	}
+
+	g.mu.Lock()
+	g.filters = append(g.filters, req.Variables.Where)
+	g.mu.Unlock()
+
+	lastID, _ := req.Variables.Where["id_gt"].(string)
+	page := []map[string]interface{}{}
+	for _, entity := range g.entities {
+		if entity["id"].(string) > lastID && len(page) < req.Variables.First {
+			page = append(page, entity)
+		}
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"data": map[string]interface{}{"newTransmissions": page},
+	})
+}
+
+func TestSubgraphTransmissionSource_Mapping(t *testing.T) {
+	contract := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	transmitter := common.HexToAddress("0x2dbbd12bf0f6a23cf4455cc6be874b7a246288ce")
+	digest := "0x000100000000000000000000000000000000000000000000000000000000abcd"
+
+	entity := func(id, epochAndRound, block, logIndex string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":                    id,
+			"configDigest":          digest,
+			"epochAndRound":         epochAndRound,
+			"aggregatorRoundId":     "7",
+			"answer":                "-123456789012345678901234567890",
+			"juelsPerFeeCoin":       "4200000000",
+			"observationsTimestamp": "1700000000",
+			"transmitter":           strings.ToLower(transmitter.Hex()),
+			"observers":             "0x000102",
+			"blockNumber":           block,
+			"blockTimestamp":        "1700000012",
+			"logIndex":              logIndex,
+		}
+	}
+
+	// Ids sort differently from chain order, and the last entity predates
+	// juelsPerFeeCoin.
+	server := &graphQLServer{entities: []map[string]interface{}{
+		entity("0xaa-1", "1282", "500", "1"),
+		entity("0xbb-3", "1281", "500", "0"),
+		entity("0xcc-2", "1283", "499", "4"),
+	}}
+	delete(server.entities[2], "juelsPerFeeCoin")
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	source := NewSubgraphTransmissionSource(ts.URL, ts.Client())
+	source.(*subgraphSource).pageSize = 2
+
+	result, err := source.FetchByBlocks(context.Background(), contract, 400, 600,
+		interfaces.FetchOptions{Transmitter: transmitter})
+	require.NoError(t, err)
+
+	// Two pages, both carrying the range, contract, and transmitter filters.
+	require.Len(t, server.filters, 2)
+	assert.Equal(t, "", server.filters[0]["id_gt"])
+	assert.Equal(t, "0xbb-3", server.filters[1]["id_gt"])
+	for _, where := range server.filters {
+		assert.Equal(t, strings.ToLower(contract.Hex()), where["contract"])
+		assert.Equal(t, strings.ToLower(transmitter.Hex()), where["transmitter"])
+		assert.Equal(t, "400", where["blockNumber_gte"])
+		assert.Equal(t, "600", where["blockNumber_lte"])
+	}
+
+	// Transmissions come back in chain order.
+	require.Len(t, result.Transmissions, 3)
+	assert.Equal(t, []uint32{1283, 1281, 1282}, []uint32{
+		result.Transmissions[0].PackedRound(),
+		result.Transmissions[1].PackedRound(),
+		result.Transmissions[2].PackedRound(),
+	})
+	assert.Equal(t, uint32(1283), result.StartRound)
+	assert.Equal(t, uint32(1282), result.EndRound)
+	assert.Equal(t, contract, result.ContractAddress)
+
+	answer, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	var configDigest [32]byte
+	configDigest[1] = 0x01
+	configDigest[30], configDigest[31] = 0xab, 0xcd
+	assert.Equal(t, entities.Transmission{
+		ContractAddress:    contract,
+		ConfigDigest:       configDigest,
+		Epoch:              5,
+		Round:              1,
+		AggregatorRoundID:  7,
+		LatestAnswer:       answer,
+		JuelsPerFeeCoin:    big.NewInt(4200000000),
+		LatestTimestamp:    1700000000,
+		TransmitterIndex:   uint8(transmitter.Big().Uint64() % 256),
+		TransmitterAddress: transmitter,
+		ObserverIndex:      entities.UnknownObserverIndex,
+		ObserverCount:      3,
+		Observers:          []uint8{0, 1, 2},
+		BlockNumber:        500,
+		BlockTimestamp:     time.Unix(1700000012, 0),
+	}, result.Transmissions[1])
+	assert.Nil(t, result.Transmissions[0].JuelsPerFeeCoin)
+}
+
+func TestSubgraphTransmissionSource_Filters(t *testing.T) {
+	server := &graphQLServer{}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	ctx := context.Background()
+	contract := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	source := NewSubgraphTransmissionSource(ts.URL, ts.Client())
+
+	var digest [32]byte
+	digest[0] = 0x01
+	_, err := source.FetchByRounds(ctx, contract, 256, 511, interfaces.FetchOptions{ConfigDigest: digest})
+	require.NoError(t, err)
+	_, err = source.FetchByTimeRange(ctx, contract, time.Unix(1700000000, 0), time.Unix(1700003600, 0),
+		interfaces.FetchOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, server.filters, 2)
+	assert.Equal(t, "256", server.filters[0]["epochAndRound_gte"])
+	assert.Equal(t, "511", server.filters[0]["epochAndRound_lte"])
+	assert.Equal(t, "0x01"+strings.Repeat("00", 31), server.filters[0]["configDigest"])
+	assert.Equal(t, "1700000000", server.filters[1]["blockTimestamp_gte"])
+	assert.Equal(t, "1700003600", server.filters[1]["blockTimestamp_lte"])
+	assert.NotContains(t, server.filters[1], "configDigest")
+
+	_, err = source.FetchByRounds(ctx, contract, 2, 1, interfaces.FetchOptions{})
+	assert.Error(t, err)
+}
+
+func TestSubgraphTransmissionSource_GraphQLError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"errors":[{"message":"indexing_error"}]}`))
+	}))
+	defer ts.Close()
+
+	source := NewSubgraphTransmissionSource(ts.URL, ts.Client())
+	_, err := source.FetchByBlocks(context.Background(), common.Address{}, 1, 2, interfaces.FetchOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "indexing_error")
+}
@@ -19,30 +19,44 @@ const (
 	defaultBlockInterval = 10000
 )
 
+// DefaultMaxFetchChunks is the default limit on chunks a single fetch may be split into.
+const DefaultMaxFetchChunks = 50000
+
 // transmissionFetcher implements the TransmissionFetcher interface.
 type transmissionFetcher struct {
 	blockchainClient  interfaces.BlockchainClient
 	aggregatorService interfaces.OCR2AggregatorService
 	concurrency       int
+	maxChunks         int
 }
 
 // NewTransmissionFetcher creates a new transmission fetcher.
+// Fetches needing more than maxChunks chunks are rejected; zero uses DefaultMaxFetchChunks.
 func NewTransmissionFetcher(
 	blockchainClient interfaces.BlockchainClient,
 	aggregatorService interfaces.OCR2AggregatorService,
+	maxChunks int,
 ) interfaces.TransmissionFetcher {
+	if maxChunks <= 0 {
+		maxChunks = DefaultMaxFetchChunks
+	}
+
 	return &transmissionFetcher{
 		blockchainClient:  blockchainClient,
 		aggregatorService: aggregatorService,
 		concurrency:       maxConcurrency,
+		maxChunks:         maxChunks,
 	}
 }
 
-// FetchByRounds fetches transmissions for a range of rounds.
+// FetchByRounds fetches transmissions for a range of rounds. The rounds are
+// looked for in the last opts.MaxBlockRange blocks, or from genesis without a
+// limit; a start round older than the scanned blocks is an error.
 func (f *transmissionFetcher) FetchByRounds(
 	ctx context.Context,
 	contractAddress common.Address,
 	startRound, endRound uint32,
+	opts interfaces.FetchOptions,
 ) (*entities.TransmissionResult, error) {
 	if startRound > endRound {
 		return nil, errors.NewDomainError(errors.ErrInvalidInput,
@@ -55,13 +69,22 @@ func (f *transmissionFetcher) FetchByRounds(
 		return nil, err
 	}
 
-	// Fetch all transmissions from genesis to current block.
-	// This is a simplified approach - in production, we'd optimize this.
-	transmissions, err := f.fetchTransmissionsInRange(ctx, contractAddress, 0, currentBlock)
+	// Fetch all transmissions in the scanned blocks.
+	var startBlock uint64
+	if opts.MaxBlockRange > 0 && currentBlock >= opts.MaxBlockRange {
+		startBlock = currentBlock - opts.MaxBlockRange + 1
+	}
+	transmissions, err := f.fetchTransmissionsInRange(ctx, contractAddress, startBlock, currentBlock, opts)
 	if err != nil {
 		return nil, err
 	}
 
+	if startBlock > 0 && !reachesRound(transmissions, startRound, opts) {
+		return nil, errors.NewDomainError(errors.ErrInvalidInput,
+			fmt.Sprintf("round %d is not within the last %d blocks (from block %d); "+
+				"raise the max block range or fetch by blocks", startRound, opts.MaxBlockRange, startBlock))
+	}
+
 	// Filter by round range.
 	var filteredTransmissions []entities.Transmission
 	for _, tx := range transmissions {
@@ -79,18 +102,36 @@ func (f *transmissionFetcher) FetchByRounds(
 	}, nil
 }
 
+// reachesRound reports whether transmissions go back to startRound, that is
+// whether the earliest round among them is not after it. Filtered
+// transmissions can skip rounds other transmitters or configs sent, so with a
+// filter the rounds are assumed to be within reach.
+func reachesRound(transmissions []entities.Transmission, startRound uint32, opts interfaces.FetchOptions) bool {
+	if opts.Transmitter != (common.Address{}) || opts.ConfigDigest != ([32]byte{}) {
+		return true
+	}
+
+	for _, tx := range transmissions {
+		if tx.Epoch<<8|uint32(tx.Round) <= startRound {
+			return true
+		}
+	}
+	return false
+}
+
 // FetchByBlocks fetches transmissions for a range of blocks.
 func (f *transmissionFetcher) FetchByBlocks(
 	ctx context.Context,
 	contractAddress common.Address,
 	startBlock, endBlock uint64,
+	opts interfaces.FetchOptions,
 ) (*entities.TransmissionResult, error) {
 	if startBlock > endBlock {
 		return nil, errors.NewDomainError(errors.ErrInvalidInput,
 			fmt.Sprintf("invalid block range: start=%d, end=%d", startBlock, endBlock))
 	}
 
-	transmissions, err := f.fetchTransmissionsInRange(ctx, contractAddress, startBlock, endBlock)
+	transmissions, err := f.fetchTransmissionsInRange(ctx, contractAddress, startBlock, endBlock, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -115,6 +156,7 @@ func (f *transmissionFetcher) FetchByTimeRange(
 	ctx context.Context,
 	contractAddress common.Address,
 	startTime, endTime time.Time,
+	opts interfaces.FetchOptions,
 ) (*entities.TransmissionResult, error) {
 	if startTime.After(endTime) {
 		return nil, errors.NewDomainError(errors.ErrInvalidInput,
@@ -132,7 +174,7 @@ func (f *transmissionFetcher) FetchByTimeRange(
 		return nil, err
 	}
 
-	return f.FetchByBlocks(ctx, contractAddress, startBlock, endBlock)
+	return f.FetchByBlocks(ctx, contractAddress, startBlock, endBlock, opts)
 }
 
 // fetchTransmissionsInRange fetches transmissions in parallel for a block range.
@@ -140,7 +182,20 @@ func (f *transmissionFetcher) fetchTransmissionsInRange(
 	ctx context.Context,
 	contractAddress common.Address,
 	startBlock, endBlock uint64,
+	opts interfaces.FetchOptions,
 ) ([]entities.Transmission, error) {
+	// Refuse oversized ranges before any chunk or goroutine is allocated.
+	chunkSize := f.chunkSize(startBlock, endBlock)
+	chunkCount := (endBlock-startBlock)/chunkSize + 1
+	// #nosec G115 -- maxChunks is always positive
+	if chunkCount > uint64(f.maxChunks) {
+		return nil, errors.NewDomainError(errors.ErrInvalidInput, fmt.Sprintf(
+			"block range %d-%d needs %d chunks of %d blocks, more than the limit of %d; "+
+				"fetch a smaller block or round range, or split it into several fetches "+
+				"(max_fetch_chunks raises the limit)",
+			startBlock, endBlock, chunkCount, chunkSize, f.maxChunks))
+	}
+
 	// Split the range into chunks.
 	chunks := f.splitBlockRange(startBlock, endBlock)
 
@@ -172,7 +227,7 @@ func (f *transmissionFetcher) fetchTransmissionsInRange(
 			}
 
 			// Fetch transmissions for this chunk.
-			transmissions, err := f.aggregatorService.GetTransmissions(ctx, contractAddress, start, end)
+			transmissions, err := f.aggregatorService.GetTransmissions(ctx, contractAddress, start, end, opts)
 			if err != nil {
 				errorsChan <- err
 				return
@@ -203,10 +258,8 @@ func (f *transmissionFetcher) fetchTransmissionsInRange(
 	return allTransmissions, nil
 }
 
-// splitBlockRange splits a block range into smaller chunks.
-func (f *transmissionFetcher) splitBlockRange(startBlock, endBlock uint64) []entities.BlockRange {
-	var chunks []entities.BlockRange
-
+// chunkSize returns the number of blocks per chunk for a block range.
+func (f *transmissionFetcher) chunkSize(startBlock, endBlock uint64) uint64 {
 	totalBlocks := endBlock - startBlock + 1
 	chunkSize := uint64(defaultBlockInterval)
 
@@ -220,6 +273,15 @@ func (f *transmissionFetcher) splitBlockRange(startBlock, endBlock uint64) []ent
 		}
 	}
 
+	return chunkSize
+}
+
+// splitBlockRange splits a block range into smaller chunks.
+func (f *transmissionFetcher) splitBlockRange(startBlock, endBlock uint64) []entities.BlockRange {
+	var chunks []entities.BlockRange
+
+	chunkSize := f.chunkSize(startBlock, endBlock)
+
 	for start := startBlock; start <= endBlock; start += chunkSize {
 		end := start + chunkSize - 1
 		if end > endBlock {
@@ -0,0 +1,100 @@
+package blockchain
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransmissionFetcher_MaxChunks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	mockClient := mocks.NewMockBlockchainClient(ctrl)
+	mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+
+	// Forty full chunks of defaultBlockInterval blocks are allowed.
+	fetcher := NewTransmissionFetcher(mockClient, mockAggregator, 40)
+
+	t.Run("range within the cap is fetched", func(t *testing.T) {
+		mockAggregator.EXPECT().
+			GetTransmissions(ctx, contract, gomock.Any(), gomock.Any(), interfaces.FetchOptions{}).
+			Return([]entities.Transmission{}, nil).
+			Times(40)
+
+		_, err := fetcher.FetchByBlocks(ctx, contract, 0, 40*defaultBlockInterval-1, interfaces.FetchOptions{})
+		require.NoError(t, err)
+	})
+
+	t.Run("range exceeding the cap is rejected before fetching", func(t *testing.T) {
+		// No GetTransmissions call is expected.
+		_, err := fetcher.FetchByBlocks(ctx, contract, 0, 40*defaultBlockInterval, interfaces.FetchOptions{})
+		require.Error(t, err)
+		assert.True(t, stderrors.Is(err, errors.ErrInvalidInput))
+		assert.Contains(t, err.Error(), "needs 41 chunks")
+		assert.Contains(t, err.Error(), "smaller block or round range")
+	})
+
+	t.Run("huge range from genesis is rejected", func(t *testing.T) {
+		mockClient.EXPECT().GetBlockNumber(ctx).Return(uint64(1)<<40, nil)
+
+		_, err := fetcher.FetchByRounds(ctx, contract, 1, 10, interfaces.FetchOptions{})
+		require.Error(t, err)
+		assert.True(t, stderrors.Is(err, errors.ErrInvalidInput))
+	})
+}
+
+func TestTransmissionFetcher_MaxBlockRange(t *testing.T) {
+	ctx := context.Background()
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	opts := interfaces.FetchOptions{MaxBlockRange: defaultBlockInterval}
+
+	// The window is the last defaultBlockInterval blocks, fetched in chunks;
+	// its first chunk holds rounds 1/5 through 1/7.
+	head := uint64(1_000_000)
+	windowStart := head - defaultBlockInterval + 1
+	newFetcher := func(t *testing.T) interfaces.TransmissionFetcher {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockBlockchainClient(ctrl)
+		mockClient.EXPECT().GetBlockNumber(ctx).Return(head, nil)
+		mockAggregator := mocks.NewMockOCR2AggregatorService(ctrl)
+		mockAggregator.EXPECT().
+			GetTransmissions(ctx, contract, gomock.Any(), gomock.Any(), opts).
+			DoAndReturn(func(_ context.Context, _ common.Address, start, end uint64, _ interfaces.FetchOptions) (
+				[]entities.Transmission, error,
+			) {
+				assert.GreaterOrEqual(t, start, windowStart)
+				assert.LessOrEqual(t, end, head)
+				if start != windowStart {
+					return nil, nil
+				}
+				return []entities.Transmission{{Epoch: 1, Round: 5}, {Epoch: 1, Round: 6}, {Epoch: 1, Round: 7}}, nil
+			}).
+			MinTimes(1)
+		return NewTransmissionFetcher(mockClient, mockAggregator, 0)
+	}
+
+	t.Run("rounds within the window", func(t *testing.T) {
+		result, err := newFetcher(t).FetchByRounds(ctx, contract, 1<<8|6, 1<<8|7, opts)
+		require.NoError(t, err)
+		assert.Len(t, result.Transmissions, 2)
+	})
+
+	t.Run("rounds older than the window", func(t *testing.T) {
+		_, err := newFetcher(t).FetchByRounds(ctx, contract, 1<<8|2, 1<<8|7, opts)
+		require.Error(t, err)
+		assert.True(t, stderrors.Is(err, errors.ErrInvalidInput))
+		assert.Contains(t, err.Error(), "not within the last 10000 blocks (from block 990001)")
+	})
+}
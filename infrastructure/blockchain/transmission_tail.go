@@ -0,0 +1,148 @@
+package blockchain
+
+import (
+	"context"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/errors"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	ocr2aggregator "github.com/smartcontractkit/libocr/gethwrappers2/accesscontrolledocr2aggregator"
+)
+
+// tailReorgDepth is how many blocks behind the newest delivered log are kept for reorg tracking.
+const tailReorgDepth = 128
+
+// logKey identifies a log by its position in the chain.
+type logKey struct {
+	blockNumber uint64
+	logIndex    uint
+}
+
+// seenLog is a delivered transmission and the block it was mined in.
+type seenLog struct {
+	blockHash    common.Hash
+	transmission entities.Transmission
+}
+
+// TransmissionTail streams NewTransmission events of a contract over a subscription.
+// Logs later reorged out are reported through the handler's OnRemoved.
+type TransmissionTail struct {
+	client  bind.ContractFilterer
+	chainID int64
+
+	seen        map[logKey]seenLog
+	latestBlock uint64
+}
+
+// NewTransmissionTail creates a new transmission tail. The client must support
+// subscriptions, e.g. a WebSocket endpoint.
+func NewTransmissionTail(client *ethclient.Client, chainID int64) *TransmissionTail {
+	return newTransmissionTail(client, chainID)
+}
+
+// newTransmissionTail creates a transmission tail over any log filterer.
+func newTransmissionTail(client bind.ContractFilterer, chainID int64) *TransmissionTail {
+	return &TransmissionTail{
+		client:  client,
+		chainID: chainID,
+		seen:    make(map[logKey]seenLog),
+	}
+}
+
+// Run delivers transmissions to the handler until the context is canceled
+// or the subscription fails.
+func (t *TransmissionTail) Run(
+	ctx context.Context,
+	contractAddress common.Address,
+	handler interfaces.TransmissionTailHandler,
+) error {
+	filterer, err := ocr2aggregator.NewAccessControlledOCR2AggregatorFilterer(contractAddress, t.client)
+	if err != nil {
+		return &errors.BlockchainError{
+			Operation: "TransmissionTail.NewFilterer",
+			ChainID:   t.chainID,
+			Err:       err,
+		}
+	}
+
+	events := make(chan *ocr2aggregator.AccessControlledOCR2AggregatorNewTransmission)
+	sub, err := filterer.WatchNewTransmission(&bind.WatchOpts{Context: ctx}, events, nil)
+	if err != nil {
+		return &errors.BlockchainError{
+			Operation: "TransmissionTail.WatchNewTransmission",
+			ChainID:   t.chainID,
+			Err:       err,
+		}
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-sub.Err():
+			return &errors.BlockchainError{
+				Operation:   "TransmissionTail.Subscription",
+				ChainID:     t.chainID,
+				BlockNumber: t.latestBlock,
+				Err:         err,
+			}
+		case event := <-events:
+			t.handleEvent(contractAddress, event, handler)
+		}
+	}
+}
+
+// handleEvent delivers a single event, reconciling duplicates and reorgs.
+func (t *TransmissionTail) handleEvent(
+	contractAddress common.Address,
+	event *ocr2aggregator.AccessControlledOCR2AggregatorNewTransmission,
+	handler interfaces.TransmissionTailHandler,
+) {
+	key := logKey{blockNumber: event.Raw.BlockNumber, logIndex: event.Raw.Index}
+	previous, seen := t.seen[key]
+
+	if event.Raw.Removed {
+		// Only logs that were delivered need reconciling.
+		if seen && previous.blockHash == event.Raw.BlockHash {
+			delete(t.seen, key)
+			handler.OnRemoved(previous.transmission)
+		}
+		return
+	}
+
+	if seen {
+		if previous.blockHash == event.Raw.BlockHash {
+			// Re-delivery of a log already reported.
+			return
+		}
+		// A different block took this position without a removal being seen first.
+		handler.OnRemoved(previous.transmission)
+	}
+
+	transmission := transmissionFromEvent(contractAddress, event)
+	t.seen[key] = seenLog{blockHash: event.Raw.BlockHash, transmission: transmission}
+	handler.OnTransmission(transmission)
+
+	if key.blockNumber > t.latestBlock {
+		t.latestBlock = key.blockNumber
+		t.prune()
+	}
+}
+
+// prune forgets logs too deep to be reorged out.
+func (t *TransmissionTail) prune() {
+	if t.latestBlock < tailReorgDepth {
+		return
+	}
+
+	floor := t.latestBlock - tailReorgDepth
+	for key := range t.seen {
+		if key.blockNumber < floor {
+			delete(t.seen, key)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// streamingBackend delivers canned logs over a log subscription.
+type streamingBackend struct {
+	fakeAggregatorBackend
+}
+
+func (b *streamingBackend) SubscribeFilterLogs(
+	ctx context.Context,
+	_ ethereum.FilterQuery,
+	ch chan<- types.Log,
+) (ethereum.Subscription, error) {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		for _, log := range b.logs {
+			select {
+			case ch <- log:
+			case <-quit:
+				return nil
+			case <-ctx.Done():
+				return nil
+			}
+		}
+		<-quit
+		return nil
+	}), nil
+}
+
+// tailCall records a handler callback.
+type tailCall struct {
+	removed      bool
+	transmission entities.Transmission
+}
+
+// recordingTailHandler forwards callbacks to a channel.
+type recordingTailHandler struct {
+	calls chan tailCall
+}
+
+func (h *recordingTailHandler) OnTransmission(transmission entities.Transmission) {
+	h.calls <- tailCall{transmission: transmission}
+}
+
+func (h *recordingTailHandler) OnRemoved(transmission entities.Transmission) {
+	h.calls <- tailCall{removed: true, transmission: transmission}
+}
+
+func TestTransmissionTail_ReorgRemoval(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000000")
+
+	at := func(blockNumber uint64, blockHash byte, removed bool) types.Log {
+		log := newTransmissionLog(t, contract, transmitter, blockNumber)
+		log.BlockHash = common.Hash{blockHash}
+		log.Index = 3
+		log.Removed = removed
+		return log
+	}
+
+	backend := &streamingBackend{fakeAggregatorBackend{logs: []types.Log{
+		at(10, 0xa, false), // mined
+		at(10, 0xa, false), // duplicate delivery
+		at(10, 0xa, true),  // reorged out
+		at(10, 0xb, false), // re-mined in the new block
+		at(11, 0xc, true),  // removal of a log never delivered
+		at(12, 0xd, false),
+	}}}
+	tail := newTransmissionTail(backend, 1)
+	handler := &recordingTailHandler{calls: make(chan tailCall, 16)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- tail.Run(ctx, contract, handler) }()
+
+	var calls []tailCall
+	for len(calls) < 4 {
+		select {
+		case call := <-handler.calls:
+			calls = append(calls, call)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out after %d callbacks", len(calls))
+		}
+	}
+	cancel()
+	require.NoError(t, <-done)
+
+	require.Len(t, calls, 4)
+	assert.False(t, calls[0].removed)
+	assert.Equal(t, uint64(10), calls[0].transmission.BlockNumber)
+
+	assert.True(t, calls[1].removed, "the reorged log must be reported as removed")
+	assert.Equal(t, calls[0].transmission, calls[1].transmission)
+
+	assert.False(t, calls[2].removed)
+	assert.Equal(t, uint64(10), calls[2].transmission.BlockNumber)
+
+	assert.False(t, calls[3].removed)
+	assert.Equal(t, uint64(12), calls[3].transmission.BlockNumber)
+	assert.Empty(t, handler.calls)
+}
@@ -3,9 +3,14 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/viper"
 )
 
@@ -15,12 +20,44 @@ type Config struct {
 	ChainID  int64  `mapstructure:"chain_id"`
 	RPCAddr  string `mapstructure:"rpc_addr"`
 
+	// RPCTLS configures TLS for RPC endpoints behind a private CA.
+	RPCTLS TLSConfig `mapstructure:"rpc_tls"`
+
+	// RPCRPS caps outbound RPC requests per second across all clients; 0 is unlimited.
+	RPCRPS float64 `mapstructure:"rpc_rps"`
+
+	// SkipChainIDCheck logs an RPC chain ID that differs from ChainID instead of failing.
+	SkipChainIDCheck bool `mapstructure:"skip_chain_id_check"`
+
+	// DefaultTransmitter is watched when the transmitter argument is omitted.
+	DefaultTransmitter string `mapstructure:"default_transmitter"`
+
+	// Source is where transmissions are fetched from: "rpc" reads the node's
+	// event logs, "subgraph" queries the GraphQL endpoint at SubgraphURL.
+	Source      string `mapstructure:"source"`
+	SubgraphURL string `mapstructure:"subgraph_url"`
+
 	Database DatabaseConfig `mapstructure:"database"`
+	SMTP     SMTPConfig     `mapstructure:"smtp"`
+	Slack    SlackConfig    `mapstructure:"slack"`
+
+	PagerDuty PagerDutyConfig `mapstructure:"pagerduty"`
+	Routing   RoutingConfig   `mapstructure:"routing"`
 
 	// Timeouts and limits.
 	BlockchainTimeout    time.Duration `mapstructure:"blockchain_timeout"`
 	MaxConcurrency       int           `mapstructure:"max_concurrency"`
 	DefaultBlockInterval int           `mapstructure:"default_block_interval"`
+	MaxRoundRange        int           `mapstructure:"max_round_range"`
+	MaxFetchChunks       int           `mapstructure:"max_fetch_chunks"`
+	MaxBlockRange        uint64        `mapstructure:"max_block_range"`
+
+	// Output formatting.
+	HealthScorePrecision int `mapstructure:"health_score_precision"`
+
+	Anomaly AnomalyConfig `mapstructure:"anomaly"`
+
+	Metrics MetricsConfig `mapstructure:"metrics"`
 }
 
 // DatabaseConfig represents database configuration.
@@ -32,14 +69,146 @@ type DatabaseConfig struct {
 	DBName   string `mapstructure:"dbName"`
 	SSLMode  string `mapstructure:"sslMode"`
 
+	// SSL files for servers behind a private CA, passed to the driver as
+	// sslrootcert, sslcert, and sslkey.
+	SSLRootCert string `mapstructure:"ssl_root_cert"`
+	SSLCert     string `mapstructure:"ssl_cert"`
+	SSLKey      string `mapstructure:"ssl_key"`
+
+	// ReadReplicaDSN optionally points read queries at a replica.
+	// Writes always go to the primary.
+	ReadReplicaDSN string `mapstructure:"read_replica_dsn"`
+
+	// BulkLoad is how fetched transmissions are saved: "insert" batches
+	// INSERTs, "copy" streams them with Postgres COPY.
+	BulkLoad string `mapstructure:"bulk_load"`
+
 	// Connection pool settings.
 	MaxIdleConns    int           `mapstructure:"max_idle_conns"`
 	MaxOpenConns    int           `mapstructure:"max_open_conns"`
 	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
 }
 
+// TLSConfig points at PEM files for connections to servers behind a private CA.
+// CertFile and KeyFile are only needed when the server requires a client certificate.
+type TLSConfig struct {
+	CAFile   string `mapstructure:"ca_file"`
+	CertFile string `mapstructure:"cert_file"`
+	KeyFile  string `mapstructure:"key_file"`
+}
+
+// SMTPConfig represents SMTP configuration for email notifications.
+type SMTPConfig struct {
+	Host     string   `mapstructure:"host"`
+	Port     int      `mapstructure:"port"`
+	Username string   `mapstructure:"username"`
+	Password string   `mapstructure:"password"`
+	From     string   `mapstructure:"from"`
+	To       []string `mapstructure:"to"`
+	Security string   `mapstructure:"security"`
+
+	// MessageTemplate is a Go template file for the opening text of the email body.
+	MessageTemplate string `mapstructure:"message_template"`
+}
+
+// SlackConfig represents Slack webhook configuration for notifications.
+type SlackConfig struct {
+	WebhookURL string `mapstructure:"webhook_url"`
+	Username   string `mapstructure:"username"`
+	IconEmoji  string `mapstructure:"icon_emoji"`
+
+	// MaxTextLength caps each attachment; Truncation is "truncate", "split", or "paginate".
+	MaxTextLength int    `mapstructure:"max_text_length"`
+	Truncation    string `mapstructure:"truncation"`
+
+	// MessageTemplate is a Go template file for the message text above the attachments.
+	MessageTemplate string `mapstructure:"message_template"`
+}
+
+// PagerDutyConfig represents PagerDuty Events API configuration for notifications.
+type PagerDutyConfig struct {
+	RoutingKey string `mapstructure:"routing_key"`
+	EventsURL  string `mapstructure:"events_url"`
+
+	// DedupTTL is how long a repeated event for an open incident is suppressed; 0 disables it.
+	DedupTTL time.Duration `mapstructure:"dedup_ttl"`
+	// StateFile keeps the suppressed dedup keys across restarts.
+	StateFile string `mapstructure:"state_file"`
+}
+
+// RoutingConfig maps alert severities to the names of the notifiers that receive them.
+// When every list is empty, alerts go to all configured notifiers.
+type RoutingConfig struct {
+	Critical []string `mapstructure:"critical"`
+	Warning  []string `mapstructure:"warning"`
+	Info     []string `mapstructure:"info"`
+}
+
+// IsEmpty reports whether no severity has a route.
+func (c RoutingConfig) IsEmpty() bool {
+	return len(c.Critical) == 0 && len(c.Warning) == 0 && len(c.Info) == 0
+}
+
+// AnomalyConfig represents anomaly detection configuration.
+type AnomalyConfig struct {
+	// DeviationThresholdPercent is the feed's deviation threshold; 0 disables the check.
+	DeviationThresholdPercent float64       `mapstructure:"deviation_threshold_percent"`
+	DeviationMaxDelay         time.Duration `mapstructure:"deviation_max_delay"`
+	AnswerDecimals            int           `mapstructure:"answer_decimals"`
+
+	// AnswerBounds maps a contract address to the sane range of its scaled answers.
+	AnswerBounds map[string]AnswerBoundConfig `mapstructure:"answer_bounds"`
+
+	// AnswerDisplay maps a contract address to how its answers are written in reports.
+	AnswerDisplay map[string]AnswerDisplayConfig `mapstructure:"answer_display"`
+}
+
+// AnswerBoundConfig is an inclusive range of scaled answers; an unset side is unbounded.
+type AnswerBoundConfig struct {
+	Min *float64 `mapstructure:"min"`
+	Max *float64 `mapstructure:"max"`
+}
+
+// AnswerDisplayConfig formats a feed's answers in reports, e.g. prefix "$",
+// decimals 2, and thousands separator "," for a USD feed. Unset decimals keep
+// every answer decimal.
+type AnswerDisplayConfig struct {
+	Decimals           *int   `mapstructure:"decimals"`
+	Prefix             string `mapstructure:"prefix"`
+	Suffix             string `mapstructure:"suffix"`
+	ThousandsSeparator string `mapstructure:"thousands_separator"`
+}
+
+// MetricsConfig represents Prometheus metrics configuration.
+type MetricsConfig struct {
+	// NotifierDurationBuckets are the upper bounds in seconds of the
+	// notifier_duration_seconds histogram; empty uses the default buckets.
+	NotifierDurationBuckets []float64 `mapstructure:"notifier_duration_buckets"`
+}
+
 // LoadConfig loads configuration from file and environment.
 func LoadConfig(configPath string) (*Config, error) {
+	return LoadConfigWithProfile(configPath, "")
+}
+
+// LoadConfigWithProfile loads configuration like LoadConfig, with the named
+// profile merged over the base file. A profile is a [profiles.<name>] section
+// of the base file and/or a config.<name>.toml file beside it; the file wins
+// over the section. Environment variables override both.
+func LoadConfigWithProfile(configPath, profile string) (*Config, error) {
+	return LoadConfigWithJSON(configPath, profile, "")
+}
+
+// LoadConfigWithJSON loads configuration like LoadConfigWithProfile, with an
+// inline JSON config merged over the file, so containers can be configured
+// without mounting one. Its keys are those of the file. An empty configJSON
+// falls back to OCR_CONFIG_JSON. The profile and other environment variables
+// still apply over it.
+func LoadConfigWithJSON(configPath, profile, configJSON string) (*Config, error) {
+	if configJSON == "" {
+		configJSON = os.Getenv(configJSONEnv)
+	}
+
 	v := viper.New()
 
 	// Set defaults.
@@ -47,10 +216,26 @@ func LoadConfig(configPath string) (*Config, error) {
 	v.SetDefault("blockchain_timeout", "30s")
 	v.SetDefault("max_concurrency", 30)
 	v.SetDefault("default_block_interval", 10000)
+	v.SetDefault("max_round_range", 10000)
+	v.SetDefault("max_fetch_chunks", 50000)
+	v.SetDefault("max_block_range", 500000)
+	v.SetDefault("source", "rpc")
+	v.SetDefault("health_score_precision", 1)
 	v.SetDefault("database.sslMode", "disable")
 	v.SetDefault("database.max_idle_conns", 10)
 	v.SetDefault("database.max_open_conns", 100)
 	v.SetDefault("database.conn_max_lifetime", "1h")
+	v.SetDefault("database.bulk_load", "insert")
+	v.SetDefault("smtp.port", 587)
+	v.SetDefault("smtp.security", "starttls")
+	v.SetDefault("slack.username", "OCR Monitor")
+	v.SetDefault("slack.icon_emoji", ":robot_face:")
+	v.SetDefault("slack.max_text_length", 3000)
+	v.SetDefault("slack.truncation", "truncate")
+	v.SetDefault("pagerduty.dedup_ttl", "1h")
+	v.SetDefault("anomaly.deviation_threshold_percent", 0.5)
+	v.SetDefault("anomaly.deviation_max_delay", "2m")
+	v.SetDefault("anomaly.answer_decimals", 8)
 
 	// Set config file.
 	if configPath != "" {
@@ -67,6 +252,11 @@ func LoadConfig(configPath string) (*Config, error) {
 	v.SetEnvPrefix("OCR")
 	v.AutomaticEnv()
 
+	// Bind keys explicitly so a file-less, env-only configuration unmarshals.
+	if err := bindEnv(v); err != nil {
+		return nil, err
+	}
+
 	// Read config file.
 	if err := v.ReadInConfig(); err != nil {
 		// It's okay if config file doesn't exist.
@@ -75,6 +265,18 @@ func LoadConfig(configPath string) (*Config, error) {
 		}
 	}
 
+	if configJSON != "" {
+		if err := mergeJSON(v, configJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	if profile != "" {
+		if err := mergeProfile(v, profile); err != nil {
+			return nil, err
+		}
+	}
+
 	var config Config
 	if err := v.Unmarshal(&config); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
@@ -88,6 +290,143 @@ func LoadConfig(configPath string) (*Config, error) {
 	return &config, nil
 }
 
+// configJSONEnv holds an inline JSON config when --config-json is not given.
+const configJSONEnv = "OCR_CONFIG_JSON"
+
+// mergeJSON merges an inline JSON config over the loaded configuration.
+func mergeJSON(v *viper.Viper, configJSON string) error {
+	j := viper.New()
+	j.SetConfigType("json")
+	if err := j.ReadConfig(strings.NewReader(configJSON)); err != nil {
+		return fmt.Errorf("failed to parse inline JSON config: %w", err)
+	}
+
+	if err := v.MergeConfigMap(j.AllSettings()); err != nil {
+		return fmt.Errorf("failed to merge inline JSON config: %w", err)
+	}
+
+	return nil
+}
+
+// mergeProfile merges a profile's section of the base file, then its own
+// file, over the loaded configuration.
+func mergeProfile(v *viper.Viper, profile string) error {
+	found := false
+
+	section := "profiles." + profile
+	if v.IsSet(section) {
+		if err := v.MergeConfigMap(v.GetStringMap(section)); err != nil {
+			return fmt.Errorf("failed to merge profile %s: %w", profile, err)
+		}
+		found = true
+	}
+
+	// The profile file sits beside the base file, or on the same search
+	// paths when there is no base file.
+	p := viper.New()
+	if base := v.ConfigFileUsed(); base != "" {
+		ext := filepath.Ext(base)
+		p.SetConfigFile(strings.TrimSuffix(base, ext) + "." + profile + ext)
+	} else {
+		p.SetConfigName("config." + profile)
+		p.SetConfigType("toml")
+		p.AddConfigPath(".")
+		p.AddConfigPath("./config")
+		p.AddConfigPath("/etc/ocr-checker")
+	}
+
+	err := p.ReadInConfig()
+	var notFound viper.ConfigFileNotFoundError
+	switch {
+	case err == nil:
+		if err := v.MergeConfigMap(p.AllSettings()); err != nil {
+			return fmt.Errorf("failed to merge profile %s: %w", profile, err)
+		}
+		found = true
+	case errors.As(err, &notFound), errors.Is(err, os.ErrNotExist):
+		// The profile may live in the base file only.
+	default:
+		return fmt.Errorf("failed to read profile %s: %w", profile, err)
+	}
+
+	if !found {
+		return fmt.Errorf("profile %s not found: no [%s] section or config.%s.toml", profile, section, profile)
+	}
+
+	return nil
+}
+
+// envBindings maps configuration keys to their environment variables.
+var envBindings = map[string]string{
+	"log_level":                  "OCR_LOG_LEVEL",
+	"chain_id":                   "OCR_CHAIN_ID",
+	"rpc_addr":                   "OCR_RPC_ADDR",
+	"default_transmitter":        "OCR_DEFAULT_TRANSMITTER",
+	"rpc_tls.ca_file":            "OCR_RPC_TLS_CA_FILE",
+	"rpc_tls.cert_file":          "OCR_RPC_TLS_CERT_FILE",
+	"rpc_tls.key_file":           "OCR_RPC_TLS_KEY_FILE",
+	"rpc_rps":                    "OCR_RPC_RPS",
+	"skip_chain_id_check":        "OCR_SKIP_CHAIN_ID_CHECK",
+	"source":                     "OCR_SOURCE",
+	"subgraph_url":               "OCR_SUBGRAPH_URL",
+	"database.user":              "OCR_DATABASE_USER",
+	"database.password":          "OCR_DATABASE_PASSWORD",
+	"database.host":              "OCR_DATABASE_HOST",
+	"database.port":              "OCR_DATABASE_PORT",
+	"database.dbName":            "OCR_DATABASE_DBNAME",
+	"database.sslMode":           "OCR_DATABASE_SSLMODE",
+	"database.max_idle_conns":    "OCR_DATABASE_MAX_IDLE_CONNS",
+	"database.max_open_conns":    "OCR_DATABASE_MAX_OPEN_CONNS",
+	"database.conn_max_lifetime": "OCR_DATABASE_CONN_MAX_LIFETIME",
+	"database.read_replica_dsn":  "OCR_DATABASE_READ_REPLICA_DSN",
+	"database.bulk_load":         "OCR_DATABASE_BULK_LOAD",
+	"database.ssl_root_cert":     "OCR_DATABASE_SSL_ROOT_CERT",
+	"database.ssl_cert":          "OCR_DATABASE_SSL_CERT",
+	"database.ssl_key":           "OCR_DATABASE_SSL_KEY",
+	"smtp.host":                  "OCR_SMTP_HOST",
+	"smtp.port":                  "OCR_SMTP_PORT",
+	"smtp.username":              "OCR_SMTP_USERNAME",
+	"smtp.password":              "OCR_SMTP_PASSWORD",
+	"smtp.from":                  "OCR_SMTP_FROM",
+	"smtp.to":                    "OCR_SMTP_TO",
+	"smtp.security":              "OCR_SMTP_SECURITY",
+	"smtp.message_template":      "OCR_SMTP_MESSAGE_TEMPLATE",
+	"slack.webhook_url":          "OCR_SLACK_WEBHOOK_URL",
+	"slack.username":             "OCR_SLACK_USERNAME",
+	"slack.icon_emoji":           "OCR_SLACK_ICON_EMOJI",
+	"slack.max_text_length":      "OCR_SLACK_MAX_TEXT_LENGTH",
+	"slack.truncation":           "OCR_SLACK_TRUNCATION",
+	"slack.message_template":     "OCR_SLACK_MESSAGE_TEMPLATE",
+
+	// Alert routing.
+	"pagerduty.routing_key": "OCR_PAGERDUTY_ROUTING_KEY",
+	"pagerduty.events_url":  "OCR_PAGERDUTY_EVENTS_URL",
+	"pagerduty.dedup_ttl":   "OCR_PAGERDUTY_DEDUP_TTL",
+	"pagerduty.state_file":  "OCR_PAGERDUTY_STATE_FILE",
+	"routing.critical":      "OCR_ROUTING_CRITICAL",
+	"routing.warning":       "OCR_ROUTING_WARNING",
+	"routing.info":          "OCR_ROUTING_INFO",
+
+	// Anomaly detection.
+	"anomaly.deviation_threshold_percent": "OCR_ANOMALY_DEVIATION_THRESHOLD_PERCENT",
+	"anomaly.deviation_max_delay":         "OCR_ANOMALY_DEVIATION_MAX_DELAY",
+	"anomaly.answer_decimals":             "OCR_ANOMALY_ANSWER_DECIMALS",
+
+	// Metrics.
+	"metrics.notifier_duration_buckets": "OCR_METRICS_NOTIFIER_DURATION_BUCKETS",
+}
+
+// bindEnv binds configuration keys to their OCR_* environment variables.
+func bindEnv(v *viper.Viper) error {
+	for key, env := range envBindings {
+		if err := v.BindEnv(key, env); err != nil {
+			return fmt.Errorf("failed to bind environment variable %s: %w", env, err)
+		}
+	}
+
+	return nil
+}
+
 // Validate validates the configuration.
 func (c *Config) Validate() error {
 	if c.ChainID <= 0 {
@@ -98,6 +437,36 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("rpc_addr is required")
 	}
 
+	if c.DefaultTransmitter != "" && !common.IsHexAddress(c.DefaultTransmitter) {
+		return fmt.Errorf("default_transmitter is not a valid address: %s", c.DefaultTransmitter)
+	}
+
+	if (c.RPCTLS.CertFile == "") != (c.RPCTLS.KeyFile == "") {
+		return fmt.Errorf("rpc_tls.cert_file and rpc_tls.key_file must be set together")
+	}
+
+	if c.RPCRPS < 0 {
+		return fmt.Errorf("rpc_rps must not be negative")
+	}
+
+	switch c.Source {
+	case "rpc":
+	case "subgraph":
+		if c.SubgraphURL == "" {
+			return fmt.Errorf("subgraph_url is required when source is subgraph")
+		}
+	default:
+		return fmt.Errorf("source must be rpc or subgraph")
+	}
+
+	if (c.Database.SSLCert == "") != (c.Database.SSLKey == "") {
+		return fmt.Errorf("database.ssl_cert and database.ssl_key must be set together")
+	}
+
+	if c.Database.BulkLoad != "insert" && c.Database.BulkLoad != "copy" {
+		return fmt.Errorf("database.bulk_load must be insert or copy")
+	}
+
 	if c.MaxConcurrency <= 0 {
 		return fmt.Errorf("max_concurrency must be positive")
 	}
@@ -106,11 +475,103 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("default_block_interval must be positive")
 	}
 
+	if c.MaxRoundRange <= 0 {
+		return fmt.Errorf("max_round_range must be positive")
+	}
+
+	// Small ranges are split into one chunk per concurrent worker, so allow at least that many.
+	if c.MaxFetchChunks < 100 {
+		return fmt.Errorf("max_fetch_chunks must be at least 100")
+	}
+
+	if c.HealthScorePrecision < 0 || c.HealthScorePrecision > 6 {
+		return fmt.Errorf("health_score_precision must be between 0 and 6")
+	}
+
+	if c.Slack.MaxTextLength <= 0 {
+		return fmt.Errorf("slack.max_text_length must be positive")
+	}
+
+	switch c.Slack.Truncation {
+	case "truncate", "split", "paginate":
+	default:
+		return fmt.Errorf("slack.truncation must be truncate, split, or paginate")
+	}
+
+	if c.PagerDuty.DedupTTL < 0 {
+		return fmt.Errorf("pagerduty.dedup_ttl cannot be negative")
+	}
+
+	for severity, names := range map[string][]string{
+		"critical": c.Routing.Critical,
+		"warning":  c.Routing.Warning,
+		"info":     c.Routing.Info,
+	} {
+		for _, name := range names {
+			switch name {
+			case "email", "slack", "pagerduty":
+			default:
+				return fmt.Errorf("routing.%s: unknown notifier %q (expected email, slack, or pagerduty)", severity, name)
+			}
+		}
+	}
+
+	if c.Anomaly.DeviationThresholdPercent < 0 {
+		return fmt.Errorf("anomaly.deviation_threshold_percent cannot be negative")
+	}
+
+	if c.Anomaly.DeviationMaxDelay < 0 {
+		return fmt.Errorf("anomaly.deviation_max_delay cannot be negative")
+	}
+
+	if c.Anomaly.AnswerDecimals < 0 || c.Anomaly.AnswerDecimals > 36 {
+		return fmt.Errorf("anomaly.answer_decimals must be between 0 and 36")
+	}
+
+	for contract, bound := range c.Anomaly.AnswerBounds {
+		if !common.IsHexAddress(contract) {
+			return fmt.Errorf("anomaly.answer_bounds: %q is not a valid contract address", contract)
+		}
+		if bound.Min != nil && bound.Max != nil && *bound.Min > *bound.Max {
+			return fmt.Errorf("anomaly.answer_bounds.%s: min is greater than max", contract)
+		}
+	}
+
+	for contract, display := range c.Anomaly.AnswerDisplay {
+		if !common.IsHexAddress(contract) {
+			return fmt.Errorf("anomaly.answer_display: %q is not a valid contract address", contract)
+		}
+		if display.Decimals != nil && (*display.Decimals < 0 || *display.Decimals > 36) {
+			return fmt.Errorf("anomaly.answer_display.%s: decimals must be between 0 and 36", contract)
+		}
+	}
+
+	for i, bucket := range c.Metrics.NotifierDurationBuckets {
+		if bucket <= 0 {
+			return fmt.Errorf("metrics.notifier_duration_buckets must be positive")
+		}
+		if i > 0 && bucket <= c.Metrics.NotifierDurationBuckets[i-1] {
+			return fmt.Errorf("metrics.notifier_duration_buckets must be in increasing order")
+		}
+	}
+
 	return nil
 }
 
 // GetDatabaseDSN returns the database connection string.
 func (c *DatabaseConfig) GetDatabaseDSN() string {
-	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		c.Host, c.Port, c.User, c.Password, c.DBName, c.SSLMode)
+
+	for _, param := range []struct{ key, value string }{
+		{"sslrootcert", c.SSLRootCert},
+		{"sslcert", c.SSLCert},
+		{"sslkey", c.SSLKey},
+	} {
+		if param.value != "" {
+			dsn += fmt.Sprintf(" %s=%s", param.key, param.value)
+		}
+	}
+
+	return dsn
 }
@@ -0,0 +1,291 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig_EnvOnly(t *testing.T) {
+	t.Setenv("OCR_CHAIN_ID", "137")
+	t.Setenv("OCR_RPC_ADDR", "https://polygon.example.org")
+	t.Setenv("OCR_LOG_LEVEL", "debug")
+	t.Setenv("OCR_DATABASE_HOST", "db.example.org")
+	t.Setenv("OCR_DATABASE_PORT", "5433")
+	t.Setenv("OCR_DATABASE_USER", "ocr")
+	t.Setenv("OCR_DATABASE_PASSWORD", "secret")
+	t.Setenv("OCR_DATABASE_DBNAME", "chainlink")
+	t.Setenv("OCR_DATABASE_SSLMODE", "require")
+	t.Setenv("OCR_DATABASE_CONN_MAX_LIFETIME", "30m")
+	t.Setenv("OCR_SLACK_USERNAME", "Polygon Monitor")
+	t.Setenv("OCR_SLACK_TRUNCATION", "split")
+	t.Setenv("OCR_ROUTING_CRITICAL", "slack,pagerduty")
+	t.Setenv("OCR_PAGERDUTY_STATE_FILE", "/var/lib/ocr/pagerduty.json")
+	t.Setenv("OCR_DEFAULT_TRANSMITTER", "0xa000000000000000000000000000000000000000")
+	t.Setenv("OCR_RPC_TLS_CA_FILE", "/etc/ocr/ca.pem")
+	t.Setenv("OCR_DATABASE_SSL_ROOT_CERT", "/etc/ocr/ca.pem")
+
+	cfg, err := LoadConfig("")
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(137), cfg.ChainID)
+	assert.Equal(t, "https://polygon.example.org", cfg.RPCAddr)
+	assert.Equal(t, "debug", cfg.LogLevel)
+	assert.Equal(t, "0xa000000000000000000000000000000000000000", cfg.DefaultTransmitter)
+	assert.Equal(t, "db.example.org", cfg.Database.Host)
+	assert.Equal(t, "5433", cfg.Database.Port)
+	assert.Equal(t, "ocr", cfg.Database.User)
+	assert.Equal(t, "secret", cfg.Database.Password)
+	assert.Equal(t, "chainlink", cfg.Database.DBName)
+	assert.Equal(t, "require", cfg.Database.SSLMode)
+	assert.Equal(t, 30*time.Minute, cfg.Database.ConnMaxLifetime)
+	assert.Equal(t, "/etc/ocr/ca.pem", cfg.RPCTLS.CAFile)
+	assert.Equal(t,
+		"host=db.example.org port=5433 user=ocr password=secret dbname=chainlink sslmode=require sslrootcert=/etc/ocr/ca.pem",
+		cfg.Database.GetDatabaseDSN())
+
+	// Defaults still apply to keys not set in the environment.
+	assert.Equal(t, 30, cfg.MaxConcurrency)
+	assert.Equal(t, 10, cfg.Database.MaxIdleConns)
+	assert.Equal(t, "Polygon Monitor", cfg.Slack.Username)
+	assert.Equal(t, "split", cfg.Slack.Truncation)
+	assert.Equal(t, 3000, cfg.Slack.MaxTextLength)
+	assert.Equal(t, []string{"slack", "pagerduty"}, cfg.Routing.Critical)
+	assert.Empty(t, cfg.Routing.Warning)
+	assert.Equal(t, "/var/lib/ocr/pagerduty.json", cfg.PagerDuty.StateFile)
+	assert.Equal(t, time.Hour, cfg.PagerDuty.DedupTTL)
+	assert.Equal(t, ":robot_face:", cfg.Slack.IconEmoji)
+	assert.Equal(t, "rpc", cfg.Source)
+}
+
+func TestLoadConfig_EnvOnlyMissingRequired(t *testing.T) {
+	t.Setenv("OCR_RPC_ADDR", "https://polygon.example.org")
+
+	_, err := LoadConfig("")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "chain_id must be positive")
+}
+
+func TestLoadConfig_ClientCertRequiresKey(t *testing.T) {
+	t.Setenv("OCR_CHAIN_ID", "137")
+	t.Setenv("OCR_RPC_ADDR", "https://polygon.example.org")
+	t.Setenv("OCR_RPC_TLS_CERT_FILE", "/etc/ocr/client.pem")
+
+	_, err := LoadConfig("")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rpc_tls.cert_file and rpc_tls.key_file must be set together")
+}
+
+func TestLoadConfig_SubgraphSourceRequiresURL(t *testing.T) {
+	t.Setenv("OCR_CHAIN_ID", "137")
+	t.Setenv("OCR_RPC_ADDR", "https://polygon.example.org")
+	t.Setenv("OCR_SOURCE", "subgraph")
+
+	_, err := LoadConfig("")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "subgraph_url is required when source is subgraph")
+
+	t.Setenv("OCR_SUBGRAPH_URL", "https://subgraph.example.org/ocr")
+	cfg, err := LoadConfig("")
+	require.NoError(t, err)
+	assert.Equal(t, "subgraph", cfg.Source)
+	assert.Equal(t, "https://subgraph.example.org/ocr", cfg.SubgraphURL)
+}
+
+func TestLoadConfig_AnswerBounds(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+chain_id = 137
+rpc_addr = "https://polygon.example.org"
+
+[anomaly.answer_bounds.0xa142BB41f409599603D3bB16842D0d274AAeDcf5]
+min = 0.01
+max = 100000
+`), 0o600))
+
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+
+	bound, ok := cfg.Anomaly.AnswerBounds["0xa142bb41f409599603d3bb16842d0d274aaedcf5"]
+	require.True(t, ok)
+	require.NotNil(t, bound.Min)
+	require.NotNil(t, bound.Max)
+	assert.Equal(t, 0.01, *bound.Min)
+	assert.Equal(t, 100000.0, *bound.Max)
+}
+
+func TestLoadConfig_AnswerDisplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+chain_id = 137
+rpc_addr = "https://polygon.example.org"
+
+[anomaly.answer_display.0xa142BB41f409599603D3bB16842D0d274AAeDcf5]
+decimals = 2
+prefix = "$"
+thousands_separator = ","
+`), 0o600))
+
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+
+	display, ok := cfg.Anomaly.AnswerDisplay["0xa142bb41f409599603d3bb16842d0d274aaedcf5"]
+	require.True(t, ok)
+	require.NotNil(t, display.Decimals)
+	assert.Equal(t, 2, *display.Decimals)
+	assert.Equal(t, "$", display.Prefix)
+	assert.Equal(t, ",", display.ThousandsSeparator)
+
+	negative := -1
+	cfg.Anomaly.AnswerDisplay["0xa142bb41f409599603d3bb16842d0d274aaedcf5"] = AnswerDisplayConfig{Decimals: &negative}
+	assert.ErrorContains(t, cfg.Validate(), "decimals must be between 0 and 36")
+}
+
+func TestLoadConfig_NotifierDurationBuckets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+chain_id = 137
+rpc_addr = "https://polygon.example.org"
+
+[metrics]
+notifier_duration_buckets = [0.1, 0.5, 2]
+`), 0o600))
+
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0.1, 0.5, 2}, cfg.Metrics.NotifierDurationBuckets)
+
+	cfg.Metrics.NotifierDurationBuckets = []float64{0.5, 0.1}
+	assert.ErrorContains(t, cfg.Validate(), "increasing order")
+}
+
+func TestLoadConfigWithProfile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+chain_id = 137
+rpc_addr = "https://polygon.dev.example.org"
+max_concurrency = 5
+
+[database]
+host = "db.dev.example.org"
+user = "ocr"
+
+[profiles.prod]
+rpc_addr = "https://polygon.prod.example.org"
+
+[profiles.prod.database]
+host = "db.prod.example.org"
+`), 0o600))
+
+	cfg, err := LoadConfigWithProfile(path, "prod")
+	require.NoError(t, err)
+
+	// The profile section overrides the base; keys it omits keep the base values.
+	assert.Equal(t, "https://polygon.prod.example.org", cfg.RPCAddr)
+	assert.Equal(t, "db.prod.example.org", cfg.Database.Host)
+	assert.Equal(t, "ocr", cfg.Database.User)
+	assert.Equal(t, int64(137), cfg.ChainID)
+	assert.Equal(t, 5, cfg.MaxConcurrency)
+
+	// Without a profile the base values are used.
+	cfg, err = LoadConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, "https://polygon.dev.example.org", cfg.RPCAddr)
+	assert.Equal(t, "db.dev.example.org", cfg.Database.Host)
+
+	// A profile file beside the base overrides the section.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.prod.toml"), []byte(`
+[database]
+host = "db-file.prod.example.org"
+`), 0o600))
+	cfg, err = LoadConfigWithProfile(path, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, "https://polygon.prod.example.org", cfg.RPCAddr)
+	assert.Equal(t, "db-file.prod.example.org", cfg.Database.Host)
+
+	// The environment overrides the profile.
+	t.Setenv("OCR_DATABASE_HOST", "db.env.example.org")
+	cfg, err = LoadConfigWithProfile(path, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, "db.env.example.org", cfg.Database.Host)
+}
+
+func TestLoadConfigWithProfile_NotFound(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+chain_id = 137
+rpc_addr = "https://polygon.example.org"
+`), 0o600))
+
+	_, err := LoadConfigWithProfile(path, "staging")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "profile staging not found")
+}
+
+func TestLoadConfigWithJSON(t *testing.T) {
+	cfg, err := LoadConfigWithJSON("", "", `{
+		"chain_id": 137,
+		"rpc_addr": "https://polygon.example.org",
+		"max_concurrency": 8,
+		"blockchain_timeout": "45s",
+		"database": {"host": "db.example.org", "dbName": "chainlink"},
+		"slack": {"truncation": "paginate"},
+		"routing": {"critical": ["slack", "pagerduty"]}
+	}`)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(137), cfg.ChainID)
+	assert.Equal(t, "https://polygon.example.org", cfg.RPCAddr)
+	assert.Equal(t, 8, cfg.MaxConcurrency)
+	assert.Equal(t, 45*time.Second, cfg.BlockchainTimeout)
+	assert.Equal(t, "db.example.org", cfg.Database.Host)
+	assert.Equal(t, "chainlink", cfg.Database.DBName)
+	assert.Equal(t, "paginate", cfg.Slack.Truncation)
+	assert.Equal(t, []string{"slack", "pagerduty"}, cfg.Routing.Critical)
+
+	// Defaults still apply to keys the JSON omits.
+	assert.Equal(t, 50000, cfg.MaxFetchChunks)
+	assert.Equal(t, uint64(500000), cfg.MaxBlockRange)
+	assert.Equal(t, "rpc", cfg.Source)
+	assert.Equal(t, "disable", cfg.Database.SSLMode)
+
+	// The inline config is validated like a file.
+	_, err = LoadConfigWithJSON("", "", `{"chain_id": 137, "rpc_addr": "https://polygon.example.org", "max_concurrency": 0}`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "max_concurrency must be positive")
+
+	_, err = LoadConfigWithJSON("", "", `{"chain_id": 137,`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse inline JSON config")
+}
+
+func TestLoadConfigWithJSON_Precedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+chain_id = 137
+rpc_addr = "https://polygon.file.example.org"
+
+[database]
+host = "db.file.example.org"
+user = "ocr"
+`), 0o600))
+
+	// The JSON overrides the file; keys it omits keep the file's values.
+	t.Setenv("OCR_CONFIG_JSON", `{"rpc_addr": "https://polygon.env-json.example.org"}`)
+	cfg, err := LoadConfigWithJSON(path, "", `{"database": {"host": "db.json.example.org"}}`)
+	require.NoError(t, err)
+	assert.Equal(t, "https://polygon.file.example.org", cfg.RPCAddr)
+	assert.Equal(t, "db.json.example.org", cfg.Database.Host)
+	assert.Equal(t, "ocr", cfg.Database.User)
+
+	// Without --config-json, OCR_CONFIG_JSON is used.
+	cfg, err = LoadConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, "https://polygon.env-json.example.org", cfg.RPCAddr)
+	assert.Equal(t, "db.file.example.org", cfg.Database.Host)
+}
@@ -3,14 +3,21 @@
 package config
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"text/template"
 
 	"chainlink-ocr-checker/application/services"
 	"chainlink-ocr-checker/application/usecases"
 	"chainlink-ocr-checker/domain/interfaces"
 	"chainlink-ocr-checker/infrastructure/blockchain"
 	"chainlink-ocr-checker/infrastructure/logger"
+	"chainlink-ocr-checker/infrastructure/mask"
+	"chainlink-ocr-checker/infrastructure/metrics"
+	"chainlink-ocr-checker/infrastructure/notifier"
 	"chainlink-ocr-checker/infrastructure/repository"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -23,9 +30,20 @@ type Container struct {
 	// Infrastructure.
 	Logger           interfaces.Logger
 	DB               *gorm.DB
+	ReadReplicaDB    *gorm.DB
 	EthClient        *ethclient.Client
 	BlockchainClient interfaces.BlockchainClient
 
+	// RPCLimiter caps requests of every RPC client; its rate can change after dialing.
+	RPCLimiter *blockchain.RPCRateLimiter
+
+	// Confirmations is how far the reported head trails the chain; it can change after dialing.
+	Confirmations *blockchain.Confirmations
+
+	// Metrics collects the Prometheus metrics the monitor command serves,
+	// including notifier send durations.
+	Metrics *metrics.PrometheusRecorder
+
 	// Repositories.
 	JobRepository          interfaces.JobRepository
 	TransmissionRepository interfaces.TransmissionRepository
@@ -36,14 +54,35 @@ type Container struct {
 	TransmissionFetcher   interfaces.TransmissionFetcher
 	TransmissionAnalyzer  interfaces.TransmissionAnalyzer
 
+	// Notifiers configured for alert delivery.
+	Notifiers []interfaces.Notifier
+
+	// AlertNotifier dispatches alerts to Notifiers by severity; nil without notifiers.
+	AlertNotifier interfaces.Notifier
+
 	// Use Cases.
-	FetchTransmissionsUseCase interfaces.FetchTransmissionsUseCase
-	WatchTransmittersUseCase  interfaces.WatchTransmittersUseCase
-	ParseTransmissionsUseCase interfaces.ParseTransmissionsUseCase
+	FetchTransmissionsUseCase   interfaces.FetchTransmissionsUseCase
+	WatchTransmittersUseCase    interfaces.WatchTransmittersUseCase
+	ParseTransmissionsUseCase   interfaces.ParseTransmissionsUseCase
+	ReindexTransmissionsUseCase interfaces.ReindexTransmissionsUseCase
+	ContractInfoUseCase         interfaces.ContractInfoUseCase
+	ConfigHistoryUseCase        interfaces.ConfigHistoryUseCase
+	ConfigDiffUseCase           interfaces.ConfigDiffUseCase
+	ObserversUseCase            interfaces.ObserversUseCase
+	SLAUseCase                  interfaces.SLAUseCase
+	VerifyTransmissionsUseCase  interfaces.VerifyTransmissionsUseCase
+	ArchiveTransmissionsUseCase interfaces.ArchiveTransmissionsUseCase
 }
 
 // NewContainer creates a new dependency injection container.
 func NewContainer(config *Config) (*Container, error) {
+	return NewContainerWithTransport(config, nil)
+}
+
+// NewContainerWithTransport creates a container whose RPC requests go through
+// transport instead of a dialed connection, so tests can serve recorded
+// responses. A nil transport dials the configured RPC as NewContainer does.
+func NewContainerWithTransport(config *Config, transport http.RoundTripper) (*Container, error) {
 	container := &Container{
 		Config: config,
 	}
@@ -51,14 +90,17 @@ func NewContainer(config *Config) (*Container, error) {
 	// Initialize logger.
 	container.Logger = logger.NewLogrusLogger(config.LogLevel)
 
+	// Initialize metrics.
+	container.Metrics = metrics.NewPrometheusRecorderWithBuckets(config.Metrics.NotifierDurationBuckets)
+
 	// Initialize blockchain client.
-	if err := container.initBlockchainClient(); err != nil {
+	if err := container.initBlockchainClient(transport); err != nil {
 		return nil, fmt.Errorf("failed to initialize blockchain client: %w", err)
 	}
 
 	// Initialize database (optional).
 	if config.Database.Host != "" {
-		if err := container.initDatabase(); err != nil {
+		if err := container.initDatabase(container.openDatabase); err != nil {
 			container.Logger.Warn("Failed to initialize database", "error", err)
 			// Database is optional, so we continue.
 		}
@@ -70,69 +112,169 @@ func NewContainer(config *Config) (*Container, error) {
 	// Initialize use cases.
 	container.initUseCases()
 
+	// Initialize notifiers.
+	container.initNotifiers()
+
 	return container, nil
 }
 
-// initBlockchainClient initializes the blockchain client.
-func (c *Container) initBlockchainClient() error {
+// initBlockchainClient initializes the blockchain client, over transport when it is set.
+func (c *Container) initBlockchainClient(transport http.RoundTripper) error {
+	tlsOpts := blockchain.TLSOptions{
+		CAFile:   c.Config.RPCTLS.CAFile,
+		CertFile: c.Config.RPCTLS.CertFile,
+		KeyFile:  c.Config.RPCTLS.KeyFile,
+	}
+
+	// Share one limiter so the cap holds across clients.
+	c.RPCLimiter = blockchain.NewRPCRateLimiter(c.Config.RPCRPS)
+	c.Confirmations = blockchain.NewConfirmations(c.Config.ChainID)
+	chainIDCheck := blockchain.ChainIDCheck{Skip: c.Config.SkipChainIDCheck, Logger: c.Logger}
+
+	// An injected transport serves both clients from one connection.
+	if transport != nil {
+		ethClient, err := blockchain.DialRPCWithTransport(context.Background(), c.Config.RPCAddr, transport, c.RPCLimiter)
+		if err != nil {
+			return fmt.Errorf("failed to dial RPC %s: %w", mask.URL(c.Config.RPCAddr), mask.Error(err))
+		}
+		c.EthClient = ethClient
+
+		blockchainClient, err := blockchain.NewEthereumClientFromRPC(
+			ethClient, c.Config.ChainID, c.Confirmations, chainIDCheck)
+		if err != nil {
+			return fmt.Errorf("failed to create blockchain client: %w", mask.Error(err))
+		}
+		c.BlockchainClient = blockchainClient
+		return nil
+	}
+
 	// Create Ethereum client.
-	ethClient, err := ethclient.Dial(c.Config.RPCAddr)
+	ethClient, err := blockchain.DialRPC(context.Background(), c.Config.RPCAddr, tlsOpts, c.RPCLimiter)
 	if err != nil {
-		return fmt.Errorf("failed to dial RPC: %w", err)
+		return fmt.Errorf("failed to dial RPC %s: %w", mask.URL(c.Config.RPCAddr), mask.Error(err))
 	}
 	c.EthClient = ethClient
 
-	// Create blockchain client wrapper.
-	blockchainClient, err := blockchain.NewEthereumClient(c.Config.RPCAddr, c.Config.ChainID)
+	// Create blockchain client wrapper, trailing head by the chain's confirmations.
+	blockchainClient, err := blockchain.NewEthereumClientWithTLS(
+		c.Config.RPCAddr, c.Config.ChainID, tlsOpts, c.RPCLimiter, c.Confirmations, chainIDCheck)
 	if err != nil {
-		return fmt.Errorf("failed to create blockchain client: %w", err)
+		return fmt.Errorf("failed to create blockchain client: %w", mask.Error(err))
 	}
 	c.BlockchainClient = blockchainClient
 
 	return nil
 }
 
-// initDatabase initializes the database connection.
-func (c *Container) initDatabase() error {
-	dsn := c.Config.Database.GetDatabaseDSN()
+// initDatabase initializes the database connection, opening each DSN with open.
+// A read replica that fails to open is logged and reads stay on the primary.
+func (c *Container) initDatabase(open func(dsn string) (*gorm.DB, error)) error {
+	db, err := open(c.Config.Database.GetDatabaseDSN())
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	c.DB = db
+
+	// Reads go to the replica when one is configured.
+	if c.Config.Database.ReadReplicaDSN != "" {
+		replica, err := open(c.Config.Database.ReadReplicaDSN)
+		if err != nil {
+			c.Logger.Warn("Failed to open read replica, reading from the primary", "error", err)
+		} else {
+			c.ReadReplicaDB = replica
+		}
+	}
+
+	// Initialize repositories.
+	c.JobRepository = repository.NewJobRepositoryWithReplica(db, c.ReadReplicaDB)
+	c.TransmissionRepository = repository.NewTransmissionRepositoryWithBulkLoad(
+		db, c.ReadReplicaDB, repository.BulkLoadMode(c.Config.Database.BulkLoad))
+	c.UnitOfWork = repository.NewUnitOfWork(db)
 
+	return nil
+}
+
+// openDatabase opens a connection pool configured from the database settings.
+func (c *Container) openDatabase(dsn string) (*gorm.DB, error) {
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		Logger: nil, // We use our own logger
 	})
 	if err != nil {
-		return fmt.Errorf("failed to open database: %w", err)
+		// Driver errors may quote the DSN.
+		return nil, mask.Error(err)
 	}
 
 	// Configure connection pool.
 	sqlDB, err := db.DB()
 	if err != nil {
-		return fmt.Errorf("failed to get sql.DB: %w", err)
+		return nil, fmt.Errorf("failed to get sql.DB: %w", err)
 	}
 
 	sqlDB.SetMaxIdleConns(c.Config.Database.MaxIdleConns)
 	sqlDB.SetMaxOpenConns(c.Config.Database.MaxOpenConns)
 	sqlDB.SetConnMaxLifetime(c.Config.Database.ConnMaxLifetime)
 
-	c.DB = db
+	return db, nil
+}
 
-	// Initialize repositories.
-	c.JobRepository = repository.NewJobRepository(db)
-	c.TransmissionRepository = repository.NewTransmissionRepository(db)
-	c.UnitOfWork = repository.NewUnitOfWork(db)
+// answerBounds converts the configured answer bounds to analyzer bounds keyed by contract.
+func (c *Container) answerBounds() map[common.Address]services.AnswerBound {
+	if len(c.Config.Anomaly.AnswerBounds) == 0 {
+		return nil
+	}
 
-	return nil
+	bounds := make(map[common.Address]services.AnswerBound, len(c.Config.Anomaly.AnswerBounds))
+	for contract, bound := range c.Config.Anomaly.AnswerBounds {
+		bounds[common.HexToAddress(contract)] = services.AnswerBound{Min: bound.Min, Max: bound.Max}
+	}
+	return bounds
+}
+
+// answerDisplays converts the configured answer display settings to analyzer displays keyed by contract.
+func (c *Container) answerDisplays() map[common.Address]services.AnswerDisplay {
+	if len(c.Config.Anomaly.AnswerDisplay) == 0 {
+		return nil
+	}
+
+	displays := make(map[common.Address]services.AnswerDisplay, len(c.Config.Anomaly.AnswerDisplay))
+	for contract, display := range c.Config.Anomaly.AnswerDisplay {
+		displays[common.HexToAddress(contract)] = services.AnswerDisplay{
+			Decimals:           display.Decimals,
+			Prefix:             display.Prefix,
+			Suffix:             display.Suffix,
+			ThousandsSeparator: display.ThousandsSeparator,
+		}
+	}
+	return displays
 }
 
 // initServices initializes domain services.
 func (c *Container) initServices() {
 	// OCR2 Aggregator Service.
-	c.OCR2AggregatorService = blockchain.NewOCR2AggregatorService(c.EthClient, c.Config.ChainID)
+	c.OCR2AggregatorService = blockchain.NewOCR2AggregatorService(c.EthClient, c.Config.ChainID, c.Logger)
 
-	// Transmission Fetcher.
-	c.TransmissionFetcher = blockchain.NewTransmissionFetcher(c.BlockchainClient, c.OCR2AggregatorService)
+	// Transmission Fetcher, reading from the configured source.
+	if c.Config.Source == "subgraph" {
+		c.TransmissionFetcher = blockchain.NewSubgraphTransmissionSource(
+			c.Config.SubgraphURL,
+			&http.Client{Timeout: c.Config.BlockchainTimeout},
+		)
+	} else {
+		c.TransmissionFetcher = blockchain.NewTransmissionFetcher(
+			c.BlockchainClient,
+			c.OCR2AggregatorService,
+			c.Config.MaxFetchChunks,
+		)
+	}
 
 	// Transmission Analyzer.
-	c.TransmissionAnalyzer = services.NewTransmissionAnalyzer(c.Logger)
+	c.TransmissionAnalyzer = services.NewTransmissionAnalyzer(c.Logger, services.AnomalyConfig{
+		DeviationThresholdPercent: c.Config.Anomaly.DeviationThresholdPercent,
+		DeviationMaxDelay:         c.Config.Anomaly.DeviationMaxDelay,
+		AnswerDecimals:            uint8(c.Config.Anomaly.AnswerDecimals), // #nosec G115 -- validated range
+		AnswerBounds:              c.answerBounds(),
+		AnswerDisplays:            c.answerDisplays(),
+	})
 }
 
 // initUseCases initializes use cases.
@@ -140,8 +282,10 @@ func (c *Container) initUseCases() {
 	// Fetch Transmissions Use Case.
 	c.FetchTransmissionsUseCase = usecases.NewFetchTransmissionsUseCase(
 		c.TransmissionFetcher,
+		c.OCR2AggregatorService,
 		c.TransmissionRepository,
 		c.Logger,
+		uint32(c.Config.MaxRoundRange), // #nosec G115 -- validated positive
 	)
 
 	// Watch Transmitters Use Case.
@@ -151,6 +295,7 @@ func (c *Container) initUseCases() {
 			c.TransmissionFetcher,
 			c.OCR2AggregatorService,
 			c.Logger,
+			c.Config.MaxBlockRange,
 		)
 	}
 
@@ -159,6 +304,233 @@ func (c *Container) initUseCases() {
 		c.TransmissionAnalyzer,
 		c.Logger,
 	)
+
+	// Reindex Transmissions Use Case.
+	c.ReindexTransmissionsUseCase = usecases.NewReindexTransmissionsUseCase(
+		c.OCR2AggregatorService,
+		c.Logger,
+	)
+
+	// Contract Info Use Case.
+	c.ContractInfoUseCase = usecases.NewContractInfoUseCase(
+		c.BlockchainClient,
+		c.TransmissionFetcher,
+		c.Logger,
+	)
+
+	// Config History Use Case.
+	c.ConfigHistoryUseCase = usecases.NewConfigHistoryUseCase(
+		c.BlockchainClient,
+		c.OCR2AggregatorService,
+		c.Logger,
+	)
+
+	// Config Diff Use Case.
+	c.ConfigDiffUseCase = usecases.NewConfigDiffUseCase(
+		c.OCR2AggregatorService,
+		c.Logger,
+	)
+
+	// Observers Use Case.
+	c.ObserversUseCase = usecases.NewObserversUseCase(
+		c.OCR2AggregatorService,
+		c.Logger,
+	)
+
+	// Verify Transmissions Use Case.
+	c.VerifyTransmissionsUseCase = usecases.NewVerifyTransmissionsUseCase(
+		c.TransmissionFetcher,
+		c.Logger,
+	)
+
+	// SLA Use Case.
+	c.SLAUseCase = usecases.NewSLAUseCase(
+		c.TransmissionFetcher,
+		c.OCR2AggregatorService,
+		c.Logger,
+	)
+
+	// Archive Transmissions Use Case.
+	c.ArchiveTransmissionsUseCase = usecases.NewArchiveTransmissionsUseCase(
+		c.BlockchainClient,
+		c.OCR2AggregatorService,
+		c.Logger,
+	)
+}
+
+// initNotifiers initializes the notifiers enabled by configuration.
+func (c *Container) initNotifiers() {
+	// Email is enabled when SMTP has a host and default recipients.
+	if c.Config.SMTP.Host != "" && len(c.Config.SMTP.To) > 0 {
+		emailNotifier, err := c.NewEmailNotifier(nil)
+		if err != nil {
+			c.Logger.Warn("Failed to initialize email notifier", "error", err)
+		} else {
+			c.Notifiers = append(c.Notifiers, emailNotifier)
+		}
+	}
+
+	// Slack is enabled when a webhook URL is configured.
+	if c.Config.Slack.WebhookURL != "" {
+		slackNotifier, err := c.NewSlackNotifier(SlackConfig{})
+		if err != nil {
+			c.Logger.Warn("Failed to initialize slack notifier", "error", err)
+		} else {
+			c.Notifiers = append(c.Notifiers, slackNotifier)
+		}
+	}
+
+	// PagerDuty is enabled when a routing key is configured.
+	if c.Config.PagerDuty.RoutingKey != "" {
+		pagerDutyNotifier, err := notifier.NewPagerDutyNotifier(notifier.PagerDutyConfig{
+			RoutingKey:     c.Config.PagerDuty.RoutingKey,
+			EventsURL:      c.Config.PagerDuty.EventsURL,
+			Metrics:        c.notifierMetrics(),
+			DedupTTL:       c.Config.PagerDuty.DedupTTL,
+			DedupStateFile: c.Config.PagerDuty.StateFile,
+			Logger:         c.Logger,
+		})
+		if err != nil {
+			c.Logger.Warn("Failed to initialize pagerduty notifier", "error", err)
+		} else {
+			c.Notifiers = append(c.Notifiers, pagerDutyNotifier)
+		}
+	}
+
+	if len(c.Notifiers) == 0 {
+		return
+	}
+
+	var routes notifier.SeverityRoutes
+	if !c.Config.Routing.IsEmpty() {
+		routes = notifier.SeverityRoutes{
+			interfaces.NotificationSeverityCritical: c.Config.Routing.Critical,
+			interfaces.NotificationSeverityWarning:  c.Config.Routing.Warning,
+			interfaces.NotificationSeverityInfo:     c.Config.Routing.Info,
+		}
+	}
+
+	alertNotifier, err := notifier.NewMultiNotifier(c.Notifiers, routes)
+	if err != nil {
+		c.Logger.Warn("Failed to initialize alert routing", "error", err)
+		return
+	}
+	c.AlertNotifier = alertNotifier
+}
+
+// SelectAlertNotifier returns a notifier that sends every alert to the named
+// configured notifiers, in place of the severity routing of AlertNotifier.
+// A name that is not configured is an error.
+func (c *Container) SelectAlertNotifier(names []string) (interfaces.Notifier, error) {
+	configured := make(map[string]interfaces.Notifier, len(c.Notifiers))
+	for _, n := range c.Notifiers {
+		configured[n.Name()] = n
+	}
+
+	selected := make([]interfaces.Notifier, 0, len(names))
+	for _, name := range names {
+		n, ok := configured[name]
+		if !ok {
+			return nil, fmt.Errorf("notifier %q is not configured", name)
+		}
+		selected = append(selected, n)
+	}
+
+	return notifier.NewMultiNotifier(selected, nil)
+}
+
+// NewEmailNotifier creates an email notifier from the SMTP configuration.
+// Recipients override the configured smtp.to list when provided.
+func (c *Container) NewEmailNotifier(recipients []string) (interfaces.Notifier, error) {
+	smtpConfig := c.Config.SMTP
+	if smtpConfig.Host == "" {
+		return nil, fmt.Errorf("smtp configuration required for email notifications")
+	}
+
+	to := smtpConfig.To
+	if len(recipients) > 0 {
+		to = recipients
+	}
+
+	messageTemplate, err := loadMessageTemplate(smtpConfig.MessageTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifier.NewEmailNotifier(notifier.EmailConfig{
+		Host:     smtpConfig.Host,
+		Port:     smtpConfig.Port,
+		Username: smtpConfig.Username,
+		Password: smtpConfig.Password,
+		From:     smtpConfig.From,
+		To:       to,
+		Security: smtpConfig.Security,
+
+		MessageTemplate: messageTemplate,
+		Metrics:         c.notifierMetrics(),
+	})
+}
+
+// NewSlackNotifier creates a Slack notifier from the slack configuration.
+// Non-empty override fields take precedence over the configured values.
+func (c *Container) NewSlackNotifier(overrides SlackConfig) (interfaces.Notifier, error) {
+	slackConfig := c.Config.Slack
+	if overrides.WebhookURL != "" {
+		slackConfig.WebhookURL = overrides.WebhookURL
+	}
+	if overrides.Username != "" {
+		slackConfig.Username = overrides.Username
+	}
+	if overrides.IconEmoji != "" {
+		slackConfig.IconEmoji = overrides.IconEmoji
+	}
+	if overrides.MaxTextLength > 0 {
+		slackConfig.MaxTextLength = overrides.MaxTextLength
+	}
+	if overrides.Truncation != "" {
+		slackConfig.Truncation = overrides.Truncation
+	}
+	if overrides.MessageTemplate != "" {
+		slackConfig.MessageTemplate = overrides.MessageTemplate
+	}
+
+	if slackConfig.WebhookURL == "" {
+		return nil, fmt.Errorf("slack webhook url required for slack notifications")
+	}
+
+	messageTemplate, err := loadMessageTemplate(slackConfig.MessageTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifier.NewSlackNotifier(notifier.SlackConfig{
+		WebhookURL: slackConfig.WebhookURL,
+		Username:   slackConfig.Username,
+		IconEmoji:  slackConfig.IconEmoji,
+
+		MaxTextLength:   slackConfig.MaxTextLength,
+		Truncation:      slackConfig.Truncation,
+		MessageTemplate: messageTemplate,
+		Metrics:         c.notifierMetrics(),
+	})
+}
+
+// notifierMetrics returns the recorder notifiers report sends to, or nil
+// without one so the notifiers skip recording.
+func (c *Container) notifierMetrics() interfaces.NotifierMetrics {
+	if c.Metrics == nil {
+		return nil
+	}
+	return c.Metrics
+}
+
+// loadMessageTemplate loads a notifier message template, or returns nil when
+// no template file is configured.
+func loadMessageTemplate(path string) (*template.Template, error) {
+	if path == "" {
+		return nil, nil
+	}
+	return notifier.LoadMessageTemplate(path)
 }
 
 // Close closes all resources.
@@ -184,6 +556,14 @@ func (c *Container) Close() error {
 			}
 		}
 	}
+	if c.ReadReplicaDB != nil {
+		sqlDB, err := c.ReadReplicaDB.DB()
+		if err == nil {
+			if err := sqlDB.Close(); err != nil {
+				c.Logger.Error("Failed to close read replica", "error", err)
+			}
+		}
+	}
 
 	return nil
 }
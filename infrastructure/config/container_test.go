@@ -0,0 +1,50 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestInitDatabase_ReplicaFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sqlDB, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = sqlDB.Close() }()
+
+	primary, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Warn("Failed to open read replica, reading from the primary", gomock.Any())
+
+	const replicaDSN = "postgres://replica.example.org/chainlink"
+	container := &Container{
+		Config: &Config{Database: DatabaseConfig{Host: "db.example.org", ReadReplicaDSN: replicaDSN}},
+		Logger: logger,
+	}
+
+	// A broken replica leaves the repositories built on the primary.
+	err = container.initDatabase(func(dsn string) (*gorm.DB, error) {
+		if dsn == replicaDSN {
+			return nil, errors.New("connection refused")
+		}
+		return primary, nil
+	})
+	require.NoError(t, err)
+
+	assert.Same(t, primary, container.DB)
+	assert.Nil(t, container.ReadReplicaDB)
+	assert.NotNil(t, container.JobRepository)
+	assert.NotNil(t, container.TransmissionRepository)
+	assert.NotNil(t, container.UnitOfWork)
+}
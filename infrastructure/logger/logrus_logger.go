@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/mask"
 	"github.com/sirupsen/logrus"
 )
 
@@ -18,8 +19,8 @@ type logrusLogger struct {
 func NewLogrusLogger(level string) interfaces.Logger {
 	log := logrus.New()
 
-	// Set output.
-	log.SetOutput(os.Stdout)
+	// Log to stderr so command output on stdout can be piped.
+	log.SetOutput(os.Stderr)
 
 	// Set format.
 	log.SetFormatter(&logrus.TextFormatter{
@@ -67,26 +68,32 @@ func (l *logrusLogger) Fatal(msg string, fields ...interface{}) {
 
 // WithFields returns a logger with additional fields.
 func (l *logrusLogger) WithFields(fields map[string]interface{}) interfaces.Logger {
+	masked := make(logrus.Fields, len(fields))
+	for key, value := range fields {
+		masked[key] = mask.Field(key, value)
+	}
+
 	return &logrusLogger{
-		logger: l.logger.WithFields(fields),
+		logger: l.logger.WithFields(masked),
 	}
 }
 
 // WithError returns a logger with an error field.
 func (l *logrusLogger) WithError(err error) interfaces.Logger {
 	return &logrusLogger{
-		logger: l.logger.WithError(err),
+		logger: l.logger.WithError(mask.Error(err)),
 	}
 }
 
-// parseFields converts variadic fields to logrus.Fields.
+// parseFields converts variadic fields to logrus.Fields, masking secrets
+// such as webhook URLs and passwords.
 func (l *logrusLogger) parseFields(fields ...interface{}) logrus.Fields {
 	result := make(logrus.Fields)
 
 	// Process pairs of key-value.
 	for i := 0; i < len(fields)-1; i += 2 {
 		if key, ok := fields[i].(string); ok {
-			result[key] = fields[i+1]
+			result[key] = mask.Field(key, fields[i+1])
 		}
 	}
 
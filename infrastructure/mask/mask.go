@@ -0,0 +1,105 @@
+// Package mask hides secrets such as webhook URLs, tokens, and database
+// passwords before they are logged or printed.
+package mask
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Masked replaces a hidden value.
+const Masked = "***"
+
+var (
+	// urlPattern matches URLs embedded in free text such as error messages.
+	urlPattern = regexp.MustCompile(`[a-zA-Z][a-zA-Z0-9+.-]*://[^\s"'<>]+`)
+
+	// secretParamPattern matches secret key=value pairs of DSNs and queries.
+	secretParamPattern = regexp.MustCompile(
+		`(?i)\b(password|passwd|pwd|token|secret|routing_key|api_key|apikey|sslkey)=('[^']*'|[^\s&]+)`)
+
+	// sensitiveKeys are log field key fragments whose values are always masked.
+	sensitiveKeys = []string{"password", "secret", "token", "routing_key", "api_key", "apikey"}
+)
+
+// URL masks a URL down to its scheme and host, so a secret in the path, query,
+// or user info is not shown: https://hooks.slack.com/...***. A URL with only a
+// host is returned unchanged.
+func URL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return Masked
+	}
+
+	var b strings.Builder
+	b.WriteString(u.Scheme)
+	b.WriteString("://")
+	if u.User != nil {
+		b.WriteString(u.User.Username())
+		if _, ok := u.User.Password(); ok {
+			b.WriteString(":" + Masked)
+		}
+		b.WriteString("@")
+	}
+	b.WriteString(u.Host)
+	if strings.Trim(u.Path, "/") != "" || u.RawQuery != "" || u.Fragment != "" {
+		b.WriteString("/..." + Masked)
+	}
+	return b.String()
+}
+
+// DSN masks the password of a key=value or URL database connection string.
+func DSN(dsn string) string {
+	return Text(dsn)
+}
+
+// Text masks every URL and secret key=value pair in free text.
+func Text(s string) string {
+	s = urlPattern.ReplaceAllStringFunc(s, URL)
+	return secretParamPattern.ReplaceAllString(s, "${1}="+Masked)
+}
+
+// Field masks a log field value. Values of sensitive keys are hidden
+// entirely; other strings and errors have their URLs and secrets masked.
+func Field(key string, value interface{}) interface{} {
+	lower := strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(lower, sensitive) {
+			return Masked
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return Text(v)
+	case error:
+		return Text(v.Error())
+	default:
+		return value
+	}
+}
+
+// Error wraps err so its message has URLs and secrets masked. The original
+// error stays reachable through errors.Is and errors.As.
+func Error(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &maskedError{err: err}
+}
+
+// maskedError masks the message of the error it wraps.
+type maskedError struct {
+	err error
+}
+
+// Error returns the masked message.
+func (e *maskedError) Error() string {
+	return Text(e.err.Error())
+}
+
+// Unwrap returns the original error.
+func (e *maskedError) Unwrap() error {
+	return e.err
+}
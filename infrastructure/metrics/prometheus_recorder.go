@@ -0,0 +1,258 @@
+// Package metrics provides Prometheus metrics for the OCR checker application.
+// It contains the MetricsRecorder implementation and the HTTP handler that exposes it.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "ocr_checker"
+
+// DefaultNotifierDurationBuckets are the notifier_duration_seconds buckets in
+// seconds, spanning a fast webhook to the notifiers' 10s client timeout.
+var DefaultNotifierDurationBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// PrometheusRecorder implements the MetricsRecorder interface with Prometheus collectors.
+type PrometheusRecorder struct {
+	registry *prometheus.Registry
+
+	checksTotal          *prometheus.CounterVec
+	checkPanics          *prometheus.CounterVec
+	lastCheckTimestamp   *prometheus.GaugeVec
+	jobs                 *prometheus.GaugeVec
+	observerTransmission *prometheus.CounterVec
+	distinctConfigs      *prometheus.GaugeVec
+	overallStatus        prometheus.Gauge
+	notifierDuration     *prometheus.HistogramVec
+	notifierErrors       *prometheus.CounterVec
+
+	// mu guards the last-known values kept for snapshots and the overall rollup.
+	mu             sync.Mutex
+	targetStatuses map[common.Address]entities.HealthStatus
+	lastChecks     map[common.Address]time.Time
+	jobCounts      map[common.Address]map[entities.JobStatus]int
+	observerCounts map[common.Address]map[uint8]int
+	configCounts   map[common.Address]int
+}
+
+// NewPrometheusRecorder creates a new Prometheus metrics recorder with its own registry.
+func NewPrometheusRecorder() *PrometheusRecorder {
+	return NewPrometheusRecorderWithBuckets(DefaultNotifierDurationBuckets)
+}
+
+// NewPrometheusRecorderWithBuckets creates a recorder like NewPrometheusRecorder
+// whose notifier_duration_seconds histogram uses the given buckets in seconds.
+// Empty buckets fall back to DefaultNotifierDurationBuckets.
+func NewPrometheusRecorderWithBuckets(notifierBuckets []float64) *PrometheusRecorder {
+	if len(notifierBuckets) == 0 {
+		notifierBuckets = DefaultNotifierDurationBuckets
+	}
+
+	r := &PrometheusRecorder{
+		registry: prometheus.NewRegistry(),
+		checksTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "checks_total",
+			Help:      "Number of watch checks run, by outcome.",
+		}, []string{"transmitter", "outcome"}),
+		checkPanics: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "check_panics_total",
+			Help:      "Number of watch checks that panicked and were recovered.",
+		}, []string{"transmitter"}),
+		lastCheckTimestamp: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "last_check_timestamp_seconds",
+			Help:      "Unix time of the last completed watch check.",
+		}, []string{"transmitter"}),
+		jobs: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "jobs",
+			Help:      "Number of jobs by status in the last watch check.",
+		}, []string{"transmitter", "status"}),
+		observerTransmission: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "observer_transmissions_total",
+			Help: "Transmissions per observer index in the last check window. " +
+				"Reset every check so only observers seen in the current window are exported.",
+		}, []string{"contract", "observer"}),
+		distinctConfigs: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "distinct_configs",
+			Help: "Distinct config digests among the transmissions of the last check window. " +
+				"More than one means the contract was reconfigured within the window.",
+		}, []string{"contract"}),
+		overallStatus: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "overall_status",
+			Help:      "Worst status across all checked targets (0 = OK, 1 = WARNING, 2 = CRITICAL).",
+		}),
+		notifierDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "notifier_duration_seconds",
+			Help:      "Time taken to send a notification, by notifier backend, including failed sends.",
+			Buckets:   notifierBuckets,
+		}, []string{"backend"}),
+		notifierErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "notifier_errors_total",
+			Help:      "Number of notification sends that failed, by notifier backend.",
+		}, []string{"backend"}),
+		targetStatuses: make(map[common.Address]entities.HealthStatus),
+		lastChecks:     make(map[common.Address]time.Time),
+		jobCounts:      make(map[common.Address]map[entities.JobStatus]int),
+		observerCounts: make(map[common.Address]map[uint8]int),
+		configCounts:   make(map[common.Address]int),
+	}
+
+	r.registry.MustRegister(
+		r.checksTotal,
+		r.checkPanics,
+		r.lastCheckTimestamp,
+		r.jobs,
+		r.observerTransmission,
+		r.distinctConfigs,
+		r.overallStatus,
+		r.notifierDuration,
+		r.notifierErrors,
+	)
+
+	return r
+}
+
+// Handler returns the HTTP handler that serves the metrics.
+func (r *PrometheusRecorder) Handler() http.Handler {
+	return promhttp.HandlerFor(r.registry, promhttp.HandlerOpts{})
+}
+
+// Registry returns the underlying Prometheus registry.
+func (r *PrometheusRecorder) Registry() *prometheus.Registry {
+	return r.registry
+}
+
+// RecordWatchResult records job statuses and observer participation for a check.
+func (r *PrometheusRecorder) RecordWatchResult(
+	transmitter common.Address,
+	result *interfaces.WatchTransmittersResult,
+) {
+	r.checksTotal.WithLabelValues(transmitter.Hex(), "success").Inc()
+
+	summary := result.Summary
+	jobs := map[entities.JobStatus]int{
+		entities.JobStatusFound:    summary.FoundJobs,
+		entities.JobStatusStale:    summary.StaleJobs,
+		entities.JobStatusMissing:  summary.MissingJobs,
+		entities.JobStatusNoActive: summary.NoActiveJobs,
+		entities.JobStatusError:    summary.ErrorJobs,
+	}
+
+	// Jobs on the same contract share the fetched window, so count each contract once.
+	observers := make(map[common.Address]map[uint8]int)
+	configs := make(map[common.Address]int)
+	for _, status := range result.Statuses {
+		if status.ConfigDigests > 0 {
+			configs[status.ContractAddress] = status.ConfigDigests
+		}
+		if len(status.ObserverCounts) == 0 || observers[status.ContractAddress] != nil {
+			continue
+		}
+		observers[status.ContractAddress] = status.ObserverCounts
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.setLastCheck(transmitter, time.Now(), jobs)
+	r.setObserverCounts(observers)
+	r.setConfigCounts(configs)
+	r.setTargetStatus(transmitter, summary.HealthStatus())
+}
+
+// setLastCheck exports the time and job counts of a target's last check.
+func (r *PrometheusRecorder) setLastCheck(
+	transmitter common.Address,
+	at time.Time,
+	jobs map[entities.JobStatus]int,
+) {
+	transmitterLabel := transmitter.Hex()
+	r.lastChecks[transmitter] = at
+	r.jobCounts[transmitter] = jobs
+
+	r.lastCheckTimestamp.WithLabelValues(transmitterLabel).Set(float64(at.Unix()))
+	for status, count := range jobs {
+		r.jobs.WithLabelValues(transmitterLabel, string(status)).Set(float64(count))
+	}
+}
+
+// setObserverCounts exports the per-observer transmissions of the last check window.
+func (r *PrometheusRecorder) setObserverCounts(observers map[common.Address]map[uint8]int) {
+	// Reset so observers that dropped out of the window stop being exported.
+	r.observerTransmission.Reset()
+	r.observerCounts = observers
+
+	for contract, counts := range observers {
+		contractLabel := contract.Hex()
+		for observer, count := range counts {
+			r.observerTransmission.
+				WithLabelValues(contractLabel, strconv.Itoa(int(observer))).
+				Add(float64(count))
+		}
+	}
+}
+
+// setConfigCounts exports the distinct configs per contract of the last check window.
+func (r *PrometheusRecorder) setConfigCounts(configs map[common.Address]int) {
+	// Reset so contracts that dropped out of the window stop being exported.
+	r.distinctConfigs.Reset()
+	r.configCounts = configs
+
+	for contract, count := range configs {
+		r.distinctConfigs.WithLabelValues(contract.Hex()).Set(float64(count))
+	}
+}
+
+// RecordCheckError records a check that failed before producing a result.
+// A failed check counts as critical for the target.
+func (r *PrometheusRecorder) RecordCheckError(transmitter common.Address) {
+	r.checksTotal.WithLabelValues(transmitter.Hex(), "error").Inc()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.setTargetStatus(transmitter, entities.HealthStatusCritical)
+}
+
+// RecordCheckPanic records a check that panicked and was recovered. The check
+// is recorded as an error separately.
+func (r *PrometheusRecorder) RecordCheckPanic(transmitter common.Address) {
+	r.checkPanics.WithLabelValues(transmitter.Hex()).Inc()
+}
+
+// RecordNotifierSend records the duration of a notification send and counts it
+// as an error when it failed.
+func (r *PrometheusRecorder) RecordNotifierSend(backend string, duration time.Duration, err error) {
+	r.notifierDuration.WithLabelValues(backend).Observe(duration.Seconds())
+	if err != nil {
+		r.notifierErrors.WithLabelValues(backend).Inc()
+	}
+}
+
+// setTargetStatus stores the latest status of a target and updates the overall rollup.
+// The caller must hold mu.
+func (r *PrometheusRecorder) setTargetStatus(transmitter common.Address, status entities.HealthStatus) {
+	r.targetStatuses[transmitter] = status
+
+	statuses := make([]entities.HealthStatus, 0, len(r.targetStatuses))
+	for _, s := range r.targetStatuses {
+		statuses = append(statuses, s)
+	}
+	r.overallStatus.Set(float64(entities.WorstHealthStatus(statuses...)))
+}
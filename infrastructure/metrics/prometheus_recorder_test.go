@@ -0,0 +1,159 @@
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/helpers"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrometheusRecorder_ObserverTransmissions(t *testing.T) {
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000000")
+	contractA := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contractB := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	recorder := NewPrometheusRecorder()
+
+	recorder.RecordWatchResult(transmitter, &interfaces.WatchTransmittersResult{
+		Statuses: []entities.TransmitterStatus{
+			{
+				ContractAddress: contractA,
+				Status:          entities.JobStatusFound,
+				ObserverCounts:  map[uint8]int{0: 5, 3: 2},
+			},
+			{
+				// Second job on the same contract must not double count.
+				ContractAddress: contractA,
+				Status:          entities.JobStatusFound,
+				ObserverCounts:  map[uint8]int{0: 5, 3: 2},
+			},
+			{
+				ContractAddress: contractB,
+				Status:          entities.JobStatusStale,
+				ObserverCounts:  map[uint8]int{1: 4},
+			},
+		},
+		Summary: interfaces.TransmitterSummary{TotalJobs: 3, FoundJobs: 2, StaleJobs: 1},
+	})
+
+	observers := recorder.observerTransmission
+	assert.Equal(t, 3, testutil.CollectAndCount(observers))
+	assert.Equal(t, 5.0, testutil.ToFloat64(observers.WithLabelValues(contractA.Hex(), "0")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(observers.WithLabelValues(contractA.Hex(), "3")))
+	assert.Equal(t, 4.0, testutil.ToFloat64(observers.WithLabelValues(contractB.Hex(), "1")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(recorder.jobs.WithLabelValues(transmitter.Hex(), "Found")))
+
+	// The next window only exports the observers it saw.
+	recorder.RecordWatchResult(transmitter, &interfaces.WatchTransmittersResult{
+		Statuses: []entities.TransmitterStatus{
+			{
+				ContractAddress: contractA,
+				Status:          entities.JobStatusFound,
+				ObserverCounts:  map[uint8]int{3: 1},
+			},
+		},
+		Summary: interfaces.TransmitterSummary{TotalJobs: 1, FoundJobs: 1},
+	})
+
+	assert.Equal(t, 1, testutil.CollectAndCount(observers))
+	assert.Equal(t, 1.0, testutil.ToFloat64(observers.WithLabelValues(contractA.Hex(), "3")))
+}
+
+func TestPrometheusRecorder_DistinctConfigs(t *testing.T) {
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000000")
+	contractA := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contractB := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	recorder := NewPrometheusRecorder()
+
+	// Transmissions on contract A spanned two config digests.
+	recorder.RecordWatchResult(transmitter, &interfaces.WatchTransmittersResult{
+		Statuses: []entities.TransmitterStatus{
+			{ContractAddress: contractA, Status: entities.JobStatusFound, ConfigDigests: 2},
+			{ContractAddress: contractB, Status: entities.JobStatusFound, ConfigDigests: 1},
+			{ContractAddress: helpers.RandomAddress(), Status: entities.JobStatusNoActive},
+		},
+	})
+
+	configs := recorder.distinctConfigs
+	assert.Equal(t, 2, testutil.CollectAndCount(configs))
+	assert.Equal(t, 2.0, testutil.ToFloat64(configs.WithLabelValues(contractA.Hex())))
+	assert.Equal(t, 1.0, testutil.ToFloat64(configs.WithLabelValues(contractB.Hex())))
+
+	// Snapshots carry the gauge across a restart.
+	restored := NewPrometheusRecorder()
+	restored.Restore(recorder.Snapshot())
+	assert.Equal(t, 2.0, testutil.ToFloat64(restored.distinctConfigs.WithLabelValues(contractA.Hex())))
+}
+
+func TestPrometheusRecorder_Checks(t *testing.T) {
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000000")
+	recorder := NewPrometheusRecorder()
+
+	recorder.RecordWatchResult(transmitter, &interfaces.WatchTransmittersResult{})
+	recorder.RecordCheckError(transmitter)
+	recorder.RecordCheckError(transmitter)
+	recorder.RecordCheckPanic(transmitter)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(recorder.checksTotal.WithLabelValues(transmitter.Hex(), "success")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(recorder.checksTotal.WithLabelValues(transmitter.Hex(), "error")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(recorder.checkPanics.WithLabelValues(transmitter.Hex())))
+}
+
+func TestPrometheusRecorder_OverallStatus(t *testing.T) {
+	transmitterA := common.HexToAddress("0xa000000000000000000000000000000000000000")
+	transmitterB := common.HexToAddress("0xb000000000000000000000000000000000000000")
+	recorder := NewPrometheusRecorder()
+
+	assert.Equal(t, 0.0, testutil.ToFloat64(recorder.overallStatus))
+
+	recorder.RecordWatchResult(transmitterA, &interfaces.WatchTransmittersResult{
+		Summary: interfaces.TransmitterSummary{TotalJobs: 2, FoundJobs: 1, StaleJobs: 1},
+	})
+	assert.Equal(t, float64(entities.HealthStatusWarning), testutil.ToFloat64(recorder.overallStatus))
+
+	recorder.RecordCheckError(transmitterB)
+	assert.Equal(t, float64(entities.HealthStatusCritical), testutil.ToFloat64(recorder.overallStatus))
+
+	// Recovery of the failing target drops back to the remaining worst status.
+	recorder.RecordWatchResult(transmitterB, &interfaces.WatchTransmittersResult{
+		Summary: interfaces.TransmitterSummary{TotalJobs: 1, FoundJobs: 1},
+	})
+	assert.Equal(t, float64(entities.HealthStatusWarning), testutil.ToFloat64(recorder.overallStatus))
+}
+
+func TestPrometheusRecorder_NotifierSends(t *testing.T) {
+	recorder := NewPrometheusRecorderWithBuckets([]float64{0.5, 1})
+
+	recorder.RecordNotifierSend("slack", 200*time.Millisecond, nil)
+	recorder.RecordNotifierSend("slack", 3*time.Second, errors.New("webhook returned 500"))
+	recorder.RecordNotifierSend("pagerduty", 100*time.Millisecond, nil)
+
+	assert.Equal(t, 2, testutil.CollectAndCount(recorder.notifierDuration))
+	assert.Equal(t, 1.0, testutil.ToFloat64(recorder.notifierErrors.WithLabelValues("slack")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(recorder.notifierErrors.WithLabelValues("pagerduty")))
+
+	expected := `
+# HELP ocr_checker_notifier_duration_seconds Time taken to send a notification, by notifier backend, including failed sends.
+# TYPE ocr_checker_notifier_duration_seconds histogram
+ocr_checker_notifier_duration_seconds_bucket{backend="pagerduty",le="0.5"} 1
+ocr_checker_notifier_duration_seconds_bucket{backend="pagerduty",le="1"} 1
+ocr_checker_notifier_duration_seconds_bucket{backend="pagerduty",le="+Inf"} 1
+ocr_checker_notifier_duration_seconds_sum{backend="pagerduty"} 0.1
+ocr_checker_notifier_duration_seconds_count{backend="pagerduty"} 1
+ocr_checker_notifier_duration_seconds_bucket{backend="slack",le="0.5"} 1
+ocr_checker_notifier_duration_seconds_bucket{backend="slack",le="1"} 1
+ocr_checker_notifier_duration_seconds_bucket{backend="slack",le="+Inf"} 2
+ocr_checker_notifier_duration_seconds_sum{backend="slack"} 3.2
+ocr_checker_notifier_duration_seconds_count{backend="slack"} 2
+`
+	assert.NoError(t, testutil.CollectAndCompare(recorder.notifierDuration, strings.NewReader(expected),
+		"ocr_checker_notifier_duration_seconds"))
+}
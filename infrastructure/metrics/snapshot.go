@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Snapshot holds the last-known gauge values of a recorder so a restarted
+// monitor can export them until its first check completes. Counters are not
+// included; Prometheus handles their reset on restart.
+type Snapshot struct {
+	SavedAt   time.Time                        `json:"saved_at"`
+	Targets   []TargetSnapshot                 `json:"targets"`
+	Observers map[common.Address]map[uint8]int `json:"observers,omitempty"`
+	Configs   map[common.Address]int           `json:"configs,omitempty"`
+}
+
+// TargetSnapshot is the last-known state of one monitored transmitter.
+type TargetSnapshot struct {
+	Transmitter common.Address             `json:"transmitter"`
+	Status      entities.HealthStatus      `json:"status"`
+	LastCheck   time.Time                  `json:"last_check"`
+	Jobs        map[entities.JobStatus]int `json:"jobs,omitempty"`
+}
+
+// Snapshot returns the recorder's last-known gauge values.
+func (r *PrometheusRecorder) Snapshot() Snapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	snapshot := Snapshot{
+		SavedAt:   time.Now().UTC(),
+		Targets:   make([]TargetSnapshot, 0, len(r.targetStatuses)),
+		Observers: r.observerCounts,
+		Configs:   r.configCounts,
+	}
+	for transmitter, status := range r.targetStatuses {
+		snapshot.Targets = append(snapshot.Targets, TargetSnapshot{
+			Transmitter: transmitter,
+			Status:      status,
+			LastCheck:   r.lastChecks[transmitter],
+			Jobs:        r.jobCounts[transmitter],
+		})
+	}
+
+	return snapshot
+}
+
+// Restore exports the values of a snapshot as if its checks had just been
+// recorded. The last check timestamp keeps the snapshot's value so dashboards
+// can tell the data is from before the restart.
+func (r *PrometheusRecorder) Restore(snapshot Snapshot) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, target := range snapshot.Targets {
+		if !target.LastCheck.IsZero() {
+			r.setLastCheck(target.Transmitter, target.LastCheck, target.Jobs)
+		}
+		r.setTargetStatus(target.Transmitter, target.Status)
+	}
+	if snapshot.Observers != nil {
+		r.setObserverCounts(snapshot.Observers)
+	}
+	if snapshot.Configs != nil {
+		r.setConfigCounts(snapshot.Configs)
+	}
+}
+
+// WriteSnapshot saves a snapshot as JSON, replacing the file only once it is fully written.
+func WriteSnapshot(path string, snapshot Snapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode metrics snapshot: %w", err)
+	}
+
+	cleanPath := filepath.Clean(path)
+	tmpPath := cleanPath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write metrics snapshot: %w", err)
+	}
+	if err := os.Rename(tmpPath, cleanPath); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to replace metrics snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// ReadSnapshot loads a snapshot written by WriteSnapshot.
+func ReadSnapshot(path string) (Snapshot, error) {
+	var snapshot Snapshot
+
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return snapshot, fmt.Errorf("failed to read metrics snapshot: %w", err)
+	}
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return snapshot, fmt.Errorf("failed to decode metrics snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"path/filepath"
+	"testing"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusRecorder_RestoreSnapshot(t *testing.T) {
+	transmitterA := common.HexToAddress("0xa000000000000000000000000000000000000000")
+	transmitterB := common.HexToAddress("0xb000000000000000000000000000000000000000")
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	before := NewPrometheusRecorder()
+	before.RecordWatchResult(transmitterA, &interfaces.WatchTransmittersResult{
+		Statuses: []entities.TransmitterStatus{
+			{ContractAddress: contract, Status: entities.JobStatusFound, ObserverCounts: map[uint8]int{2: 7}},
+		},
+		Summary: interfaces.TransmitterSummary{TotalJobs: 3, FoundJobs: 2, StaleJobs: 1},
+	})
+	before.RecordCheckError(transmitterB)
+
+	path := filepath.Join(t.TempDir(), "monitor-state.json")
+	require.NoError(t, WriteSnapshot(path, before.Snapshot()))
+
+	// A restarted monitor exports the saved values before its first check.
+	snapshot, err := ReadSnapshot(path)
+	require.NoError(t, err)
+	after := NewPrometheusRecorder()
+	after.Restore(snapshot)
+
+	labelA := transmitterA.Hex()
+	assert.Equal(t, 2.0, testutil.ToFloat64(after.jobs.WithLabelValues(labelA, "Found")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(after.jobs.WithLabelValues(labelA, "Stale")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(after.jobs.WithLabelValues(labelA, "Missing")))
+	assert.Equal(t,
+		testutil.ToFloat64(before.lastCheckTimestamp.WithLabelValues(labelA)),
+		testutil.ToFloat64(after.lastCheckTimestamp.WithLabelValues(labelA)))
+	assert.Equal(t, 7.0, testutil.ToFloat64(after.observerTransmission.WithLabelValues(contract.Hex(), "2")))
+	assert.Equal(t, float64(entities.HealthStatusCritical), testutil.ToFloat64(after.overallStatus))
+
+	// A target that never completed a check gets no job or timestamp series.
+	assert.Equal(t, 5, testutil.CollectAndCount(after.jobs))
+	assert.Equal(t, 1, testutil.CollectAndCount(after.lastCheckTimestamp))
+
+	// Fresh checks take over from the restored values.
+	after.RecordWatchResult(transmitterB, &interfaces.WatchTransmittersResult{
+		Summary: interfaces.TransmitterSummary{TotalJobs: 1, FoundJobs: 1},
+	})
+	assert.Equal(t, float64(entities.HealthStatusWarning), testutil.ToFloat64(after.overallStatus))
+}
+
+func TestReadSnapshot_Missing(t *testing.T) {
+	_, err := ReadSnapshot(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
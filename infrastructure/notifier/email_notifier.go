@@ -0,0 +1,290 @@
+// Package notifier provides notification backends for the OCR checker application.
+// It contains implementations of the domain Notifier interface.
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"html"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"chainlink-ocr-checker/domain/interfaces"
+)
+
+// SMTP security modes.
+const (
+	// SMTPSecurityNone sends mail over a plain connection.
+	SMTPSecurityNone = "none"
+	// SMTPSecuritySTARTTLS upgrades a plain connection with STARTTLS.
+	SMTPSecuritySTARTTLS = "starttls"
+	// SMTPSecurityTLS connects over implicit TLS.
+	SMTPSecurityTLS = "tls"
+)
+
+// EmailConfig represents SMTP settings for the email notifier.
+type EmailConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	To       []string
+	Security string
+
+	// MessageTemplate, when set, renders the opening text of the body in place
+	// of the title and summary. The subject and details list are unchanged.
+	MessageTemplate *template.Template
+
+	// Metrics, when set, records the duration and outcome of each SMTP delivery.
+	Metrics interfaces.NotifierMetrics
+}
+
+// mailSender delivers a fully formatted message.
+type mailSender interface {
+	Send(ctx context.Context, from string, to []string, msg []byte) error
+}
+
+// emailNotifier implements the Notifier interface over SMTP.
+type emailNotifier struct {
+	config EmailConfig
+	sender mailSender
+}
+
+// NewEmailNotifier creates a new SMTP email notifier.
+func NewEmailNotifier(config EmailConfig) (interfaces.Notifier, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &emailNotifier{
+		config: config,
+		sender: &smtpSender{config: config},
+	}, nil
+}
+
+// Name returns the name of the notifier backend.
+func (n *emailNotifier) Name() string {
+	return "email"
+}
+
+// Notify sends the notification as an HTML and plain-text email.
+func (n *emailNotifier) Notify(ctx context.Context, notification interfaces.Notification) error {
+	intro := ""
+	if n.config.MessageTemplate != nil {
+		var err error
+		if intro, err = renderMessage(n.config.MessageTemplate, notification); err != nil {
+			return err
+		}
+	}
+
+	msg, err := buildEmail(n.config.From, n.config.To, notification, intro)
+	if err != nil {
+		return fmt.Errorf("failed to build email: %w", err)
+	}
+
+	start := time.Now()
+	err = n.sender.Send(ctx, n.config.From, n.config.To, msg)
+	recordSend(n.config.Metrics, n.Name(), start, err)
+	if err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
+
+// validate validates the email configuration.
+func (c EmailConfig) validate() error {
+	if c.Host == "" {
+		return fmt.Errorf("smtp host is required")
+	}
+
+	if c.Port <= 0 {
+		return fmt.Errorf("smtp port must be positive")
+	}
+
+	if c.From == "" {
+		return fmt.Errorf("smtp from address is required")
+	}
+
+	if len(c.To) == 0 {
+		return fmt.Errorf("at least one email recipient is required")
+	}
+
+	switch c.Security {
+	case "", SMTPSecurityNone, SMTPSecuritySTARTTLS, SMTPSecurityTLS:
+	default:
+		return fmt.Errorf("invalid smtp security mode: %s (use none, starttls, or tls)", c.Security)
+	}
+
+	return nil
+}
+
+// buildEmail renders the notification as a multipart/alternative message.
+// A non-empty intro replaces the title and summary at the top of the body.
+func buildEmail(from string, to []string, notification interfaces.Notification, intro string) ([]byte, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	if err := writePart(writer, "text/plain", emailText(notification, intro)); err != nil {
+		return nil, err
+	}
+	if err := writePart(writer, "text/html", emailHTML(notification, intro)); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	var msg bytes.Buffer
+	_, _ = fmt.Fprintf(&msg, "From: %s\r\n", from)
+	_, _ = fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(to, ", "))
+	_, _ = fmt.Fprintf(&msg, "Subject: %s\r\n", sanitizeHeader(notification.Title))
+	_, _ = fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	_, _ = fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	_, _ = fmt.Fprintf(&msg, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", writer.Boundary())
+	msg.Write(body.Bytes())
+
+	return msg.Bytes(), nil
+}
+
+// writePart writes a quoted-printable encoded body part.
+func writePart(writer *multipart.Writer, contentType, content string) error {
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType+"; charset=UTF-8")
+	header.Set("Content-Transfer-Encoding", "quoted-printable")
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	qp := quotedprintable.NewWriter(part)
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return err
+	}
+
+	return qp.Close()
+}
+
+// emailText renders the plain-text body.
+func emailText(notification interfaces.Notification, intro string) string {
+	var b strings.Builder
+	if intro != "" {
+		b.WriteString(intro + "\n")
+	} else {
+		b.WriteString(notification.Title + "\n\n")
+		b.WriteString(notification.Summary + "\n")
+	}
+	if len(notification.Details) > 0 {
+		b.WriteString("\nDetails:\n")
+		for _, detail := range notification.Details {
+			b.WriteString("- " + detail + "\n")
+		}
+	}
+	return b.String()
+}
+
+// emailHTML renders the HTML body.
+func emailHTML(notification interfaces.Notification, intro string) string {
+	var b strings.Builder
+	b.WriteString("<html><body>")
+	if intro != "" {
+		b.WriteString(`<p style="white-space: pre-wrap">` + html.EscapeString(intro) + "</p>")
+	} else {
+		b.WriteString("<h2>" + html.EscapeString(notification.Title) + "</h2>")
+		b.WriteString("<p>" + html.EscapeString(notification.Summary) + "</p>")
+	}
+	if len(notification.Details) > 0 {
+		b.WriteString("<h3>Details</h3><ul>")
+		for _, detail := range notification.Details {
+			b.WriteString("<li>" + html.EscapeString(detail) + "</li>")
+		}
+		b.WriteString("</ul>")
+	}
+	b.WriteString("</body></html>")
+	return b.String()
+}
+
+// sanitizeHeader strips line breaks so values can't inject extra headers.
+func sanitizeHeader(value string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+}
+
+// smtpSender delivers messages using net/smtp.
+type smtpSender struct {
+	config EmailConfig
+}
+
+// Send delivers a message, honoring the configured security mode.
+func (s *smtpSender) Send(ctx context.Context, from string, to []string, msg []byte) error {
+	addr := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+	tlsConfig := &tls.Config{ServerName: s.config.Host, MinVersion: tls.VersionTLS12}
+	dialer := &net.Dialer{}
+
+	var conn net.Conn
+	var err error
+	if s.config.Security == SMTPSecurityTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	if s.config.Security == SMTPSecuritySTARTTLS {
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+
+	if s.config.Username != "" {
+		auth := smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return fmt.Errorf("failed to add recipient %s: %w", recipient, err)
+		}
+	}
+
+	data, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := data.Write(msg); err != nil {
+		_ = data.Close()
+		return err
+	}
+	if err := data.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,133 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSender struct {
+	from string
+	to   []string
+	msg  string
+	err  error
+}
+
+func (s *fakeSender) Send(_ context.Context, from string, to []string, msg []byte) error {
+	s.from = from
+	s.to = to
+	s.msg = string(msg)
+	return s.err
+}
+
+func newTestEmailNotifier(t *testing.T, sender *fakeSender) interfaces.Notifier {
+	n, err := NewEmailNotifier(EmailConfig{
+		Host:     "smtp.example.com",
+		Port:     587,
+		From:     "alerts@example.com",
+		To:       []string{"ops@example.com", "oncall@example.com"},
+		Security: SMTPSecuritySTARTTLS,
+	})
+	require.NoError(t, err)
+	n.(*emailNotifier).sender = sender
+	return n
+}
+
+func TestEmailNotifier_Notify(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("critical alert", func(t *testing.T) {
+		sender := &fakeSender{}
+		n := newTestEmailNotifier(t, sender)
+
+		err := n.Notify(ctx, interfaces.Notification{
+			Title:    "OCR Checker: CRITICAL for 0xabc",
+			Severity: interfaces.NotificationSeverityCritical,
+			Summary:  "Total: 2, Missing: 1",
+			Details:  []string{"[Missing] job <job-1>"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "alerts@example.com", sender.from)
+		assert.Equal(t, []string{"ops@example.com", "oncall@example.com"}, sender.to)
+		assert.Contains(t, sender.msg, "To: ops@example.com, oncall@example.com\r\n")
+		assert.Contains(t, sender.msg, "Subject: OCR Checker: CRITICAL for 0xabc\r\n")
+		assert.Contains(t, sender.msg, "multipart/alternative")
+		assert.Contains(t, sender.msg, "text/plain")
+		assert.Contains(t, sender.msg, "text/html")
+		assert.Contains(t, sender.msg, "&lt;job-1&gt;")
+	})
+
+	t.Run("healthy summary", func(t *testing.T) {
+		sender := &fakeSender{}
+		n := newTestEmailNotifier(t, sender)
+
+		err := n.Notify(ctx, interfaces.Notification{
+			Title:    "OCR Checker: OK for 0xabc",
+			Severity: interfaces.NotificationSeverityInfo,
+		})
+		require.NoError(t, err)
+		assert.Contains(t, sender.msg, "Subject: OCR Checker: OK for 0xabc\r\n")
+	})
+
+	t.Run("message template", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "email.tmpl")
+		require.NoError(t, os.WriteFile(path, []byte("{{.Summary}} <see runbook>"), 0600))
+		tmpl, err := LoadMessageTemplate(path)
+		require.NoError(t, err)
+
+		sender := &fakeSender{}
+		n := newTestEmailNotifier(t, sender)
+		n.(*emailNotifier).config.MessageTemplate = tmpl
+
+		err = n.Notify(ctx, interfaces.Notification{
+			Title:    "OCR Checker: WARNING for 0xabc",
+			Severity: interfaces.NotificationSeverityWarning,
+			Summary:  "Total: 2, Stale: 1",
+		})
+		require.NoError(t, err)
+		assert.Contains(t, sender.msg, "Subject: OCR Checker: WARNING for 0xabc\r\n")
+		assert.Contains(t, sender.msg, "Total: 2, Stale: 1 <see runbook>")
+		assert.Contains(t, sender.msg, "Total: 2, Stale: 1 &lt;see")
+		assert.NotContains(t, sender.msg, "<h2>")
+	})
+
+	t.Run("send error", func(t *testing.T) {
+		sender := &fakeSender{err: errors.New("connection refused")}
+		n := newTestEmailNotifier(t, sender)
+
+		err := n.Notify(ctx, interfaces.Notification{Title: "title"})
+		assert.ErrorContains(t, err, "connection refused")
+	})
+}
+
+func TestLoadMessageTemplate_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bad.tmpl")
+	require.NoError(t, os.WriteFile(path, []byte("{{.Title"), 0600))
+
+	_, err := LoadMessageTemplate(path)
+	assert.ErrorContains(t, err, "failed to load message template")
+
+	_, err = LoadMessageTemplate(filepath.Join(t.TempDir(), "missing.tmpl"))
+	assert.ErrorContains(t, err, "failed to load message template")
+}
+
+func TestNewEmailNotifier_Validation(t *testing.T) {
+	_, err := NewEmailNotifier(EmailConfig{Host: "smtp.example.com", Port: 25, From: "a@example.com"})
+	assert.ErrorContains(t, err, "recipient")
+
+	_, err = NewEmailNotifier(EmailConfig{
+		Host:     "smtp.example.com",
+		Port:     25,
+		From:     "a@example.com",
+		To:       []string{"b@example.com"},
+		Security: "ssl",
+	})
+	assert.ErrorContains(t, err, "invalid smtp security mode")
+}
@@ -0,0 +1,35 @@
+// Package notifier provides notification backends for the OCR checker application.
+// It contains implementations of the domain Notifier interface.
+package notifier
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"chainlink-ocr-checker/domain/interfaces"
+)
+
+// LoadMessageTemplate parses a message template file. The template is executed
+// with the interfaces.Notification being sent, e.g.
+//
+//	{{.Title}} ({{.Severity}}): {{.Summary}}
+//	Runbook: https://wiki.example.com/ocr
+func LoadMessageTemplate(path string) (*template.Template, error) {
+	cleanPath := filepath.Clean(path)
+	tmpl, err := template.New(filepath.Base(cleanPath)).Option("missingkey=error").ParseFiles(cleanPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load message template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// renderMessage executes a message template for a notification.
+func renderMessage(tmpl *template.Template, notification interfaces.Notification) (string, error) {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, notification); err != nil {
+		return "", fmt.Errorf("failed to render message template: %w", err)
+	}
+	return strings.TrimSpace(b.String()), nil
+}
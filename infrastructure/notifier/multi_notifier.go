@@ -0,0 +1,82 @@
+// Package notifier provides notification backends for the OCR checker application.
+// It contains implementations of the domain Notifier interface.
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"chainlink-ocr-checker/domain/interfaces"
+)
+
+// SeverityRoutes maps a severity to the names of the notifiers that receive it.
+type SeverityRoutes map[interfaces.NotificationSeverity][]string
+
+// multiNotifier implements the Notifier interface by dispatching to several notifiers.
+type multiNotifier struct {
+	notifiers []interfaces.Notifier
+	routes    SeverityRoutes
+}
+
+// NewMultiNotifier creates a notifier that dispatches each notification by severity.
+// Without routes every notification goes to every notifier; with routes a severity
+// only reaches the notifiers listed for it. Routed names must match a notifier.
+func NewMultiNotifier(notifiers []interfaces.Notifier, routes SeverityRoutes) (interfaces.Notifier, error) {
+	known := make(map[string]bool, len(notifiers))
+	for _, n := range notifiers {
+		known[n.Name()] = true
+	}
+
+	for severity, names := range routes {
+		for _, name := range names {
+			if !known[name] {
+				return nil, fmt.Errorf("route for %s severity references unconfigured notifier %q", severity, name)
+			}
+		}
+	}
+
+	return &multiNotifier{
+		notifiers: notifiers,
+		routes:    routes,
+	}, nil
+}
+
+// Name returns the name of the notifier backend.
+func (n *multiNotifier) Name() string {
+	return "multi"
+}
+
+// Notify sends the notification to every notifier routed for its severity.
+// All targets are attempted; their errors are joined.
+func (n *multiNotifier) Notify(ctx context.Context, notification interfaces.Notification) error {
+	var errs []error
+	for _, target := range n.targets(notification.Severity) {
+		if err := target.Notify(ctx, notification); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", target.Name(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// targets returns the notifiers that receive a severity, in configuration order.
+func (n *multiNotifier) targets(severity interfaces.NotificationSeverity) []interfaces.Notifier {
+	if len(n.routes) == 0 {
+		return n.notifiers
+	}
+
+	routed := make(map[string]bool)
+	for _, name := range n.routes[severity] {
+		routed[name] = true
+	}
+
+	var targets []interfaces.Notifier
+	for _, target := range n.notifiers {
+		if routed[target.Name()] {
+			targets = append(targets, target)
+		}
+	}
+
+	return targets
+}
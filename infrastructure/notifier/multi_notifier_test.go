@@ -0,0 +1,82 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiNotifier_RoutesBySeverity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	newNotifier := func(name string) *mocks.MockNotifier {
+		n := mocks.NewMockNotifier(ctrl)
+		n.EXPECT().Name().Return(name).AnyTimes()
+		return n
+	}
+	slack := newNotifier("slack")
+	pagerDuty := newNotifier("pagerduty")
+
+	multi, err := NewMultiNotifier([]interfaces.Notifier{slack, pagerDuty}, SeverityRoutes{
+		interfaces.NotificationSeverityCritical: {"slack", "pagerduty"},
+		interfaces.NotificationSeverityWarning:  {"slack"},
+	})
+	require.NoError(t, err)
+
+	t.Run("warning goes to slack only", func(t *testing.T) {
+		warning := interfaces.Notification{Title: "stale", Severity: interfaces.NotificationSeverityWarning}
+		slack.EXPECT().Notify(ctx, warning).Return(nil)
+
+		require.NoError(t, multi.Notify(ctx, warning))
+	})
+
+	t.Run("critical goes to slack and pagerduty", func(t *testing.T) {
+		critical := interfaces.Notification{Title: "missing", Severity: interfaces.NotificationSeverityCritical}
+		slack.EXPECT().Notify(ctx, critical).Return(nil)
+		pagerDuty.EXPECT().Notify(ctx, critical).Return(nil)
+
+		require.NoError(t, multi.Notify(ctx, critical))
+	})
+
+	t.Run("unrouted severity goes nowhere", func(t *testing.T) {
+		info := interfaces.Notification{Title: "recovered", Severity: interfaces.NotificationSeverityInfo}
+		require.NoError(t, multi.Notify(ctx, info))
+	})
+
+	t.Run("failures do not stop other targets", func(t *testing.T) {
+		critical := interfaces.Notification{Title: "missing", Severity: interfaces.NotificationSeverityCritical}
+		slack.EXPECT().Notify(ctx, critical).Return(errors.New("webhook down"))
+		pagerDuty.EXPECT().Notify(ctx, critical).Return(nil)
+
+		err := multi.Notify(ctx, critical)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "slack: webhook down")
+	})
+
+	t.Run("without routes every notifier receives every alert", func(t *testing.T) {
+		broadcast, err := NewMultiNotifier([]interfaces.Notifier{slack, pagerDuty}, nil)
+		require.NoError(t, err)
+
+		info := interfaces.Notification{Title: "recovered", Severity: interfaces.NotificationSeverityInfo}
+		slack.EXPECT().Notify(ctx, info).Return(nil)
+		pagerDuty.EXPECT().Notify(ctx, info).Return(nil)
+
+		require.NoError(t, broadcast.Notify(ctx, info))
+	})
+
+	t.Run("route to unconfigured notifier", func(t *testing.T) {
+		_, err := NewMultiNotifier([]interfaces.Notifier{slack}, SeverityRoutes{
+			interfaces.NotificationSeverityCritical: {"pagerduty"},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"pagerduty"`)
+	})
+}
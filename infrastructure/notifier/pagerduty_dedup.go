@@ -0,0 +1,114 @@
+// Package notifier provides notification backends for the OCR checker application.
+// It contains implementations of the domain Notifier interface.
+package notifier
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// pagerDutyDedupEntry is the last event sent for a dedup key.
+type pagerDutyDedupEntry struct {
+	EventAction string    `json:"event_action"`
+	Severity    string    `json:"severity"`
+	SentAt      time.Time `json:"sent_at"`
+}
+
+// pagerDutyDedup remembers the last event sent for each dedup key so a
+// repeated event, the same action at the same severity, is not sent again
+// until the key expires. With a state file the keys are kept across restarts.
+type pagerDutyDedup struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	stateFile string
+	now       func() time.Time
+	keys      map[string]pagerDutyDedupEntry
+}
+
+// newPagerDutyDedup creates a dedup tracker, loading the keys saved in
+// stateFile when it exists. An empty stateFile keeps keys in memory only.
+func newPagerDutyDedup(ttl time.Duration, stateFile string, now func() time.Time) (*pagerDutyDedup, error) {
+	d := &pagerDutyDedup{
+		ttl:       ttl,
+		stateFile: stateFile,
+		now:       now,
+		keys:      make(map[string]pagerDutyDedupEntry),
+	}
+	if stateFile == "" {
+		return d, nil
+	}
+
+	data, err := os.ReadFile(filepath.Clean(stateFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return d, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pagerduty dedup state: %w", err)
+	}
+	if err := json.Unmarshal(data, &d.keys); err != nil {
+		return nil, fmt.Errorf("failed to decode pagerduty dedup state: %w", err)
+	}
+
+	return d, nil
+}
+
+// sent reports whether the same event was sent for its dedup key within the TTL.
+func (d *pagerDutyDedup) sent(event pagerDutyEvent) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entry, ok := d.keys[event.DedupKey]
+	return ok &&
+		entry.EventAction == event.EventAction &&
+		entry.Severity == event.Payload.Severity &&
+		d.now().Sub(entry.SentAt) < d.ttl
+}
+
+// record stores the event sent for its dedup key, drops expired keys, and
+// saves the keys to the state file when one is set.
+func (d *pagerDutyDedup) record(event pagerDutyEvent) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	d.keys[event.DedupKey] = pagerDutyDedupEntry{
+		EventAction: event.EventAction,
+		Severity:    event.Payload.Severity,
+		SentAt:      now,
+	}
+	for k, entry := range d.keys {
+		if now.Sub(entry.SentAt) >= d.ttl {
+			delete(d.keys, k)
+		}
+	}
+
+	if d.stateFile == "" {
+		return nil
+	}
+	return d.save()
+}
+
+// save writes the keys as JSON, replacing the state file only once it is fully written.
+func (d *pagerDutyDedup) save() error {
+	data, err := json.MarshalIndent(d.keys, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode pagerduty dedup state: %w", err)
+	}
+
+	cleanPath := filepath.Clean(d.stateFile)
+	tmpPath := cleanPath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write pagerduty dedup state: %w", err)
+	}
+	if err := os.Rename(tmpPath, cleanPath); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to replace pagerduty dedup state: %w", err)
+	}
+
+	return nil
+}
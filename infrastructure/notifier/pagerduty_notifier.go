@@ -0,0 +1,213 @@
+// Package notifier provides notification backends for the OCR checker application.
+// It contains implementations of the domain Notifier interface.
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"chainlink-ocr-checker/domain/interfaces"
+)
+
+// DefaultPagerDutyEventsURL is the PagerDuty Events API v2 endpoint.
+const DefaultPagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// PagerDutyConfig represents settings for the PagerDuty notifier.
+type PagerDutyConfig struct {
+	RoutingKey string
+	EventsURL  string
+	Source     string
+
+	// Metrics, when set, records the duration and outcome of each event post.
+	Metrics interfaces.NotifierMetrics
+
+	// DedupTTL is how long a dedup key's last event is remembered. While it
+	// is, the same event for the key is not sent again, so an open incident
+	// is not re-triggered; a change of severity is still sent. Zero sends
+	// every event.
+	DedupTTL time.Duration
+
+	// DedupStateFile, when set, keeps the remembered dedup keys across restarts.
+	DedupStateFile string
+
+	// Logger, when set, reports failures to save the dedup state. They do not
+	// fail the send, since the event was already delivered.
+	Logger interfaces.Logger
+
+	// now returns the current time; tests replace it to expire keys.
+	now func() time.Time
+}
+
+// pagerDutyEvent is the payload posted to the Events API.
+type pagerDutyEvent struct {
+	RoutingKey  string           `json:"routing_key"`
+	EventAction string           `json:"event_action"`
+	DedupKey    string           `json:"dedup_key,omitempty"`
+	Payload     pagerDutyPayload `json:"payload"`
+}
+
+// pagerDutyPayload carries the alert fields of an event.
+type pagerDutyPayload struct {
+	Summary       string         `json:"summary"`
+	Source        string         `json:"source"`
+	Severity      string         `json:"severity"`
+	CustomDetails map[string]any `json:"custom_details,omitempty"`
+}
+
+// pagerDutyNotifier implements the Notifier interface over the PagerDuty Events API v2.
+type pagerDutyNotifier struct {
+	config PagerDutyConfig
+	client *http.Client
+	dedup  *pagerDutyDedup
+}
+
+// NewPagerDutyNotifier creates a new PagerDuty notifier.
+// An empty events URL falls back to DefaultPagerDutyEventsURL.
+func NewPagerDutyNotifier(config PagerDutyConfig) (interfaces.Notifier, error) {
+	if config.RoutingKey == "" {
+		return nil, fmt.Errorf("pagerduty routing key is required")
+	}
+
+	if config.EventsURL == "" {
+		config.EventsURL = DefaultPagerDutyEventsURL
+	}
+
+	if config.Source == "" {
+		config.Source = "ocr-checker"
+	}
+
+	n := &pagerDutyNotifier{
+		config: config,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+
+	if config.DedupTTL > 0 {
+		if config.now == nil {
+			config.now = time.Now
+		}
+		dedup, err := newPagerDutyDedup(config.DedupTTL, config.DedupStateFile, config.now)
+		if err != nil {
+			return nil, err
+		}
+		n.dedup = dedup
+	}
+
+	return n, nil
+}
+
+// Name returns the name of the notifier backend.
+func (n *pagerDutyNotifier) Name() string {
+	return "pagerduty"
+}
+
+// Notify triggers a PagerDuty event for the notification. With a dedup TTL,
+// an event repeating the last one sent for its dedup key is skipped.
+func (n *pagerDutyNotifier) Notify(ctx context.Context, notification interfaces.Notification) error {
+	event := buildPagerDutyEvent(n.config, notification)
+
+	// Only grouped alerts are deduplicated: without a dedup key no recovery
+	// resolves the incident, so a skipped trigger would stay hidden until expiry.
+	dedup := n.dedup != nil && notification.DedupKey != ""
+	if dedup && n.dedup.sent(event) {
+		return nil
+	}
+
+	if err := n.post(ctx, event); err != nil {
+		return err
+	}
+
+	// The event is delivered; a failure to remember it must not make the
+	// caller send it again.
+	if dedup {
+		if err := n.dedup.record(event); err != nil && n.config.Logger != nil {
+			n.config.Logger.Warn("Failed to save pagerduty dedup state",
+				"dedupKey", event.DedupKey,
+				"error", err)
+		}
+	}
+	return nil
+}
+
+// post sends an event to the Events API.
+func (n *pagerDutyNotifier) post(ctx context.Context, event pagerDutyEvent) (err error) {
+	start := time.Now()
+	defer func() { recordSend(n.config.Metrics, n.Name(), start, err) }()
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode pagerduty event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.config.EventsURL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create pagerduty request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post pagerduty event: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// The Events API answers 202 Accepted for enqueued events.
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("pagerduty returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+// buildPagerDutyEvent renders the notification as an event. Events share the
+// notification's dedup key, or its title without one, so repeated alerts
+// update one incident. A recovery (info severity) with a dedup key resolves
+// the incident instead of triggering it.
+func buildPagerDutyEvent(config PagerDutyConfig, notification interfaces.Notification) pagerDutyEvent {
+	details := make(map[string]any, len(notification.Fields)+2)
+	for key, value := range notification.Fields {
+		details[key] = value
+	}
+	details["summary"] = notification.Summary
+	if len(notification.Details) > 0 {
+		details["details"] = notification.Details
+	}
+
+	dedupKey := notification.DedupKey
+	eventAction := "trigger"
+	if dedupKey == "" {
+		dedupKey = notification.Title
+	} else if notification.Severity == interfaces.NotificationSeverityInfo {
+		eventAction = "resolve"
+	}
+
+	return pagerDutyEvent{
+		RoutingKey:  config.RoutingKey,
+		EventAction: eventAction,
+		DedupKey:    dedupKey,
+		Payload: pagerDutyPayload{
+			Summary:       notification.Title,
+			Source:        config.Source,
+			Severity:      pagerDutySeverity(notification.Severity),
+			CustomDetails: details,
+		},
+	}
+}
+
+// pagerDutySeverity maps a severity to a PagerDuty event severity.
+func pagerDutySeverity(severity interfaces.NotificationSeverity) string {
+	switch severity {
+	case interfaces.NotificationSeverityCritical:
+		return "critical"
+	case interfaces.NotificationSeverityWarning:
+		return "warning"
+	default:
+		return "info"
+	}
+}
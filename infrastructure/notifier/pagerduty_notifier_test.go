@@ -0,0 +1,192 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPagerDutyNotifier_Notify(t *testing.T) {
+	ctx := context.Background()
+	notification := interfaces.Notification{
+		Title:    "OCR Checker: CRITICAL for 0xabc",
+		Severity: interfaces.NotificationSeverityCritical,
+		Summary:  "Total: 2, Missing: 1",
+		Details:  []string{"[Missing] job <job-1>"},
+	}
+
+	t.Run("triggers event", func(t *testing.T) {
+		var received pagerDutyEvent
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		n, err := NewPagerDutyNotifier(PagerDutyConfig{RoutingKey: "key", EventsURL: server.URL})
+		require.NoError(t, err)
+		assert.Equal(t, "pagerduty", n.Name())
+
+		require.NoError(t, n.Notify(ctx, notification))
+		assert.Equal(t, "key", received.RoutingKey)
+		assert.Equal(t, "trigger", received.EventAction)
+		assert.Equal(t, notification.Title, received.Payload.Summary)
+		assert.Equal(t, "critical", received.Payload.Severity)
+		assert.Equal(t, "ocr-checker", received.Payload.Source)
+		assert.Equal(t, notification.Summary, received.Payload.CustomDetails["summary"])
+	})
+
+	t.Run("groups by dedup key", func(t *testing.T) {
+		var received []pagerDutyEvent
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var event pagerDutyEvent
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+			received = append(received, event)
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		n, err := NewPagerDutyNotifier(PagerDutyConfig{RoutingKey: "key", EventsURL: server.URL})
+		require.NoError(t, err)
+
+		alert := notification
+		alert.DedupKey = "ocr-checker/137/0xabc"
+		alert.Fields = map[string]any{"transmitter": "0xabc", "failing_jobs": []string{"job-1"}}
+		require.NoError(t, n.Notify(ctx, alert))
+
+		// The recovery resolves the incident the alert opened.
+		recovery := interfaces.Notification{
+			Title:    "OCR Checker: OK for 0xabc",
+			Severity: interfaces.NotificationSeverityInfo,
+			DedupKey: alert.DedupKey,
+		}
+		require.NoError(t, n.Notify(ctx, recovery))
+
+		require.Len(t, received, 2)
+		assert.Equal(t, "trigger", received[0].EventAction)
+		assert.Equal(t, "ocr-checker/137/0xabc", received[0].DedupKey)
+		assert.Equal(t, "0xabc", received[0].Payload.CustomDetails["transmitter"])
+		assert.Equal(t, []any{"job-1"}, received[0].Payload.CustomDetails["failing_jobs"])
+		assert.Equal(t, notification.Summary, received[0].Payload.CustomDetails["summary"])
+		assert.Equal(t, "resolve", received[1].EventAction)
+		assert.Equal(t, received[0].DedupKey, received[1].DedupKey)
+	})
+
+	t.Run("dedup keys survive restart and expire", func(t *testing.T) {
+		var received []pagerDutyEvent
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var event pagerDutyEvent
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+			received = append(received, event)
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		config := PagerDutyConfig{
+			RoutingKey:     "key",
+			EventsURL:      server.URL,
+			DedupTTL:       time.Hour,
+			DedupStateFile: filepath.Join(t.TempDir(), "pagerduty.json"),
+			now:            func() time.Time { return now },
+		}
+		alert := notification
+		alert.DedupKey = "ocr-checker/137/0xabc"
+
+		n, err := NewPagerDutyNotifier(config)
+		require.NoError(t, err)
+		require.NoError(t, n.Notify(ctx, alert))
+		require.NoError(t, n.Notify(ctx, alert))
+		require.Len(t, received, 1)
+
+		// A restarted notifier does not re-trigger the open incident.
+		now = now.Add(30 * time.Minute)
+		n, err = NewPagerDutyNotifier(config)
+		require.NoError(t, err)
+		require.NoError(t, n.Notify(ctx, alert))
+		require.Len(t, received, 1)
+
+		// Once the key expires the alert is sent again.
+		now = now.Add(31 * time.Minute)
+		n, err = NewPagerDutyNotifier(config)
+		require.NoError(t, err)
+		require.NoError(t, n.Notify(ctx, alert))
+		require.Len(t, received, 2)
+
+		// An escalation is a new event for the key and is sent.
+		warning := alert
+		warning.Severity = interfaces.NotificationSeverityWarning
+		require.NoError(t, n.Notify(ctx, warning))
+		require.NoError(t, n.Notify(ctx, alert))
+		require.Len(t, received, 4)
+
+		// So is the recovery.
+		recovery := interfaces.Notification{
+			Title:    "OCR Checker: OK for 0xabc",
+			Severity: interfaces.NotificationSeverityInfo,
+			DedupKey: alert.DedupKey,
+		}
+		require.NoError(t, n.Notify(ctx, recovery))
+		require.Len(t, received, 5)
+		assert.Equal(t, "resolve", received[4].EventAction)
+	})
+
+	t.Run("dedup state save failure", func(t *testing.T) {
+		received := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			received++
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		ctrl := gomock.NewController(t)
+		logger := mocks.NewMockLogger(ctrl)
+		logger.EXPECT().Warn("Failed to save pagerduty dedup state", gomock.Any())
+
+		n, err := NewPagerDutyNotifier(PagerDutyConfig{
+			RoutingKey:     "key",
+			EventsURL:      server.URL,
+			DedupTTL:       time.Hour,
+			DedupStateFile: filepath.Join(t.TempDir(), "missing", "pagerduty.json"),
+			Logger:         logger,
+		})
+		require.NoError(t, err)
+
+		// The delivered event is not reported as failed, and stays deduplicated in memory.
+		alert := notification
+		alert.DedupKey = "ocr-checker/137/0xabc"
+		require.NoError(t, n.Notify(ctx, alert))
+		require.NoError(t, n.Notify(ctx, alert))
+		assert.Equal(t, 1, received)
+	})
+
+	t.Run("api error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, `{"status":"invalid event"}`, http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		n, err := NewPagerDutyNotifier(PagerDutyConfig{RoutingKey: "key", EventsURL: server.URL})
+		require.NoError(t, err)
+
+		err = n.Notify(ctx, notification)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid event")
+	})
+
+	t.Run("routing key required", func(t *testing.T) {
+		_, err := NewPagerDutyNotifier(PagerDutyConfig{})
+		assert.Error(t, err)
+	})
+}
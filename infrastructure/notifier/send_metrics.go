@@ -0,0 +1,17 @@
+// Package notifier provides notification backends for the OCR checker application.
+// It contains implementations of the domain Notifier interface.
+package notifier
+
+import (
+	"time"
+
+	"chainlink-ocr-checker/domain/interfaces"
+)
+
+// recordSend reports a send that started at start to metrics, when configured.
+func recordSend(metrics interfaces.NotifierMetrics, backend string, start time.Time, err error) {
+	if metrics == nil {
+		return
+	}
+	metrics.RecordNotifierSend(backend, time.Since(start), err)
+}
@@ -0,0 +1,71 @@
+package notifier
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/test/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifiers_RecordSendMetrics(t *testing.T) {
+	ctx := context.Background()
+	notification := interfaces.Notification{
+		Title:    "OCR Checker: CRITICAL for 0xabc",
+		Severity: interfaces.NotificationSeverityCritical,
+		Summary:  "Total: 2, Missing: 1",
+	}
+
+	t.Run("slack send", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		ctrl := gomock.NewController(t)
+		metrics := mocks.NewMockNotifierMetrics(ctrl)
+		metrics.EXPECT().RecordNotifierSend("slack", gomock.Any(), nil)
+
+		n, err := NewSlackNotifier(SlackConfig{WebhookURL: server.URL, Metrics: metrics})
+		require.NoError(t, err)
+		require.NoError(t, n.Notify(ctx, notification))
+	})
+
+	t.Run("pagerduty failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		ctrl := gomock.NewController(t)
+		metrics := mocks.NewMockNotifierMetrics(ctrl)
+		var recorded error
+		metrics.EXPECT().RecordNotifierSend("pagerduty", gomock.Any(), gomock.Any()).
+			Do(func(_ string, _ time.Duration, err error) { recorded = err })
+
+		n, err := NewPagerDutyNotifier(PagerDutyConfig{RoutingKey: "key", EventsURL: server.URL, Metrics: metrics})
+		require.NoError(t, err)
+
+		err = n.Notify(ctx, notification)
+		require.Error(t, err)
+		assert.Equal(t, err, recorded)
+	})
+
+	t.Run("email send", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		metrics := mocks.NewMockNotifierMetrics(ctrl)
+		metrics.EXPECT().RecordNotifierSend("email", gomock.Any(), nil)
+
+		n := &emailNotifier{
+			config: EmailConfig{From: "ocr@example.org", To: []string{"ops@example.org"}, Metrics: metrics},
+			sender: &fakeSender{},
+		}
+		require.NoError(t, n.Notify(ctx, notification))
+	})
+}
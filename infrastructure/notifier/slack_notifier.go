@@ -0,0 +1,350 @@
+// Package notifier provides notification backends for the OCR checker application.
+// It contains implementations of the domain Notifier interface.
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"chainlink-ocr-checker/infrastructure/mask"
+)
+
+// Default Slack sender identity and message limits.
+const (
+	// DefaultSlackUsername is the display name used when none is configured.
+	DefaultSlackUsername = "OCR Monitor"
+	// DefaultSlackIconEmoji is the avatar emoji used when none is configured.
+	DefaultSlackIconEmoji = ":robot_face:"
+	// DefaultSlackMaxTextLength is Slack's text limit for a single attachment.
+	DefaultSlackMaxTextLength = 3000
+)
+
+// Strategies for job details that do not fit in one attachment.
+const (
+	// SlackTruncationTruncate keeps one attachment and ends it with a "+N more" line.
+	SlackTruncationTruncate = "truncate"
+	// SlackTruncationSplit spreads the details over several attachments.
+	SlackTruncationSplit = "split"
+	// SlackTruncationPaginate posts a summary message followed by detail pages.
+	SlackTruncationPaginate = "paginate"
+)
+
+// maxSlackAttachments caps the attachments of a split message, as Slack recommends.
+const maxSlackAttachments = 20
+
+// maxSlackPages caps the detail pages posted for one notification.
+const maxSlackPages = 50
+
+// slackPageDelay spaces paginated posts to stay within the webhook rate limit
+// of about one message per second.
+const slackPageDelay = time.Second
+
+// SlackConfig represents settings for the Slack webhook notifier.
+type SlackConfig struct {
+	WebhookURL string
+	Username   string
+	IconEmoji  string
+
+	// MaxTextLength is the maximum length of an attachment text in bytes.
+	MaxTextLength int
+	// Truncation is SlackTruncationTruncate, SlackTruncationSplit, or SlackTruncationPaginate.
+	Truncation string
+
+	// MessageTemplate, when set, renders the message text in place of the
+	// bold title. Attachments with the summary and job details are unchanged.
+	MessageTemplate *template.Template
+
+	// Metrics, when set, records the duration and outcome of each webhook post.
+	Metrics interfaces.NotifierMetrics
+}
+
+// slackMessage is the payload posted to an incoming webhook.
+type slackMessage struct {
+	Username    string            `json:"username,omitempty"`
+	IconEmoji   string            `json:"icon_emoji,omitempty"`
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments,omitempty"`
+}
+
+// slackAttachment carries the colored detail block of a message.
+type slackAttachment struct {
+	Color string `json:"color"`
+	Text  string `json:"text"`
+}
+
+// slackNotifier implements the Notifier interface over a Slack incoming webhook.
+type slackNotifier struct {
+	config    SlackConfig
+	client    *http.Client
+	pageDelay time.Duration
+}
+
+// NewSlackNotifier creates a new Slack webhook notifier.
+// Empty username and icon fall back to DefaultSlackUsername and DefaultSlackIconEmoji.
+func NewSlackNotifier(config SlackConfig) (interfaces.Notifier, error) {
+	if config.WebhookURL == "" {
+		return nil, fmt.Errorf("slack webhook url is required")
+	}
+
+	if config.Username == "" {
+		config.Username = DefaultSlackUsername
+	}
+
+	if config.IconEmoji == "" {
+		config.IconEmoji = DefaultSlackIconEmoji
+	}
+
+	if config.MaxTextLength <= 0 {
+		config.MaxTextLength = DefaultSlackMaxTextLength
+	}
+
+	switch config.Truncation {
+	case "":
+		config.Truncation = SlackTruncationTruncate
+	case SlackTruncationTruncate, SlackTruncationSplit, SlackTruncationPaginate:
+	default:
+		return nil, fmt.Errorf("invalid slack truncation %q (expected %s, %s, or %s)",
+			config.Truncation, SlackTruncationTruncate, SlackTruncationSplit, SlackTruncationPaginate)
+	}
+
+	return &slackNotifier{
+		config:    config,
+		client:    &http.Client{Timeout: 10 * time.Second},
+		pageDelay: slackPageDelay,
+	}, nil
+}
+
+// Name returns the name of the notifier backend.
+func (n *slackNotifier) Name() string {
+	return "slack"
+}
+
+// Notify posts the notification to the configured webhook. In paginate mode a
+// report too large for one message is posted as several, spaced by pageDelay.
+func (n *slackNotifier) Notify(ctx context.Context, notification interfaces.Notification) error {
+	messages := []slackMessage{buildSlackMessage(n.config, notification)}
+	if n.config.Truncation == SlackTruncationPaginate {
+		messages = buildSlackPages(n.config, notification)
+	}
+
+	// Detail pages keep their numbered title; only the first message is templated.
+	if n.config.MessageTemplate != nil {
+		text, err := renderMessage(n.config.MessageTemplate, notification)
+		if err != nil {
+			return err
+		}
+		messages[0].Text = text
+	}
+
+	for i, message := range messages {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(n.pageDelay):
+			}
+		}
+
+		if err := n.post(ctx, message); err != nil {
+			if len(messages) > 1 {
+				return fmt.Errorf("message %d of %d: %w", i+1, len(messages), err)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// post sends a single message to the configured webhook.
+func (n *slackNotifier) post(ctx context.Context, message slackMessage) (err error) {
+	start := time.Now()
+	defer func() { recordSend(n.config.Metrics, n.Name(), start, err) }()
+
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to encode slack message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.config.WebhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create slack request: %w", mask.Error(err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		// The webhook URL is a secret and appears in transport errors.
+		return fmt.Errorf("failed to post slack message: %w", mask.Error(err))
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("slack webhook returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+// buildSlackMessage renders the notification as a webhook payload.
+// Details that exceed the attachment text limit are split or truncated per config.
+func buildSlackMessage(config SlackConfig, notification interfaces.Notification) slackMessage {
+	maxAttachments := 1
+	if config.Truncation == SlackTruncationSplit {
+		maxAttachments = maxSlackAttachments
+	}
+
+	texts, _ := packDetails(notification.Summary, notification.Details, textLimit(config), maxAttachments)
+
+	color := slackColor(notification.Severity)
+	attachments := make([]slackAttachment, 0, len(texts))
+	for _, text := range texts {
+		attachments = append(attachments, slackAttachment{Color: color, Text: text})
+	}
+
+	return slackMessage{
+		Username:    config.Username,
+		IconEmoji:   config.IconEmoji,
+		Text:        "*" + notification.Title + "*",
+		Attachments: attachments,
+	}
+}
+
+// buildSlackPages renders a notification that does not fit in one attachment
+// as a summary message followed by numbered detail pages. A notification that
+// fits is returned as a single message.
+func buildSlackPages(config SlackConfig, notification interfaces.Notification) []slackMessage {
+	limit := textLimit(config)
+	if _, omitted := packDetails(notification.Summary, notification.Details, limit, 1); omitted == 0 {
+		return []slackMessage{buildSlackMessage(config, notification)}
+	}
+
+	pages, _ := packDetails("", notification.Details, limit, maxSlackPages)
+	footer := fmt.Sprintf("\nDetails follow in %d messages.", len(pages))
+
+	color := slackColor(notification.Severity)
+	messages := make([]slackMessage, 0, len(pages)+1)
+	messages = append(messages, slackMessage{
+		Username:  config.Username,
+		IconEmoji: config.IconEmoji,
+		Text:      "*" + notification.Title + "*",
+		Attachments: []slackAttachment{{
+			Color: color,
+			Text:  truncateText(notification.Summary, limit-len(footer)) + footer,
+		}},
+	})
+	for i, page := range pages {
+		messages = append(messages, slackMessage{
+			Username:    config.Username,
+			IconEmoji:   config.IconEmoji,
+			Text:        fmt.Sprintf("*%s* (details %d/%d)", notification.Title, i+1, len(pages)),
+			Attachments: []slackAttachment{{Color: color, Text: page}},
+		})
+	}
+
+	return messages
+}
+
+// textLimit returns the configured attachment text limit or the default.
+func textLimit(config SlackConfig) int {
+	if config.MaxTextLength <= 0 {
+		return DefaultSlackMaxTextLength
+	}
+	return config.MaxTextLength
+}
+
+// packDetails packs the first text and the detail lines into at most maxTexts
+// texts of at most limit bytes each. When the details do not fit, the last text
+// ends with a "+N more" line and the number of omitted details is returned.
+func packDetails(first string, details []string, limit, maxTexts int) ([]string, int) {
+	// Room kept free in the last text for the "+N more" line.
+	reserve := len(moreDetailsLine(len(details)))
+
+	var texts []string
+	var current strings.Builder
+	current.WriteString(truncateText(first, limit-reserve))
+
+	omitted := 0
+	for i, detail := range details {
+		line := "\n• " + detail
+		if current.Len() == 0 {
+			line = "• " + detail
+		}
+		last := len(texts)+1 == maxTexts
+
+		budget := limit
+		if last && i < len(details)-1 {
+			budget -= reserve
+		}
+		if current.Len()+len(line) <= budget {
+			current.WriteString(line)
+			continue
+		}
+
+		// A detail too long even for an empty text is truncated in place,
+		// rather than flushing the empty text as a page of its own.
+		if current.Len() == 0 {
+			current.WriteString(truncateText(line, limit-reserve))
+			continue
+		}
+
+		if !last {
+			texts = append(texts, current.String())
+			current.Reset()
+			current.WriteString(truncateText("• "+detail, limit-reserve))
+			continue
+		}
+
+		omitted = len(details) - i
+		current.WriteString(moreDetailsLine(omitted))
+		break
+	}
+	texts = append(texts, current.String())
+
+	return texts, omitted
+}
+
+// moreDetailsLine returns the line that stands in for omitted details.
+func moreDetailsLine(omitted int) string {
+	return fmt.Sprintf("\n… +%d more", omitted)
+}
+
+// truncateText shortens text to at most limit bytes without splitting a UTF-8 sequence.
+func truncateText(text string, limit int) string {
+	if len(text) <= limit {
+		return text
+	}
+
+	const ellipsis = "…"
+	cut := limit - len(ellipsis)
+	if cut <= 0 {
+		return ""
+	}
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+
+	return text[:cut] + ellipsis
+}
+
+// slackColor maps a severity to an attachment color.
+func slackColor(severity interfaces.NotificationSeverity) string {
+	switch severity {
+	case interfaces.NotificationSeverityCritical:
+		return "danger"
+	case interfaces.NotificationSeverityWarning:
+		return "warning"
+	default:
+		return "good"
+	}
+}
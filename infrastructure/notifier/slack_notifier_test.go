@@ -0,0 +1,277 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"chainlink-ocr-checker/domain/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSlackMessage(t *testing.T) {
+	notification := interfaces.Notification{
+		Title:    "OCR Checker: CRITICAL for 0xabc",
+		Severity: interfaces.NotificationSeverityCritical,
+		Summary:  "Total: 2, Missing: 1",
+		Details:  []string{"[Missing] job <job-1>"},
+	}
+
+	t.Run("configured username and icon", func(t *testing.T) {
+		msg := buildSlackMessage(SlackConfig{
+			Username:  "OCR Monitor (staging)",
+			IconEmoji: ":satellite:",
+		}, notification)
+
+		assert.Equal(t, "OCR Monitor (staging)", msg.Username)
+		assert.Equal(t, ":satellite:", msg.IconEmoji)
+		assert.Equal(t, "*OCR Checker: CRITICAL for 0xabc*", msg.Text)
+		require.Len(t, msg.Attachments, 1)
+		assert.Equal(t, "danger", msg.Attachments[0].Color)
+		assert.Equal(t, "Total: 2, Missing: 1\n• [Missing] job <job-1>", msg.Attachments[0].Text)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		n, err := NewSlackNotifier(SlackConfig{WebhookURL: "https://hooks.slack.com/services/x"})
+		require.NoError(t, err)
+
+		msg := buildSlackMessage(n.(*slackNotifier).config, notification)
+		assert.Equal(t, DefaultSlackUsername, msg.Username)
+		assert.Equal(t, DefaultSlackIconEmoji, msg.IconEmoji)
+	})
+}
+
+func TestBuildSlackMessage_SizeLimits(t *testing.T) {
+	notification := interfaces.Notification{
+		Title:    "OCR Checker: CRITICAL for 0xabc",
+		Severity: interfaces.NotificationSeverityCritical,
+		Summary:  "Total: 100, Found: 0, Stale: 0, Missing: 100, No Active: 0, Error: 0, Health: 0.0%",
+	}
+	for i := 0; i < 100; i++ {
+		notification.Details = append(notification.Details, fmt.Sprintf(
+			"[Missing] job %d contract 0x%040d last round %d last seen never (rpc error: request timed out)",
+			i, i, 1000+i))
+	}
+
+	// countDetails checks each attachment against the limit and returns
+	// the number of detail lines shown and the "+N more" count.
+	countDetails := func(t *testing.T, msg slackMessage, limit int) (int, int) {
+		shown, omitted := 0, 0
+		for _, attachment := range msg.Attachments {
+			assert.LessOrEqual(t, len(attachment.Text), limit)
+			assert.True(t, utf8.ValidString(attachment.Text))
+			assert.Equal(t, "danger", attachment.Color)
+
+			for _, line := range strings.Split(attachment.Text, "\n") {
+				if strings.HasPrefix(line, "• ") {
+					shown++
+				} else if strings.HasPrefix(line, "… +") {
+					_, err := fmt.Sscanf(line, "… +%d more", &omitted)
+					require.NoError(t, err)
+				}
+			}
+		}
+		return shown, omitted
+	}
+
+	t.Run("truncate", func(t *testing.T) {
+		n, err := NewSlackNotifier(SlackConfig{WebhookURL: "https://hooks.slack.com/services/x"})
+		require.NoError(t, err)
+
+		msg := buildSlackMessage(n.(*slackNotifier).config, notification)
+		require.Len(t, msg.Attachments, 1)
+
+		shown, omitted := countDetails(t, msg, DefaultSlackMaxTextLength)
+		assert.Positive(t, shown)
+		assert.Positive(t, omitted)
+		assert.Equal(t, 100, shown+omitted)
+		assert.True(t, strings.HasPrefix(msg.Attachments[0].Text, notification.Summary))
+	})
+
+	t.Run("split", func(t *testing.T) {
+		n, err := NewSlackNotifier(SlackConfig{
+			WebhookURL: "https://hooks.slack.com/services/x",
+			Truncation: SlackTruncationSplit,
+		})
+		require.NoError(t, err)
+
+		msg := buildSlackMessage(n.(*slackNotifier).config, notification)
+		assert.Greater(t, len(msg.Attachments), 1)
+		assert.LessOrEqual(t, len(msg.Attachments), maxSlackAttachments)
+
+		shown, omitted := countDetails(t, msg, DefaultSlackMaxTextLength)
+		assert.Equal(t, 100, shown)
+		assert.Zero(t, omitted)
+	})
+
+	t.Run("split caps attachments", func(t *testing.T) {
+		msg := buildSlackMessage(SlackConfig{MaxTextLength: 300, Truncation: SlackTruncationSplit}, notification)
+		require.Len(t, msg.Attachments, maxSlackAttachments)
+
+		shown, omitted := countDetails(t, msg, 300)
+		assert.Positive(t, omitted)
+		assert.Equal(t, 100, shown+omitted)
+	})
+
+	t.Run("oversized first detail", func(t *testing.T) {
+		texts, omitted := packDetails("", []string{strings.Repeat("x", 500), "short"}, 300, 3)
+		require.Len(t, texts, 1)
+		assert.Zero(t, omitted)
+		assert.True(t, strings.HasPrefix(texts[0], "• xxx"))
+		assert.True(t, strings.HasSuffix(texts[0], "…\n• short"))
+		assert.LessOrEqual(t, len(texts[0]), 300)
+	})
+
+	t.Run("invalid truncation", func(t *testing.T) {
+		_, err := NewSlackNotifier(SlackConfig{WebhookURL: "https://hooks.slack.com/services/x", Truncation: "drop"})
+		assert.Error(t, err)
+	})
+}
+
+func TestSlackNotifier_Notify(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("posts payload", func(t *testing.T) {
+		var received slackMessage
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		n, err := NewSlackNotifier(SlackConfig{
+			WebhookURL: server.URL,
+			Username:   "Mainnet Monitor",
+			IconEmoji:  ":eyes:",
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, n.Notify(ctx, interfaces.Notification{Title: "hello"}))
+		assert.Equal(t, "Mainnet Monitor", received.Username)
+		assert.Equal(t, ":eyes:", received.IconEmoji)
+		assert.Equal(t, "*hello*", received.Text)
+	})
+
+	t.Run("message template", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "slack.tmpl")
+		require.NoError(t, os.WriteFile(path, []byte(
+			"{{.Title}} ({{.Severity}})\nRunbook: https://wiki.example.com/ocr#{{.Severity}}\n"), 0600))
+		tmpl, err := LoadMessageTemplate(path)
+		require.NoError(t, err)
+
+		var received slackMessage
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		n, err := NewSlackNotifier(SlackConfig{WebhookURL: server.URL, MessageTemplate: tmpl})
+		require.NoError(t, err)
+
+		require.NoError(t, n.Notify(ctx, interfaces.Notification{
+			Title:    "OCR Checker: CRITICAL for 0xabc",
+			Severity: interfaces.NotificationSeverityCritical,
+			Summary:  "Total: 2, Missing: 1",
+			Details:  []string{"[Missing] job <job-1>"},
+		}))
+		assert.Equal(t,
+			"OCR Checker: CRITICAL for 0xabc (critical)\nRunbook: https://wiki.example.com/ocr#critical",
+			received.Text)
+
+		// The attachment still carries the summary and details.
+		require.Len(t, received.Attachments, 1)
+		assert.Equal(t, "danger", received.Attachments[0].Color)
+		assert.Equal(t, "Total: 2, Missing: 1\n• [Missing] job <job-1>", received.Attachments[0].Text)
+	})
+
+	t.Run("webhook error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "invalid_token", http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		n, err := NewSlackNotifier(SlackConfig{WebhookURL: server.URL})
+		require.NoError(t, err)
+
+		err = n.Notify(ctx, interfaces.Notification{Title: "hello"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "403")
+		assert.Contains(t, err.Error(), "invalid_token")
+	})
+
+	t.Run("missing webhook", func(t *testing.T) {
+		_, err := NewSlackNotifier(SlackConfig{})
+		require.Error(t, err)
+	})
+}
+
+func TestSlackNotifier_Paginate(t *testing.T) {
+	ctx := context.Background()
+
+	var received []slackMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg slackMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		received = append(received, msg)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	n, err := NewSlackNotifier(SlackConfig{
+		WebhookURL:    server.URL,
+		MaxTextLength: 500,
+		Truncation:    SlackTruncationPaginate,
+	})
+	require.NoError(t, err)
+	n.(*slackNotifier).pageDelay = 0
+
+	notification := func(details int) interfaces.Notification {
+		notification := interfaces.Notification{
+			Title:    "OCR Checker: OK for 0xabc",
+			Severity: interfaces.NotificationSeverityInfo,
+			Summary:  "Total: 60, Found: 60, Health: 100.0%",
+		}
+		for i := 0; i < details; i++ {
+			notification.Details = append(notification.Details, fmt.Sprintf("[Found] job %d contract 0x%040d", i, i))
+		}
+		return notification
+	}
+
+	t.Run("small report is one post", func(t *testing.T) {
+		received = nil
+		require.NoError(t, n.Notify(ctx, notification(3)))
+		require.Len(t, received, 1)
+		assert.Equal(t, "*OCR Checker: OK for 0xabc*", received[0].Text)
+		assert.Equal(t, 3, strings.Count(received[0].Attachments[0].Text, "• "))
+	})
+
+	t.Run("oversized report is paginated", func(t *testing.T) {
+		received = nil
+		require.NoError(t, n.Notify(ctx, notification(60)))
+		require.Greater(t, len(received), 2)
+
+		pages := len(received) - 1
+		summary := received[0].Attachments[0].Text
+		assert.True(t, strings.HasPrefix(summary, "Total: 60"))
+		assert.Contains(t, summary, fmt.Sprintf("Details follow in %d messages.", pages))
+
+		shown := 0
+		for i, msg := range received[1:] {
+			assert.Equal(t, fmt.Sprintf("*OCR Checker: OK for 0xabc* (details %d/%d)", i+1, pages), msg.Text)
+			require.Len(t, msg.Attachments, 1)
+			assert.LessOrEqual(t, len(msg.Attachments[0].Text), 500)
+			shown += strings.Count(msg.Attachments[0].Text, "• ")
+		}
+		assert.Equal(t, 60, shown)
+	})
+}
@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"time"
 
 	"chainlink-ocr-checker/domain/entities"
 	"chainlink-ocr-checker/domain/errors"
@@ -12,46 +14,46 @@ import (
 )
 
 // jobRepository implements the JobRepository interface.
+// Every job query is a read, so all of them go to the read connection.
 type jobRepository struct {
-	db *gorm.DB
+	reader *gorm.DB
 }
 
 // NewJobRepository creates a new job repository.
 func NewJobRepository(db *gorm.DB) interfaces.JobRepository {
-	return &jobRepository{db: db}
+	return NewJobRepositoryWithReplica(db, nil)
+}
+
+// NewJobRepositoryWithReplica creates a job repository that reads from replica.
+// A nil replica reads from the primary.
+func NewJobRepositoryWithReplica(primary, replica *gorm.DB) interfaces.JobRepository {
+	return &jobRepository{reader: readerFor(primary, replica)}
 }
 
 // FindByTransmitter finds jobs by transmitter address.
+// The contract comes from the OCR2 spec's contract_address column; jobs whose
+// spec has none fall back to an address found in the job name.
 func (r *jobRepository) FindByTransmitter(
 	ctx context.Context,
 	transmitterAddress common.Address,
 ) ([]entities.Job, error) {
-	var jobs []entities.Job
+	var rows []jobRow
 
-	query := r.db.WithContext(ctx).
+	query := r.reader.WithContext(ctx).
 		Table("ocr2_oracle_specs o").
 		Select(`
 			j.id,
 			j.external_job_id,
+			j.name,
 			j.created_at,
 			o.contract_address,
-			o.relay,
-			o.relay_config,
-			o.p2pv2_bootstrappers,
-			o.ocr_key_bundle_id,
-			o.transmitter_id,
-			o.blockchain_timeout,
-			o.contract_config_tracker_poll_interval,
-			o.contract_config_confirmations,
-			o.juels_per_fee_coin_pipeline,
-			o.mercury_transmitter,
 			t.from_address as transmitter_address
 		`).
 		Joins("JOIN jobs j ON j.ocr2_oracle_spec_id = o.id").
 		Joins("JOIN transmitters t ON t.id = o.transmitter_id").
 		Where("t.from_address = ?", transmitterAddress.Hex())
 
-	err := query.Find(&jobs).Error
+	err := query.Find(&rows).Error
 	if err != nil {
 		return nil, &errors.RepositoryError{
 			Operation: "FindByTransmitter",
@@ -60,6 +62,11 @@ func (r *jobRepository) FindByTransmitter(
 		}
 	}
 
+	jobs := make([]entities.Job, 0, len(rows))
+	for _, row := range rows {
+		jobs = append(jobs, row.toEntity())
+	}
+
 	return jobs, nil
 }
 
@@ -67,7 +74,7 @@ func (r *jobRepository) FindByTransmitter(
 func (r *jobRepository) FindByContract(ctx context.Context, contractAddress common.Address) ([]entities.Job, error) {
 	var jobs []entities.Job
 
-	query := r.db.WithContext(ctx).
+	query := r.reader.WithContext(ctx).
 		Table("ocr2_oracle_specs o").
 		Select(`
 			j.id,
@@ -102,7 +109,7 @@ func (r *jobRepository) FindByFilter(
 	var jobs []entities.Job
 	var totalCount int64
 
-	query := r.db.WithContext(ctx).
+	query := r.reader.WithContext(ctx).
 		Table("ocr2_oracle_specs o").
 		Select(`
 			j.id,
@@ -169,7 +176,7 @@ func (r *jobRepository) FindByFilter(
 func (r *jobRepository) FindByID(ctx context.Context, id int32) (*entities.Job, error) {
 	var job entities.Job
 
-	query := r.db.WithContext(ctx).
+	query := r.reader.WithContext(ctx).
 		Table("ocr2_oracle_specs o").
 		Select(`
 			j.id,
@@ -203,7 +210,7 @@ func (r *jobRepository) FindByID(ctx context.Context, id int32) (*entities.Job,
 func (r *jobRepository) FindActiveJobs(ctx context.Context) ([]entities.Job, error) {
 	var jobs []entities.Job
 
-	query := r.db.WithContext(ctx).
+	query := r.reader.WithContext(ctx).
 		Table("ocr2_oracle_specs o").
 		Select(`
 			j.id,
@@ -229,3 +236,99 @@ func (r *jobRepository) FindActiveJobs(ctx context.Context) ([]entities.Job, err
 
 	return jobs, nil
 }
+
+// FindActiveJobsInBatches iterates over active jobs in batches ordered by job ID.
+func (r *jobRepository) FindActiveJobsInBatches(
+	ctx context.Context,
+	batchSize int,
+	fn func(batch []entities.Job) error,
+) error {
+	if batchSize <= 0 {
+		return errors.NewDomainError(errors.ErrInvalidInput, "batch size must be positive")
+	}
+
+	// Keyset pagination keeps each page query cheap regardless of how far in we are.
+	var lastID int32
+	for {
+		var rows []jobRow
+
+		query := r.reader.WithContext(ctx).
+			Table("ocr2_oracle_specs o").
+			Select(`
+				j.id,
+				j.external_job_id,
+				j.created_at,
+				o.contract_address,
+				t.from_address as transmitter_address
+			`).
+			Joins("JOIN jobs j ON j.ocr2_oracle_spec_id = o.id").
+			Joins("JOIN transmitters t ON t.id = o.transmitter_id").
+			Where("j.deleted_at IS NULL").
+			Where("j.id > ?", lastID).
+			Order("j.id").
+			Limit(batchSize)
+
+		if err := query.Find(&rows).Error; err != nil {
+			return &errors.RepositoryError{
+				Operation: "FindActiveJobsInBatches",
+				Entity:    "Job",
+				Err:       err,
+			}
+		}
+
+		if len(rows) == 0 {
+			return nil
+		}
+
+		jobs := make([]entities.Job, 0, len(rows))
+		for _, row := range rows {
+			jobs = append(jobs, row.toEntity())
+		}
+
+		if err := fn(jobs); err != nil {
+			return err
+		}
+
+		if len(rows) < batchSize {
+			return nil
+		}
+
+		lastID = rows[len(rows)-1].ID
+	}
+}
+
+// jobNameContractPattern finds a contract address in a job name, for specs
+// without a contract_address.
+var jobNameContractPattern = regexp.MustCompile(`0x[0-9a-fA-F]{40}`)
+
+// jobRow is the flat scan target for job queries.
+type jobRow struct {
+	ID                 int32
+	ExternalJobID      string
+	Name               string
+	CreatedAt          time.Time
+	ContractAddress    string
+	TransmitterAddress string
+}
+
+// toEntity converts a job row to a domain job.
+func (r jobRow) toEntity() entities.Job {
+	transmitter := common.HexToAddress(r.TransmitterAddress)
+
+	contract := r.ContractAddress
+	if !common.IsHexAddress(contract) {
+		contract = jobNameContractPattern.FindString(r.Name)
+	}
+
+	return entities.Job{
+		ID:            r.ID,
+		ExternalJobID: r.ExternalJobID,
+		OracleSpec: entities.OracleSpec{
+			ContractAddress:    common.HexToAddress(contract),
+			TransmitterAddress: transmitter,
+		},
+		TransmitterAddress: transmitter,
+		Active:             true,
+		CreatedAt:          r.CreatedAt,
+	}
+}
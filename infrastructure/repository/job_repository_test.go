@@ -2,6 +2,8 @@ package repository
 
 import (
 	"database/sql"
+	"errors"
+	"fmt"
 	"math/big"
 	"testing"
 	"time"
@@ -9,6 +11,7 @@ import (
 	"chainlink-ocr-checker/domain/entities"
 	"chainlink-ocr-checker/test/helpers"
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/driver/postgres"
@@ -32,55 +35,66 @@ func setupTestDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock, func()) {
 }
 
 func TestJobRepository_FindByTransmitter(t *testing.T) {
-	t.Skip("Skipping due to database schema mismatch")
 	ctx := helpers.TestContext(t)
 	db, mock, cleanup := setupTestDB(t)
 	defer cleanup()
 
 	repo := NewJobRepository(db)
 	transmitterAddr := helpers.RandomAddress()
+	columns := []string{
+		"id", "external_job_id", "name", "created_at", "contract_address", "transmitter_address",
+	}
 
-	t.Run("success", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{
-			"id", "external_job_id", "created_at", "contract_address",
-			"relay", "relay_config", "p2pv2_bootstrappers", "ocr_key_bundle_id",
-			"transmitter_id", "blockchain_timeout", "contract_config_tracker_poll_interval",
-			"contract_config_confirmations", "juels_per_fee_coin_pipeline",
-			"mercury_transmitter", "transmitter_address",
-		}).AddRow(
-			1, "job-123", time.Now(), "0x1234567890123456789012345678901234567890",
-			"evm", `{"ChainID": "1"}`, "", 1, 1, 30, 10, 1, "", false,
-			transmitterAddr.Hex(),
+	t.Run("contract from spec column", func(t *testing.T) {
+		contractAddr := helpers.RandomAddress()
+		nameAddr := helpers.RandomAddress()
+		rows := sqlmock.NewRows(columns).AddRow(
+			1, "job-123", "OCR2 ETH/USD "+nameAddr.Hex(), time.Now(), contractAddr.Hex(), transmitterAddr.Hex(),
 		)
 
-		mock.ExpectQuery(`SELECT .* FROM "ocr2_oracle_specs" o`).
+		mock.ExpectQuery(`SELECT .*o\.contract_address.* FROM ocr2_oracle_specs o`).
 			WithArgs(transmitterAddr.Hex()).
 			WillReturnRows(rows)
 
 		jobs, err := repo.FindByTransmitter(ctx, transmitterAddr)
 		require.NoError(t, err)
-		assert.Len(t, jobs, 1)
+		require.Len(t, jobs, 1)
 		assert.Equal(t, int32(1), jobs[0].ID)
 		assert.Equal(t, "job-123", jobs[0].ExternalJobID)
+		assert.Equal(t, contractAddr, jobs[0].OracleSpec.ContractAddress)
+		assert.Equal(t, transmitterAddr, jobs[0].TransmitterAddress)
+		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("no results", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{
-			"id", "external_job_id", "created_at", "contract_address",
-			"relay", "relay_config", "transmitter_address",
-		})
+	t.Run("contract from job name without spec column", func(t *testing.T) {
+		nameAddr := helpers.RandomAddress()
+		rows := sqlmock.NewRows(columns).AddRow(
+			2, "job-456", "OCR2 BTC/USD | "+nameAddr.Hex(), time.Now(), nil, transmitterAddr.Hex(),
+		)
 
-		mock.ExpectQuery(`SELECT .* FROM "ocr2_oracle_specs" o`).
+		mock.ExpectQuery(`SELECT .* FROM ocr2_oracle_specs o`).
 			WithArgs(transmitterAddr.Hex()).
 			WillReturnRows(rows)
 
+		jobs, err := repo.FindByTransmitter(ctx, transmitterAddr)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, nameAddr, jobs[0].OracleSpec.ContractAddress)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no results", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT .* FROM ocr2_oracle_specs o`).
+			WithArgs(transmitterAddr.Hex()).
+			WillReturnRows(sqlmock.NewRows(columns))
+
 		jobs, err := repo.FindByTransmitter(ctx, transmitterAddr)
 		require.NoError(t, err)
 		assert.Empty(t, jobs)
 	})
 
 	t.Run("database error", func(t *testing.T) {
-		mock.ExpectQuery(`SELECT .* FROM "ocr2_oracle_specs" o`).
+		mock.ExpectQuery(`SELECT .* FROM ocr2_oracle_specs o`).
 			WithArgs(transmitterAddr.Hex()).
 			WillReturnError(sql.ErrConnDone)
 
@@ -260,3 +274,99 @@ func TestJobRepository_FindActiveJobs(t *testing.T) {
 		assert.Len(t, jobs, 2)
 	})
 }
+
+func TestJobRepository_FindActiveJobsInBatches(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	db, mock, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewJobRepository(db)
+
+	jobRows := func(ids ...int) *sqlmock.Rows {
+		rows := sqlmock.NewRows([]string{
+			"id", "external_job_id", "created_at", "contract_address", "transmitter_address",
+		})
+		for _, id := range ids {
+			rows.AddRow(id, fmt.Sprintf("job-%d", id), time.Now(),
+				"0x1234567890123456789012345678901234567890",
+				"0x9876543210987654321098765432109876543210")
+		}
+		return rows
+	}
+
+	t.Run("iterates all batches", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT .* FROM ocr2_oracle_specs o .* ORDER BY j.id LIMIT \$2`).
+			WithArgs(0, 2).
+			WillReturnRows(jobRows(1, 2))
+		mock.ExpectQuery(`SELECT .* FROM ocr2_oracle_specs o .* ORDER BY j.id LIMIT \$2`).
+			WithArgs(2, 2).
+			WillReturnRows(jobRows(3, 4))
+		mock.ExpectQuery(`SELECT .* FROM ocr2_oracle_specs o .* ORDER BY j.id LIMIT \$2`).
+			WithArgs(4, 2).
+			WillReturnRows(jobRows(5))
+
+		var batches [][]int32
+		err := repo.FindActiveJobsInBatches(ctx, 2, func(batch []entities.Job) error {
+			ids := make([]int32, 0, len(batch))
+			for _, job := range batch {
+				ids = append(ids, job.ID)
+			}
+			batches = append(batches, ids)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, [][]int32{{1, 2}, {3, 4}, {5}}, batches)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("maps addresses", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT .* FROM ocr2_oracle_specs o`).
+			WithArgs(0, 10).
+			WillReturnRows(jobRows(7))
+
+		var jobs []entities.Job
+		err := repo.FindActiveJobsInBatches(ctx, 10, func(batch []entities.Job) error {
+			jobs = append(jobs, batch...)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, "job-7", jobs[0].ExternalJobID)
+		assert.Equal(t, common.HexToAddress("0x1234567890123456789012345678901234567890"), jobs[0].OracleSpec.ContractAddress)
+		assert.Equal(t, common.HexToAddress("0x9876543210987654321098765432109876543210"), jobs[0].TransmitterAddress)
+		assert.True(t, jobs[0].Active)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("stops on callback error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT .* FROM ocr2_oracle_specs o`).
+			WithArgs(0, 2).
+			WillReturnRows(jobRows(1, 2))
+
+		stopErr := errors.New("stop")
+		err := repo.FindActiveJobsInBatches(ctx, 2, func(_ []entities.Job) error {
+			return stopErr
+		})
+		assert.ErrorIs(t, err, stopErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT .* FROM ocr2_oracle_specs o`).
+			WithArgs(0, 2).
+			WillReturnError(sql.ErrConnDone)
+
+		err := repo.FindActiveJobsInBatches(ctx, 2, func(_ []entities.Job) error {
+			return nil
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "FindActiveJobsInBatches")
+	})
+
+	t.Run("invalid batch size", func(t *testing.T) {
+		err := repo.FindActiveJobsInBatches(ctx, 0, func(_ []entities.Job) error {
+			return nil
+		})
+		require.Error(t, err)
+	})
+}
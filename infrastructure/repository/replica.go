@@ -0,0 +1,12 @@
+package repository
+
+import "gorm.io/gorm"
+
+// readerFor returns the connection used for read queries: the replica when one
+// is configured, the primary otherwise.
+func readerFor(primary, replica *gorm.DB) *gorm.DB {
+	if replica != nil {
+		return replica
+	}
+	return primary
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/test/helpers"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepositories_ReadReplica(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	primary, primaryMock, cleanupPrimary := setupTestDB(t)
+	defer cleanupPrimary()
+	replica, replicaMock, cleanupReplica := setupTestDB(t)
+	defer cleanupReplica()
+
+	t.Run("job reads hit the replica", func(t *testing.T) {
+		repo := NewJobRepositoryWithReplica(primary, replica)
+
+		replicaMock.ExpectQuery(`SELECT .* FROM ocr2_oracle_specs o .* ORDER BY j.id LIMIT \$2`).
+			WithArgs(0, 10).
+			WillReturnRows(sqlmock.NewRows([]string{
+				"id", "external_job_id", "created_at", "contract_address", "transmitter_address",
+			}).AddRow(1, "job-1", time.Now(),
+				"0x1234567890123456789012345678901234567890",
+				"0x9876543210987654321098765432109876543210"))
+
+		var jobs []entities.Job
+		err := repo.FindActiveJobsInBatches(ctx, 10, func(batch []entities.Job) error {
+			jobs = append(jobs, batch...)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Len(t, jobs, 1)
+
+		// No query reached the primary.
+		require.NoError(t, replicaMock.ExpectationsWereMet())
+		require.NoError(t, primaryMock.ExpectationsWereMet())
+	})
+
+	t.Run("transmission writes use the primary and reads the replica", func(t *testing.T) {
+		repo, ok := NewTransmissionRepositoryWithReplica(primary, replica).(*transmissionRepository)
+		require.True(t, ok)
+		assert.Same(t, primary, repo.db)
+		assert.Same(t, replica, repo.reader)
+	})
+
+	t.Run("reads fall back to the primary without a replica", func(t *testing.T) {
+		jobs, ok := NewJobRepository(primary).(*jobRepository)
+		require.True(t, ok)
+		assert.Same(t, primary, jobs.reader)
+
+		transmissions, ok := NewTransmissionRepository(primary).(*transmissionRepository)
+		require.True(t, ok)
+		assert.Same(t, primary, transmissions.db)
+		assert.Same(t, primary, transmissions.reader)
+	})
+}
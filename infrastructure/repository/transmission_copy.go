@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+
+	"chainlink-ocr-checker/domain/entities"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// BulkLoadMode selects how SaveBatch writes transmissions.
+type BulkLoadMode string
+
+// BulkLoadMode constants.
+const (
+	// BulkLoadInsert writes batches with multi-row INSERTs.
+	BulkLoadInsert BulkLoadMode = "insert"
+	// BulkLoadCopy streams batches with the Postgres COPY protocol, falling
+	// back to INSERTs when the connection is not Postgres over pgx.
+	BulkLoadCopy BulkLoadMode = "copy"
+)
+
+// transmissionsTable is the table transmissions are saved to, as GORM names it.
+const transmissionsTable = "transmissions"
+
+// transmissionCopyColumns are the columns COPY fills, in transmissionCopyRow order.
+var transmissionCopyColumns = []string{
+	"contract_address",
+	"config_digest",
+	"epoch",
+	"round",
+	"aggregator_round_id",
+	"latest_answer",
+	"juels_per_fee_coin",
+	"latest_timestamp",
+	"transmitter_index",
+	"transmitter_address",
+	"observer_index",
+	"observer_count",
+	"observers",
+	"met_quorum",
+	"block_number",
+	"block_timestamp",
+}
+
+// transmissionCopyRow returns the values of a transmission for COPY.
+// Addresses are stored as hex, as the queries match them, and answers as numerics.
+func transmissionCopyRow(tx *entities.Transmission) []any {
+	return []any{
+		tx.ContractAddress.Hex(),
+		tx.ConfigDigest[:],
+		int64(tx.Epoch),
+		int16(tx.Round),
+		int64(tx.AggregatorRoundID),
+		copyNumeric(tx.LatestAnswer),
+		copyNumeric(tx.JuelsPerFeeCoin),
+		int64(tx.LatestTimestamp),
+		int16(tx.TransmitterIndex),
+		tx.TransmitterAddress.Hex(),
+		int16(tx.ObserverIndex),
+		int16(tx.ObserverCount),
+		[]byte(tx.Observers),
+		tx.MetQuorum,
+		int64(tx.BlockNumber), // #nosec G115 -- block numbers fit in int64
+		tx.BlockTimestamp,
+	}
+}
+
+// copyNumeric converts an optional big integer to a numeric; nil is NULL.
+func copyNumeric(value *big.Int) pgtype.Numeric {
+	if value == nil {
+		return pgtype.Numeric{}
+	}
+	return pgtype.Numeric{Int: value, Valid: true}
+}
+
+// copyBatch writes transmissions with COPY. It reports false without writing
+// when the primary connection is not Postgres over pgx.
+func (r *transmissionRepository) copyBatch(ctx context.Context, transmissions []entities.Transmission) (bool, error) {
+	if r.db.Dialector.Name() != "postgres" {
+		return false, nil
+	}
+
+	// Inside a transaction COPY has to run on the transaction's connection,
+	// which database/sql does not expose.
+	sqlDB, ok := r.db.Statement.ConnPool.(*sql.DB)
+	if !ok {
+		return false, nil
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	copied := false
+	err = conn.Raw(func(driverConn any) error {
+		pgxConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return nil
+		}
+
+		copied = true
+		_, err := pgxConn.Conn().CopyFrom(ctx,
+			pgx.Identifier{transmissionsTable},
+			transmissionCopyColumns,
+			pgx.CopyFromSlice(len(transmissions), func(i int) ([]any, error) {
+				return transmissionCopyRow(&transmissions[i]), nil
+			}),
+		)
+		return err
+	})
+	return copied, err
+}
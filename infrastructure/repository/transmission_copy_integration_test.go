@@ -0,0 +1,131 @@
+//go:build integration
+// +build integration
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"chainlink-ocr-checker/test/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// openCopyTestDB connects to the Postgres at OCR_TEST_DATABASE_DSN and creates
+// a temporary transmissions table. The pool holds one connection so the table,
+// which only its session sees, is visible to every statement.
+func openCopyTestDB(tb testing.TB) *gorm.DB {
+	tb.Helper()
+
+	dsn := os.Getenv("OCR_TEST_DATABASE_DSN")
+	if dsn == "" {
+		tb.Skip("Set OCR_TEST_DATABASE_DSN to run Postgres integration tests")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(tb, err)
+	sqlDB, err := db.DB()
+	require.NoError(tb, err)
+	sqlDB.SetMaxOpenConns(1)
+	tb.Cleanup(func() { _ = sqlDB.Close() })
+
+	require.NoError(tb, db.Exec(`CREATE TEMPORARY TABLE transmissions (
+		contract_address    text NOT NULL,
+		config_digest       bytea NOT NULL,
+		epoch               bigint NOT NULL,
+		round               smallint NOT NULL,
+		aggregator_round_id bigint NOT NULL,
+		latest_answer       numeric,
+		juels_per_fee_coin  numeric,
+		latest_timestamp    bigint NOT NULL,
+		transmitter_index   smallint NOT NULL,
+		transmitter_address text NOT NULL,
+		observer_index      smallint NOT NULL,
+		observer_count      smallint NOT NULL,
+		observers           bytea,
+		met_quorum          boolean NOT NULL,
+		block_number        bigint NOT NULL,
+		block_timestamp     timestamptz NOT NULL
+	)`).Error)
+
+	return db
+}
+
+// copyTestTransmissions returns n transmissions of one contract.
+func copyTestTransmissions(n int) []entities.Transmission {
+	contract := helpers.RandomAddress()
+	transmissions := make([]entities.Transmission, n)
+	for i := range transmissions {
+		transmissions[i] = entities.Transmission{
+			ContractAddress:    contract,
+			ConfigDigest:       [32]byte{1},
+			Epoch:              uint32(i >> 8),
+			Round:              uint8(i),
+			AggregatorRoundID:  uint32(i + 1),
+			LatestAnswer:       new(big.Int).Mul(big.NewInt(int64(i)), big.NewInt(1e18)),
+			LatestTimestamp:    uint32(1700000000 + i),
+			TransmitterAddress: helpers.RandomAddress(),
+			Observers:          []uint8{0, 1, 2},
+			BlockNumber:        uint64(1000 + i),
+			BlockTimestamp:     time.Unix(int64(1700000000+i), 0),
+		}
+	}
+	return transmissions
+}
+
+func TestTransmissionRepository_SaveBatchCopyIntegration(t *testing.T) {
+	ctx := context.Background()
+	db := openCopyTestDB(t)
+	repo := NewTransmissionRepositoryWithBulkLoad(db, nil, BulkLoadCopy).(*transmissionRepository)
+
+	transmissions := copyTestTransmissions(1000)
+	copied, err := repo.copyBatch(ctx, transmissions[:500])
+	require.NoError(t, err)
+	assert.True(t, copied, "a pgx connection should be loaded with COPY")
+	require.NoError(t, repo.SaveBatch(ctx, transmissions[500:]))
+
+	var count int64
+	require.NoError(t, db.Table(transmissionsTable).Count(&count).Error)
+	assert.Equal(t, int64(1000), count)
+
+	var last struct {
+		ContractAddress string
+		LatestAnswer    string
+		BlockNumber     uint64
+		Observers       []byte
+		JuelsPerFeeCoin *string
+	}
+	require.NoError(t, db.Table(transmissionsTable).
+		Select("contract_address, latest_answer::text AS latest_answer, block_number, observers, juels_per_fee_coin::text").
+		Order("block_number DESC").Limit(1).Scan(&last).Error)
+	assert.Equal(t, transmissions[999].ContractAddress.Hex(), last.ContractAddress)
+	assert.Equal(t, transmissions[999].LatestAnswer.String(), last.LatestAnswer)
+	assert.Equal(t, uint64(1999), last.BlockNumber)
+	assert.Equal(t, []byte{0, 1, 2}, last.Observers)
+	assert.Nil(t, last.JuelsPerFeeCoin)
+}
+
+func BenchmarkTransmissionRepository_SaveBatchCopy(b *testing.B) {
+	ctx := context.Background()
+	db := openCopyTestDB(b)
+	repo := NewTransmissionRepositoryWithBulkLoad(db, nil, BulkLoadCopy)
+
+	for _, size := range []int{1000, 100000} {
+		transmissions := copyTestTransmissions(size)
+		b.Run(fmt.Sprintf("rows=%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := repo.SaveBatch(ctx, transmissions); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
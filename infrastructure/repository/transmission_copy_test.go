@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"chainlink-ocr-checker/domain/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransmissionCopyRow(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	transmitter := common.HexToAddress("0xa000000000000000000000000000000000000001")
+	timestamp := time.Unix(1700000000, 0).UTC()
+
+	row := transmissionCopyRow(&entities.Transmission{
+		ContractAddress:    contract,
+		ConfigDigest:       [32]byte{0xab},
+		Epoch:              5,
+		Round:              2,
+		AggregatorRoundID:  7,
+		LatestAnswer:       big.NewInt(-42),
+		LatestTimestamp:    1700000000,
+		TransmitterIndex:   3,
+		TransmitterAddress: transmitter,
+		ObserverIndex:      3,
+		ObserverCount:      2,
+		Observers:          []uint8{1, 3},
+		MetQuorum:          true,
+		BlockNumber:        100,
+		BlockTimestamp:     timestamp,
+	})
+	require.Len(t, row, len(transmissionCopyColumns))
+
+	values := make(map[string]any, len(row))
+	for i, column := range transmissionCopyColumns {
+		values[column] = row[i]
+	}
+	assert.Equal(t, contract.Hex(), values["contract_address"])
+	assert.Equal(t, append([]byte{0xab}, make([]byte, 31)...), values["config_digest"])
+	assert.Equal(t, int64(5), values["epoch"])
+	assert.Equal(t, int16(2), values["round"])
+	assert.Equal(t, pgtype.Numeric{Int: big.NewInt(-42), Valid: true}, values["latest_answer"])
+	assert.Equal(t, pgtype.Numeric{}, values["juels_per_fee_coin"], "a missing answer is NULL")
+	assert.Equal(t, transmitter.Hex(), values["transmitter_address"])
+	assert.Equal(t, []byte{1, 3}, values["observers"])
+	assert.Equal(t, true, values["met_quorum"])
+	assert.Equal(t, int64(100), values["block_number"])
+	assert.Equal(t, timestamp, values["block_timestamp"])
+}
@@ -14,13 +14,31 @@ import (
 )
 
 // transmissionRepository implements the TransmissionRepository interface.
+// Saves go to the primary connection and queries to the read connection.
 type transmissionRepository struct {
-	db *gorm.DB
+	db       *gorm.DB
+	reader   *gorm.DB
+	bulkLoad BulkLoadMode
 }
 
 // NewTransmissionRepository creates a new transmission repository.
 func NewTransmissionRepository(db *gorm.DB) interfaces.TransmissionRepository {
-	return &transmissionRepository{db: db}
+	return NewTransmissionRepositoryWithReplica(db, nil)
+}
+
+// NewTransmissionRepositoryWithReplica creates a transmission repository that
+// writes to primary and reads from replica. A nil replica reads from the primary.
+func NewTransmissionRepositoryWithReplica(primary, replica *gorm.DB) interfaces.TransmissionRepository {
+	return NewTransmissionRepositoryWithBulkLoad(primary, replica, BulkLoadInsert)
+}
+
+// NewTransmissionRepositoryWithBulkLoad creates a transmission repository like
+// NewTransmissionRepositoryWithReplica whose batch saves use bulkLoad.
+func NewTransmissionRepositoryWithBulkLoad(
+	primary, replica *gorm.DB,
+	bulkLoad BulkLoadMode,
+) interfaces.TransmissionRepository {
+	return &transmissionRepository{db: primary, reader: readerFor(primary, replica), bulkLoad: bulkLoad}
 }
 
 // Save saves transmission data.
@@ -35,12 +53,27 @@ func (r *transmissionRepository) Save(ctx context.Context, transmission entities
 	return nil
 }
 
-// SaveBatch saves multiple transmissions.
+// SaveBatch saves multiple transmissions, with COPY under BulkLoadCopy when
+// the database supports it.
 func (r *transmissionRepository) SaveBatch(ctx context.Context, transmissions []entities.Transmission) error {
 	if len(transmissions) == 0 {
 		return nil
 	}
 
+	if r.bulkLoad == BulkLoadCopy {
+		copied, err := r.copyBatch(ctx, transmissions)
+		if err != nil {
+			return &errors.RepositoryError{
+				Operation: "SaveBatch",
+				Entity:    "Transmission",
+				Err:       err,
+			}
+		}
+		if copied {
+			return nil
+		}
+	}
+
 	// Use batch insert for better performance.
 	batchSize := 100
 	for i := 0; i < len(transmissions); i += batchSize {
@@ -69,7 +102,7 @@ func (r *transmissionRepository) FindByContract(
 ) ([]entities.Transmission, error) {
 	var transmissions []entities.Transmission
 
-	query := r.db.WithContext(ctx).
+	query := r.reader.WithContext(ctx).
 		Where("contract_address = ?", contractAddress.Hex()).
 		Order("block_number DESC")
 
@@ -96,7 +129,7 @@ func (r *transmissionRepository) FindByTransmitter(
 ) ([]entities.Transmission, error) {
 	var transmissions []entities.Transmission
 
-	query := r.db.WithContext(ctx).
+	query := r.reader.WithContext(ctx).
 		Where("transmitter_address = ?", transmitterAddress.Hex()).
 		Order("block_number DESC")
 
@@ -123,7 +156,7 @@ func (r *transmissionRepository) FindByRoundRange(
 ) ([]entities.Transmission, error) {
 	var transmissions []entities.Transmission
 
-	query := r.db.WithContext(ctx).
+	query := r.reader.WithContext(ctx).
 		Where("contract_address = ? AND round >= ? AND round <= ?",
 			contractAddress.Hex(), startRound, endRound).
 		Order("round ASC")
@@ -147,7 +180,7 @@ func (r *transmissionRepository) FindByTimeRange(
 ) ([]entities.Transmission, error) {
 	var transmissions []entities.Transmission
 
-	query := r.db.WithContext(ctx).
+	query := r.reader.WithContext(ctx).
 		Where("contract_address = ? AND latest_timestamp >= ? AND latest_timestamp <= ?",
 			contractAddress.Hex(), startTime, endTime).
 		Order("latest_timestamp ASC")
@@ -169,7 +202,7 @@ func (r *transmissionRepository) GetLatestRound(ctx context.Context, contractAdd
 		MaxRound uint32
 	}
 
-	err := r.db.WithContext(ctx).
+	err := r.reader.WithContext(ctx).
 		Model(&entities.Transmission{}).
 		Select("MAX(round) as max_round").
 		Where("contract_address = ?", contractAddress.Hex()).
@@ -31,7 +31,9 @@ type QueryResult struct {
 }
 
 // FetchPeriod fetches OCR transmissions for a given period range.
+// Cancelling ctx stops in-flight RPC calls and the fetch fan-out.
 func FetchPeriod(
+	ctx context.Context,
 	client *ethclient.Client,
 	contractAddr common.Address,
 	startRound, endRound, querySize int64,
@@ -42,10 +44,7 @@ func FetchPeriod(
 		return errors.Wrap(err, "failed to create OCR2 aggregator instance")
 	}
 
-	desc, err := aggr.Description(nil)
-	if err != nil {
-		return errors.Wrap(err, "failed to get description")
-	}
+	desc := describeContract(aggr, contractAddr)
 	log.Debugf("%s: %s", contractAddr, desc)
 
 	var (
@@ -59,7 +58,7 @@ func FetchPeriod(
 
 	go func() {
 		defer wg.Done()
-		block, err := getBlockNumberByRoundID(client, aggr, startRound)
+		block, err := getBlockNumberByRoundID(ctx, client, aggr, startRound)
 		if err != nil {
 			errs <- errors.Wrapf(err, "getting start block for round %d", startRound)
 			return
@@ -69,7 +68,7 @@ func FetchPeriod(
 
 	go func() {
 		defer wg.Done()
-		block, err := getBlockNumberByRoundID(client, aggr, endRound)
+		block, err := getBlockNumberByRoundID(ctx, client, aggr, endRound)
 		if err != nil {
 			errs <- errors.Wrapf(err, "getting end block for round %d", endRound)
 			return
@@ -85,6 +84,7 @@ func FetchPeriod(
 	log.Debugf("%s: fetching events from block %d to %d", contractAddr.Hex(), startBlock, endBlock)
 
 	return fetch(
+		ctx,
 		aggr,
 		startBlock,
 		endBlock,
@@ -95,7 +95,28 @@ func FetchPeriod(
 	)
 }
 
+// descriptionCaller is the subset of the aggregator binding used to read its description.
+type descriptionCaller interface {
+	Description(opts *bind.CallOpts) (string, error)
+}
+
+// describeContract returns the aggregator description, or an empty string when
+// the contract doesn't implement Description or the call reverts. The
+// description is informational only, so it must not abort a fetch.
+func describeContract(aggr descriptionCaller, contractAddr common.Address) string {
+	desc, err := aggr.Description(nil)
+	if err != nil {
+		log.Warnf("%s: failed to get description, continuing without it: %v", contractAddr.Hex(), err)
+		return ""
+	}
+	return desc
+}
+
+// fetch fans out config and transmission filter queries over the block range and
+// streams results to resultChan, which is closed once every query has finished.
+// When ctx is cancelled no new queries are started and in-flight ones return early.
 func fetch(
+	ctx context.Context,
 	aggr *ocr2aggregator.AccessControlledOCR2Aggregator,
 	startBlock, endBlock *big.Int,
 	startRound, endRound, querySize int64,
@@ -110,18 +131,24 @@ func fetch(
 		roundIDs = append(roundIDs, uint32(i)) // #nosec G115 -- i is bounded by startRound and endRound
 	}
 
+	callOpts := &bind.CallOpts{Context: ctx}
 	transmittersMap := make(map[[32]byte][]common.Address)
-	latestCfgDetail, err := aggr.LatestConfigDetails(nil)
+	latestCfgDetail, err := aggr.LatestConfigDetails(callOpts)
 	if err == nil {
-		if transmitters, err := aggr.GetTransmitters(nil); err == nil {
+		if transmitters, err := aggr.GetTransmitters(callOpts); err == nil {
 			transmittersMap[latestCfgDetail.ConfigDigest] = transmitters
 		}
 	}
 
 	// Throttle for config fetching
+	var transmittersMu sync.Mutex
 	cfgSem := make(chan struct{}, maxConcurrency)
 	cfgWg := sync.WaitGroup{}
+cfgLoop:
 	for from := new(big.Int).Set(startBlock); from.Cmp(endBlock) <= 0; {
+		if ctx.Err() != nil {
+			break
+		}
 		to := new(big.Int).Add(from, big.NewInt(querySize-1))
 		if to.Cmp(endBlock) > 0 {
 			to.Set(endBlock)
@@ -129,13 +156,17 @@ func fetch(
 		start := from.Uint64()
 		end := to.Uint64()
 
-		cfgSem <- struct{}{}
+		select {
+		case cfgSem <- struct{}{}:
+		case <-ctx.Done():
+			break cfgLoop
+		}
 		cfgWg.Add(1)
 		go func(start, end uint64) {
 			defer cfgWg.Done()
 			defer func() { <-cfgSem }()
 
-			iter, err := aggr.FilterConfigSet(&bind.FilterOpts{Start: start, End: &end})
+			iter, err := aggr.FilterConfigSet(&bind.FilterOpts{Start: start, End: &end, Context: ctx})
 			if err != nil {
 				log.Warnf("failed to filter config (block %d-%d): %v", start, end, err)
 				return
@@ -146,7 +177,9 @@ func fetch(
 			}
 
 			for iter.Next() {
+				transmittersMu.Lock()
 				transmittersMap[iter.Event.ConfigDigest] = iter.Event.Transmitters
+				transmittersMu.Unlock()
 				log.Infof("%x : %v", iter.Event.ConfigDigest, iter.Event.Transmitters)
 			}
 			_ = iter.Close()
@@ -159,7 +192,11 @@ func fetch(
 	// Transmission fetching
 	querySem := make(chan struct{}, maxConcurrency)
 	queryWg := sync.WaitGroup{}
+queryLoop:
 	for from := new(big.Int).Set(startBlock); from.Cmp(endBlock) <= 0; {
+		if ctx.Err() != nil {
+			break
+		}
 		to := new(big.Int).Add(from, big.NewInt(querySize-1))
 		if to.Cmp(endBlock) > 0 {
 			to.Set(endBlock)
@@ -167,14 +204,21 @@ func fetch(
 		start := from.Uint64()
 		end := to.Uint64()
 
-		querySem <- struct{}{}
+		select {
+		case querySem <- struct{}{}:
+		case <-ctx.Done():
+			break queryLoop
+		}
 		queryWg.Add(1)
 		go func(start, end uint64) {
 			defer queryWg.Done()
 			defer func() { <-querySem }()
 
-			output, err := filterAndCaptureTransmissions(aggr, start, end, roundIDs, transmittersMap)
-			resultChan <- QueryResult{StartBlock: start, Output: output, Err: err}
+			output, err := filterAndCaptureTransmissions(ctx, aggr, start, end, roundIDs, transmittersMap)
+			select {
+			case resultChan <- QueryResult{StartBlock: start, Output: output, Err: err}:
+			case <-ctx.Done():
+			}
 		}(start, end)
 
 		from.Add(to, big.NewInt(1))
@@ -189,15 +233,16 @@ func fetch(
 }
 
 func getBlockNumberByRoundID(
+	ctx context.Context,
 	client *ethclient.Client,
 	aggr *ocr2aggregator.AccessControlledOCR2Aggregator,
 	roundID int64,
 ) (*big.Int, error) {
-	ts, err := aggr.GetTimestamp(nil, big.NewInt(roundID))
+	ts, err := aggr.GetTimestamp(&bind.CallOpts{Context: ctx}, big.NewInt(roundID))
 	if err != nil {
 		return nil, fmt.Errorf("GetTimestamp failed for round %d: %w", roundID, err)
 	}
-	blockNumber, _, err := findBlockByTimestamp(client, ts)
+	blockNumber, _, err := findBlockByTimestamp(ctx, client, ts)
 	if err != nil {
 		return nil, fmt.Errorf("FindBlockByTimestamp failed: %w", err)
 	}
@@ -205,12 +250,13 @@ func getBlockNumberByRoundID(
 }
 
 func filterAndCaptureTransmissions(
+	ctx context.Context,
 	aggr *ocr2aggregator.AccessControlledOCR2Aggregator,
 	start, end uint64,
 	roundIDs []uint32,
 	transmittersMap map[[32]byte][]common.Address,
 ) ([]config.Result, error) {
-	opts := &bind.FilterOpts{Start: start, End: &end, Context: context.Background()}
+	opts := &bind.FilterOpts{Start: start, End: &end, Context: ctx}
 	iter, err := aggr.FilterNewTransmission(opts, roundIDs)
 	if err != nil {
 		return nil, fmt.Errorf("filtering transmissions failed: %w", err)
@@ -241,26 +287,27 @@ func filterAndCaptureTransmissions(
 	return output, nil
 }
 
-func findBlockByTimestamp(client *ethclient.Client, targetTimestamp *big.Int) (*big.Int, *types.Block, error) {
-	ctx := context.Background()
+func findBlockByTimestamp(
+	ctx context.Context,
+	client *ethclient.Client,
+	targetTimestamp *big.Int,
+) (*big.Int, *types.Header, error) {
 	latestBlockNumber, err := client.BlockNumber(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 	// #nosec G115 -- block number is valid
-	latestBlock, err := client.BlockByNumber(ctx, big.NewInt(int64(latestBlockNumber)))
+	latestHeader, err := client.HeaderByNumber(ctx, big.NewInt(int64(latestBlockNumber)))
 	if err != nil {
 		return nil, nil, err
 	}
-	latestTimestamp := big.NewInt(int64(latestBlock.Time())) // #nosec G115 -- block timestamp is valid
+	latestTimestamp := big.NewInt(int64(latestHeader.Time)) // #nosec G115 -- block timestamp is valid
 
 	blockInterval := defaultBlockInterval
 	// #nosec G115 -- block number is valid
-	if block, err := client.BlockByNumber(ctx, big.NewInt(int64(latestBlockNumber))); err == nil {
-		prevBlockNum := big.NewInt(int64(latestBlockNumber - 1)) // #nosec G115 -- block number is valid
-		if prev, err := client.BlockByNumber(ctx, prevBlockNum); err == nil && block.Time() > prev.Time() {
-			blockInterval = int(block.Time() - prev.Time()) // #nosec G115 -- block times are valid
-		}
+	prevBlockNum := big.NewInt(int64(latestBlockNumber - 1)) // #nosec G115 -- block number is valid
+	if prev, err := client.HeaderByNumber(ctx, prevBlockNum); err == nil && latestHeader.Time > prev.Time {
+		blockInterval = int(latestHeader.Time - prev.Time) // #nosec G115 -- block times are valid
 	}
 
 	diffSeconds := new(big.Int).Sub(latestTimestamp, targetTimestamp).Int64()
@@ -279,11 +326,11 @@ func findBlockByTimestamp(client *ethclient.Client, targetTimestamp *big.Int) (*
 	for low.Cmp(high) <= 0 {
 		mid.Add(low, high)
 		mid.Div(mid, big.NewInt(2))
-		block, err := client.BlockByNumber(ctx, mid)
+		header, err := client.HeaderByNumber(ctx, mid)
 		if err != nil {
 			return nil, nil, err
 		}
-		blockTime := big.NewInt(int64(block.Time())) // #nosec G115 -- block timestamp is valid
+		blockTime := big.NewInt(int64(header.Time)) // #nosec G115 -- block timestamp is valid
 		cmp := blockTime.Cmp(targetTimestamp)
 		switch {
 		case cmp < 0:
@@ -291,12 +338,12 @@ func findBlockByTimestamp(client *ethclient.Client, targetTimestamp *big.Int) (*
 		case cmp > 0:
 			high.Sub(mid, big.NewInt(1))
 		default:
-			return mid, block, nil
+			return mid, header, nil
 		}
 	}
-	closestBlock, err := client.BlockByNumber(ctx, low)
+	closestHeader, err := client.HeaderByNumber(ctx, low)
 	if err != nil {
 		return nil, nil, err
 	}
-	return low, closestBlock, nil
+	return low, closestHeader, nil
 }
@@ -0,0 +1,102 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	ocr2aggregator "github.com/smartcontractkit/libocr/gethwrappers2/accesscontrolledocr2aggregator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDescriptionCaller struct {
+	desc string
+	err  error
+}
+
+func (f fakeDescriptionCaller) Description(_ *bind.CallOpts) (string, error) {
+	return f.desc, f.err
+}
+
+func TestDescribeContract(t *testing.T) {
+	contractAddr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	t.Run("returns description", func(t *testing.T) {
+		desc := describeContract(fakeDescriptionCaller{desc: "ETH / USD"}, contractAddr)
+		assert.Equal(t, "ETH / USD", desc)
+	})
+
+	t.Run("reverted call is not fatal", func(t *testing.T) {
+		desc := describeContract(fakeDescriptionCaller{err: errors.New("execution reverted")}, contractAddr)
+		assert.Empty(t, desc)
+	})
+}
+
+// blockingFilterBackend blocks every log query until its context is cancelled.
+// Methods not overridden panic through the nil embedded backend.
+type blockingFilterBackend struct {
+	bind.ContractBackend
+
+	queries atomic.Int32
+}
+
+func (b *blockingFilterBackend) FilterLogs(ctx context.Context, _ ethereum.FilterQuery) ([]types.Log, error) {
+	b.queries.Add(1)
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (b *blockingFilterBackend) CallContract(_ context.Context, _ ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	return nil, errors.New("execution reverted")
+}
+
+func TestFetch_CancellationStopsFanOut(t *testing.T) {
+	backend := &blockingFilterBackend{}
+	aggr, err := ocr2aggregator.NewAccessControlledOCR2Aggregator(
+		common.HexToAddress("0x1000000000000000000000000000000000000001"), backend)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	resultChan := make(chan QueryResult)
+
+	fetchErr := make(chan error, 1)
+	go func() {
+		// 1000 single-block queries, far more than the concurrency limit.
+		fetchErr <- fetch(ctx, aggr, big.NewInt(1), big.NewInt(1000), 1, 1, 1, resultChan)
+	}()
+
+	// Wait until the first batch of queries is in flight, then interrupt.
+	require.Eventually(t, func() bool {
+		return backend.queries.Load() >= maxConcurrency
+	}, time.Second, 5*time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-fetchErr:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("fetch did not stop scheduling after cancellation")
+	}
+
+	// The result channel closes without the consumer draining every query.
+	deadline := time.After(2 * time.Second)
+	for {
+		select {
+		case _, ok := <-resultChan:
+			if !ok {
+				assert.Less(t, int(backend.queries.Load()), 1000)
+				return
+			}
+		case <-deadline:
+			t.Fatal("fan-out did not stop after cancellation")
+		}
+	}
+}
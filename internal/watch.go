@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/pkg/errors"
@@ -11,7 +12,9 @@ import (
 )
 
 // FetchLatestN fetches the latest N rounds of transmissions.
+// Cancelling ctx stops in-flight RPC calls and the fetch fan-out.
 func FetchLatestN(
+	ctx context.Context,
 	client *ethclient.Client,
 	contractAddr common.Address,
 	lastRoundNum, lastCheckBlock, querySize uint64,
@@ -22,12 +25,12 @@ func FetchLatestN(
 		return errors.Wrap(err, "failed to create OCR2 aggregator instance")
 	}
 
-	latestRoundData, err := aggr.LatestRoundData(nil)
+	latestRoundData, err := aggr.LatestRoundData(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		return errors.Wrap(err, "failed to get latestRoundData")
 	}
 
-	block, err := client.BlockNumber(context.Background())
+	block, err := client.BlockNumber(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get block number")
 	}
@@ -41,6 +44,7 @@ func FetchLatestN(
 	log.Debugf("%s: fetching events from block %d to %d", contractAddr.Hex(), startBlock, endBlock)
 
 	return fetch(
+		ctx,
 		aggr,
 		startBlock,
 		endBlock,
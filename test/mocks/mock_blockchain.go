@@ -151,6 +151,36 @@ func (mr *MockOCR2AggregatorServiceMockRecorder) GetConfigFromBlock(ctx, contrac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigFromBlock", reflect.TypeOf((*MockOCR2AggregatorService)(nil).GetConfigFromBlock), ctx, contractAddress, blockNumber)
 }
 
+// GetConfigHistory mocks base method.
+func (m *MockOCR2AggregatorService) GetConfigHistory(ctx context.Context, contractAddress common.Address, startBlock, endBlock uint64) ([]entities.ConfigSetEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigHistory", ctx, contractAddress, startBlock, endBlock)
+	ret0, _ := ret[0].([]entities.ConfigSetEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfigHistory indicates an expected call of GetConfigHistory.
+func (mr *MockOCR2AggregatorServiceMockRecorder) GetConfigHistory(ctx, contractAddress, startBlock, endBlock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigHistory", reflect.TypeOf((*MockOCR2AggregatorService)(nil).GetConfigHistory), ctx, contractAddress, startBlock, endBlock)
+}
+
+// GetLatestConfigSet mocks base method.
+func (m *MockOCR2AggregatorService) GetLatestConfigSet(ctx context.Context, contractAddress common.Address) (*entities.ConfigSetEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestConfigSet", ctx, contractAddress)
+	ret0, _ := ret[0].(*entities.ConfigSetEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestConfigSet indicates an expected call of GetLatestConfigSet.
+func (mr *MockOCR2AggregatorServiceMockRecorder) GetLatestConfigSet(ctx, contractAddress interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestConfigSet", reflect.TypeOf((*MockOCR2AggregatorService)(nil).GetLatestConfigSet), ctx, contractAddress)
+}
+
 // GetLatestRound mocks base method.
 func (m *MockOCR2AggregatorService) GetLatestRound(ctx context.Context, contractAddress common.Address) (*entities.Round, error) {
 	m.ctrl.T.Helper()
@@ -182,18 +212,18 @@ func (mr *MockOCR2AggregatorServiceMockRecorder) GetRoundData(ctx, contractAddre
 }
 
 // GetTransmissions mocks base method.
-func (m *MockOCR2AggregatorService) GetTransmissions(ctx context.Context, contractAddress common.Address, startBlock, endBlock uint64) ([]entities.Transmission, error) {
+func (m *MockOCR2AggregatorService) GetTransmissions(ctx context.Context, contractAddress common.Address, startBlock, endBlock uint64, opts interfaces.FetchOptions) ([]entities.Transmission, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransmissions", ctx, contractAddress, startBlock, endBlock)
+	ret := m.ctrl.Call(m, "GetTransmissions", ctx, contractAddress, startBlock, endBlock, opts)
 	ret0, _ := ret[0].([]entities.Transmission)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTransmissions indicates an expected call of GetTransmissions.
-func (mr *MockOCR2AggregatorServiceMockRecorder) GetTransmissions(ctx, contractAddress, startBlock, endBlock interface{}) *gomock.Call {
+func (mr *MockOCR2AggregatorServiceMockRecorder) GetTransmissions(ctx, contractAddress, startBlock, endBlock, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransmissions", reflect.TypeOf((*MockOCR2AggregatorService)(nil).GetTransmissions), ctx, contractAddress, startBlock, endBlock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransmissions", reflect.TypeOf((*MockOCR2AggregatorService)(nil).GetTransmissions), ctx, contractAddress, startBlock, endBlock, opts)
 }
 
 // MockTransmissionFetcher is a mock of TransmissionFetcher interface.
@@ -220,48 +250,48 @@ func (m *MockTransmissionFetcher) EXPECT() *MockTransmissionFetcherMockRecorder
 }
 
 // FetchByBlocks mocks base method.
-func (m *MockTransmissionFetcher) FetchByBlocks(ctx context.Context, contractAddress common.Address, startBlock, endBlock uint64) (*entities.TransmissionResult, error) {
+func (m *MockTransmissionFetcher) FetchByBlocks(ctx context.Context, contractAddress common.Address, startBlock, endBlock uint64, opts interfaces.FetchOptions) (*entities.TransmissionResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchByBlocks", ctx, contractAddress, startBlock, endBlock)
+	ret := m.ctrl.Call(m, "FetchByBlocks", ctx, contractAddress, startBlock, endBlock, opts)
 	ret0, _ := ret[0].(*entities.TransmissionResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FetchByBlocks indicates an expected call of FetchByBlocks.
-func (mr *MockTransmissionFetcherMockRecorder) FetchByBlocks(ctx, contractAddress, startBlock, endBlock interface{}) *gomock.Call {
+func (mr *MockTransmissionFetcherMockRecorder) FetchByBlocks(ctx, contractAddress, startBlock, endBlock, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByBlocks", reflect.TypeOf((*MockTransmissionFetcher)(nil).FetchByBlocks), ctx, contractAddress, startBlock, endBlock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByBlocks", reflect.TypeOf((*MockTransmissionFetcher)(nil).FetchByBlocks), ctx, contractAddress, startBlock, endBlock, opts)
 }
 
 // FetchByRounds mocks base method.
-func (m *MockTransmissionFetcher) FetchByRounds(ctx context.Context, contractAddress common.Address, startRound, endRound uint32) (*entities.TransmissionResult, error) {
+func (m *MockTransmissionFetcher) FetchByRounds(ctx context.Context, contractAddress common.Address, startRound, endRound uint32, opts interfaces.FetchOptions) (*entities.TransmissionResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchByRounds", ctx, contractAddress, startRound, endRound)
+	ret := m.ctrl.Call(m, "FetchByRounds", ctx, contractAddress, startRound, endRound, opts)
 	ret0, _ := ret[0].(*entities.TransmissionResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FetchByRounds indicates an expected call of FetchByRounds.
-func (mr *MockTransmissionFetcherMockRecorder) FetchByRounds(ctx, contractAddress, startRound, endRound interface{}) *gomock.Call {
+func (mr *MockTransmissionFetcherMockRecorder) FetchByRounds(ctx, contractAddress, startRound, endRound, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByRounds", reflect.TypeOf((*MockTransmissionFetcher)(nil).FetchByRounds), ctx, contractAddress, startRound, endRound)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByRounds", reflect.TypeOf((*MockTransmissionFetcher)(nil).FetchByRounds), ctx, contractAddress, startRound, endRound, opts)
 }
 
 // FetchByTimeRange mocks base method.
-func (m *MockTransmissionFetcher) FetchByTimeRange(ctx context.Context, contractAddress common.Address, startTime, endTime time.Time) (*entities.TransmissionResult, error) {
+func (m *MockTransmissionFetcher) FetchByTimeRange(ctx context.Context, contractAddress common.Address, startTime, endTime time.Time, opts interfaces.FetchOptions) (*entities.TransmissionResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchByTimeRange", ctx, contractAddress, startTime, endTime)
+	ret := m.ctrl.Call(m, "FetchByTimeRange", ctx, contractAddress, startTime, endTime, opts)
 	ret0, _ := ret[0].(*entities.TransmissionResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FetchByTimeRange indicates an expected call of FetchByTimeRange.
-func (mr *MockTransmissionFetcherMockRecorder) FetchByTimeRange(ctx, contractAddress, startTime, endTime interface{}) *gomock.Call {
+func (mr *MockTransmissionFetcherMockRecorder) FetchByTimeRange(ctx, contractAddress, startTime, endTime, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByTimeRange", reflect.TypeOf((*MockTransmissionFetcher)(nil).FetchByTimeRange), ctx, contractAddress, startTime, endTime)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByTimeRange", reflect.TypeOf((*MockTransmissionFetcher)(nil).FetchByTimeRange), ctx, contractAddress, startTime, endTime, opts)
 }
 
 // MockTransmissionWatcher is a mock of TransmissionWatcher interface.
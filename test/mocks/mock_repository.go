@@ -52,6 +52,20 @@ func (mr *MockJobRepositoryMockRecorder) FindActiveJobs(ctx interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveJobs", reflect.TypeOf((*MockJobRepository)(nil).FindActiveJobs), ctx)
 }
 
+// FindActiveJobsInBatches mocks base method.
+func (m *MockJobRepository) FindActiveJobsInBatches(ctx context.Context, batchSize int, fn func([]entities.Job) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveJobsInBatches", ctx, batchSize, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindActiveJobsInBatches indicates an expected call of FindActiveJobsInBatches.
+func (mr *MockJobRepositoryMockRecorder) FindActiveJobsInBatches(ctx, batchSize, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveJobsInBatches", reflect.TypeOf((*MockJobRepository)(nil).FindActiveJobsInBatches), ctx, batchSize, fn)
+}
+
 // FindByContract mocks base method.
 func (m *MockJobRepository) FindByContract(ctx context.Context, contractAddress common.Address) ([]entities.Job, error) {
 	m.ctrl.T.Helper()
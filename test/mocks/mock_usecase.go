@@ -89,6 +89,226 @@ func (mr *MockWatchTransmittersUseCaseMockRecorder) Execute(ctx, params interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockWatchTransmittersUseCase)(nil).Execute), ctx, params)
 }
 
+// ExecuteAll mocks base method.
+func (m *MockWatchTransmittersUseCase) ExecuteAll(ctx context.Context, params interfaces.WatchAllTransmittersParams) ([]interfaces.TransmitterWatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteAll", ctx, params)
+	ret0, _ := ret[0].([]interfaces.TransmitterWatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteAll indicates an expected call of ExecuteAll.
+func (mr *MockWatchTransmittersUseCaseMockRecorder) ExecuteAll(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteAll", reflect.TypeOf((*MockWatchTransmittersUseCase)(nil).ExecuteAll), ctx, params)
+}
+
+// ExecuteMany mocks base method.
+func (m *MockWatchTransmittersUseCase) ExecuteMany(ctx context.Context, params interfaces.WatchManyTransmittersParams) ([]interfaces.TransmitterWatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteMany", ctx, params)
+	ret0, _ := ret[0].([]interfaces.TransmitterWatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteMany indicates an expected call of ExecuteMany.
+func (mr *MockWatchTransmittersUseCaseMockRecorder) ExecuteMany(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteMany", reflect.TypeOf((*MockWatchTransmittersUseCase)(nil).ExecuteMany), ctx, params)
+}
+
+// MockContractInfoUseCase is a mock of ContractInfoUseCase interface.
+type MockContractInfoUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockContractInfoUseCaseMockRecorder
+}
+
+// MockContractInfoUseCaseMockRecorder is the mock recorder for MockContractInfoUseCase.
+type MockContractInfoUseCaseMockRecorder struct {
+	mock *MockContractInfoUseCase
+}
+
+// NewMockContractInfoUseCase creates a new mock instance.
+func NewMockContractInfoUseCase(ctrl *gomock.Controller) *MockContractInfoUseCase {
+	mock := &MockContractInfoUseCase{ctrl: ctrl}
+	mock.recorder = &MockContractInfoUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContractInfoUseCase) EXPECT() *MockContractInfoUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockContractInfoUseCase) Execute(ctx context.Context, params interfaces.ContractInfoParams) (*interfaces.ContractInfoResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*interfaces.ContractInfoResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockContractInfoUseCaseMockRecorder) Execute(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockContractInfoUseCase)(nil).Execute), ctx, params)
+}
+
+// MockConfigHistoryUseCase is a mock of ConfigHistoryUseCase interface.
+type MockConfigHistoryUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigHistoryUseCaseMockRecorder
+}
+
+// MockConfigHistoryUseCaseMockRecorder is the mock recorder for MockConfigHistoryUseCase.
+type MockConfigHistoryUseCaseMockRecorder struct {
+	mock *MockConfigHistoryUseCase
+}
+
+// NewMockConfigHistoryUseCase creates a new mock instance.
+func NewMockConfigHistoryUseCase(ctrl *gomock.Controller) *MockConfigHistoryUseCase {
+	mock := &MockConfigHistoryUseCase{ctrl: ctrl}
+	mock.recorder = &MockConfigHistoryUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigHistoryUseCase) EXPECT() *MockConfigHistoryUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockConfigHistoryUseCase) Execute(ctx context.Context, params interfaces.ConfigHistoryParams) (*interfaces.ConfigHistoryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*interfaces.ConfigHistoryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockConfigHistoryUseCaseMockRecorder) Execute(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockConfigHistoryUseCase)(nil).Execute), ctx, params)
+}
+
+// MockConfigDiffUseCase is a mock of ConfigDiffUseCase interface.
+type MockConfigDiffUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigDiffUseCaseMockRecorder
+}
+
+// MockConfigDiffUseCaseMockRecorder is the mock recorder for MockConfigDiffUseCase.
+type MockConfigDiffUseCaseMockRecorder struct {
+	mock *MockConfigDiffUseCase
+}
+
+// NewMockConfigDiffUseCase creates a new mock instance.
+func NewMockConfigDiffUseCase(ctrl *gomock.Controller) *MockConfigDiffUseCase {
+	mock := &MockConfigDiffUseCase{ctrl: ctrl}
+	mock.recorder = &MockConfigDiffUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigDiffUseCase) EXPECT() *MockConfigDiffUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockConfigDiffUseCase) Execute(ctx context.Context, params interfaces.ConfigDiffParams) (*interfaces.ConfigDiffResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*interfaces.ConfigDiffResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockConfigDiffUseCaseMockRecorder) Execute(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockConfigDiffUseCase)(nil).Execute), ctx, params)
+}
+
+// MockObserversUseCase is a mock of ObserversUseCase interface.
+type MockObserversUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockObserversUseCaseMockRecorder
+}
+
+// MockObserversUseCaseMockRecorder is the mock recorder for MockObserversUseCase.
+type MockObserversUseCaseMockRecorder struct {
+	mock *MockObserversUseCase
+}
+
+// NewMockObserversUseCase creates a new mock instance.
+func NewMockObserversUseCase(ctrl *gomock.Controller) *MockObserversUseCase {
+	mock := &MockObserversUseCase{ctrl: ctrl}
+	mock.recorder = &MockObserversUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObserversUseCase) EXPECT() *MockObserversUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockObserversUseCase) Execute(ctx context.Context, params interfaces.ObserversParams) (*interfaces.ObserversResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*interfaces.ObserversResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockObserversUseCaseMockRecorder) Execute(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockObserversUseCase)(nil).Execute), ctx, params)
+}
+
+// MockSLAUseCase is a mock of SLAUseCase interface.
+type MockSLAUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSLAUseCaseMockRecorder
+}
+
+// MockSLAUseCaseMockRecorder is the mock recorder for MockSLAUseCase.
+type MockSLAUseCaseMockRecorder struct {
+	mock *MockSLAUseCase
+}
+
+// NewMockSLAUseCase creates a new mock instance.
+func NewMockSLAUseCase(ctrl *gomock.Controller) *MockSLAUseCase {
+	mock := &MockSLAUseCase{ctrl: ctrl}
+	mock.recorder = &MockSLAUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSLAUseCase) EXPECT() *MockSLAUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockSLAUseCase) Execute(ctx context.Context, params interfaces.SLAParams) (*interfaces.SLAResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*interfaces.SLAResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockSLAUseCaseMockRecorder) Execute(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSLAUseCase)(nil).Execute), ctx, params)
+}
+
 // MockParseTransmissionsUseCase is a mock of ParseTransmissionsUseCase interface.
 type MockParseTransmissionsUseCase struct {
 	ctrl     *gomock.Controller
@@ -126,6 +346,44 @@ func (mr *MockParseTransmissionsUseCaseMockRecorder) Execute(ctx, params interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockParseTransmissionsUseCase)(nil).Execute), ctx, params)
 }
 
+// MockReindexTransmissionsUseCase is a mock of ReindexTransmissionsUseCase interface.
+type MockReindexTransmissionsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReindexTransmissionsUseCaseMockRecorder
+}
+
+// MockReindexTransmissionsUseCaseMockRecorder is the mock recorder for MockReindexTransmissionsUseCase.
+type MockReindexTransmissionsUseCaseMockRecorder struct {
+	mock *MockReindexTransmissionsUseCase
+}
+
+// NewMockReindexTransmissionsUseCase creates a new mock instance.
+func NewMockReindexTransmissionsUseCase(ctrl *gomock.Controller) *MockReindexTransmissionsUseCase {
+	mock := &MockReindexTransmissionsUseCase{ctrl: ctrl}
+	mock.recorder = &MockReindexTransmissionsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReindexTransmissionsUseCase) EXPECT() *MockReindexTransmissionsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockReindexTransmissionsUseCase) Execute(ctx context.Context, params interfaces.ReindexTransmissionsParams) (*interfaces.ReindexTransmissionsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*interfaces.ReindexTransmissionsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockReindexTransmissionsUseCaseMockRecorder) Execute(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockReindexTransmissionsUseCase)(nil).Execute), ctx, params)
+}
+
 // MockTransmissionAnalyzer is a mock of TransmissionAnalyzer interface.
 type MockTransmissionAnalyzer struct {
 	ctrl     *gomock.Controller
@@ -150,46 +408,122 @@ func (m *MockTransmissionAnalyzer) EXPECT() *MockTransmissionAnalyzerMockRecorde
 }
 
 // AnalyzeObserverActivity mocks base method.
-func (m *MockTransmissionAnalyzer) AnalyzeObserverActivity(transmissions []entities.Transmission) ([]entities.ObserverActivity, error) {
+func (m *MockTransmissionAnalyzer) AnalyzeObserverActivity(ctx context.Context, transmissions []entities.Transmission) ([]entities.ObserverActivity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AnalyzeObserverActivity", transmissions)
+	ret := m.ctrl.Call(m, "AnalyzeObserverActivity", ctx, transmissions)
 	ret0, _ := ret[0].([]entities.ObserverActivity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AnalyzeObserverActivity indicates an expected call of AnalyzeObserverActivity.
-func (mr *MockTransmissionAnalyzerMockRecorder) AnalyzeObserverActivity(transmissions interface{}) *gomock.Call {
+func (mr *MockTransmissionAnalyzerMockRecorder) AnalyzeObserverActivity(ctx, transmissions interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeObserverActivity", reflect.TypeOf((*MockTransmissionAnalyzer)(nil).AnalyzeObserverActivity), transmissions)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeObserverActivity", reflect.TypeOf((*MockTransmissionAnalyzer)(nil).AnalyzeObserverActivity), ctx, transmissions)
 }
 
 // DetectAnomalies mocks base method.
-func (m *MockTransmissionAnalyzer) DetectAnomalies(transmissions []entities.Transmission) ([]interfaces.TransmissionAnomaly, error) {
+func (m *MockTransmissionAnalyzer) DetectAnomalies(ctx context.Context, transmissions []entities.Transmission) ([]interfaces.TransmissionAnomaly, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DetectAnomalies", transmissions)
+	ret := m.ctrl.Call(m, "DetectAnomalies", ctx, transmissions)
 	ret0, _ := ret[0].([]interfaces.TransmissionAnomaly)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DetectAnomalies indicates an expected call of DetectAnomalies.
-func (mr *MockTransmissionAnalyzerMockRecorder) DetectAnomalies(transmissions interface{}) *gomock.Call {
+func (mr *MockTransmissionAnalyzerMockRecorder) DetectAnomalies(ctx, transmissions interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectAnomalies", reflect.TypeOf((*MockTransmissionAnalyzer)(nil).DetectAnomalies), transmissions)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectAnomalies", reflect.TypeOf((*MockTransmissionAnalyzer)(nil).DetectAnomalies), ctx, transmissions)
 }
 
 // GenerateReport mocks base method.
-func (m *MockTransmissionAnalyzer) GenerateReport(transmissions []entities.Transmission, format interfaces.OutputFormat) ([]byte, error) {
+func (m *MockTransmissionAnalyzer) GenerateReport(ctx context.Context, transmissions []entities.Transmission, format interfaces.OutputFormat) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateReport", transmissions, format)
+	ret := m.ctrl.Call(m, "GenerateReport", ctx, transmissions, format)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateReport indicates an expected call of GenerateReport.
-func (mr *MockTransmissionAnalyzerMockRecorder) GenerateReport(transmissions, format interface{}) *gomock.Call {
+func (mr *MockTransmissionAnalyzerMockRecorder) GenerateReport(ctx, transmissions, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateReport", reflect.TypeOf((*MockTransmissionAnalyzer)(nil).GenerateReport), ctx, transmissions, format)
+}
+
+// MockVerifyTransmissionsUseCase is a mock of VerifyTransmissionsUseCase interface.
+type MockVerifyTransmissionsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyTransmissionsUseCaseMockRecorder
+}
+
+// MockVerifyTransmissionsUseCaseMockRecorder is the mock recorder for MockVerifyTransmissionsUseCase.
+type MockVerifyTransmissionsUseCaseMockRecorder struct {
+	mock *MockVerifyTransmissionsUseCase
+}
+
+// NewMockVerifyTransmissionsUseCase creates a new mock instance.
+func NewMockVerifyTransmissionsUseCase(ctrl *gomock.Controller) *MockVerifyTransmissionsUseCase {
+	mock := &MockVerifyTransmissionsUseCase{ctrl: ctrl}
+	mock.recorder = &MockVerifyTransmissionsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyTransmissionsUseCase) EXPECT() *MockVerifyTransmissionsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockVerifyTransmissionsUseCase) Execute(ctx context.Context, params interfaces.VerifyTransmissionsParams) (*interfaces.VerifyTransmissionsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*interfaces.VerifyTransmissionsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockVerifyTransmissionsUseCaseMockRecorder) Execute(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockVerifyTransmissionsUseCase)(nil).Execute), ctx, params)
+}
+
+// MockArchiveTransmissionsUseCase is a mock of ArchiveTransmissionsUseCase interface.
+type MockArchiveTransmissionsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchiveTransmissionsUseCaseMockRecorder
+}
+
+// MockArchiveTransmissionsUseCaseMockRecorder is the mock recorder for MockArchiveTransmissionsUseCase.
+type MockArchiveTransmissionsUseCaseMockRecorder struct {
+	mock *MockArchiveTransmissionsUseCase
+}
+
+// NewMockArchiveTransmissionsUseCase creates a new mock instance.
+func NewMockArchiveTransmissionsUseCase(ctrl *gomock.Controller) *MockArchiveTransmissionsUseCase {
+	mock := &MockArchiveTransmissionsUseCase{ctrl: ctrl}
+	mock.recorder = &MockArchiveTransmissionsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArchiveTransmissionsUseCase) EXPECT() *MockArchiveTransmissionsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockArchiveTransmissionsUseCase) Execute(ctx context.Context, params interfaces.ArchiveTransmissionsParams) (*interfaces.ArchiveTransmissionsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*interfaces.ArchiveTransmissionsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockArchiveTransmissionsUseCaseMockRecorder) Execute(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateReport", reflect.TypeOf((*MockTransmissionAnalyzer)(nil).GenerateReport), transmissions, format)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockArchiveTransmissionsUseCase)(nil).Execute), ctx, params)
 }